@@ -0,0 +1,107 @@
+package common
+
+// ClientRegistrationPolicies defines client registration policies of the realm.
+// Policies are matched with the existing ones by name. Policies created by the operator
+// and removed from the spec are deleted, policies created in other ways are kept untouched.
+// +kubebuilder:object:generate=true
+type ClientRegistrationPolicies struct {
+	// Anonymous is a list of policies applied to clients registered without a token or with an initial access token.
+	// +optional
+	Anonymous []ClientRegistrationPolicy `json:"anonymous,omitempty"`
+
+	// Authenticated is a list of policies applied to clients registered with a bearer token.
+	// +optional
+	Authenticated []ClientRegistrationPolicy `json:"authenticated,omitempty"`
+}
+
+// ClientRegistrationPolicy defines a single client registration policy.
+// Exactly one policy type must be set.
+// +kubebuilder:validation:XValidation:rule="(has(self.trustedHosts) ? 1 : 0) + (has(self.maxClients) ? 1 : 0) + (has(self.allowedProtocolMappers) ? 1 : 0) + (has(self.allowedClientScopes) ? 1 : 0) + (has(self.custom) ? 1 : 0) == 1",message="exactly one policy type must be set"
+// +kubebuilder:object:generate=true
+type ClientRegistrationPolicy struct {
+	// Name is a name of the policy.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:example="Trusted Hosts"
+	Name string `json:"name"`
+
+	// TrustedHosts limits hosts that can register clients.
+	// +optional
+	TrustedHosts *TrustedHostsPolicy `json:"trustedHosts,omitempty"`
+
+	// MaxClients limits the number of clients in the realm.
+	// +optional
+	MaxClients *MaxClientsPolicy `json:"maxClients,omitempty"`
+
+	// AllowedProtocolMappers limits protocol mappers that registered clients can use.
+	// +optional
+	AllowedProtocolMappers *AllowedProtocolMappersPolicy `json:"allowedProtocolMappers,omitempty"`
+
+	// AllowedClientScopes limits client scopes that registered clients can use.
+	// +optional
+	AllowedClientScopes *AllowedClientScopesPolicy `json:"allowedClientScopes,omitempty"`
+
+	// Custom is a policy of any other type with a raw configuration,
+	// for example consent-required, scope or client-disabled.
+	// +optional
+	Custom *CustomClientRegistrationPolicy `json:"custom,omitempty"`
+}
+
+// TrustedHostsPolicy defines trusted-hosts client registration policy.
+type TrustedHostsPolicy struct {
+	// Hosts is a list of trusted hosts or domains.
+	// +kubebuilder:example={"example.com", "*.example.com"}
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// HostSendingRequestMustMatch requires the host sending the registration request to be trusted.
+	// +kubebuilder:default=true
+	// +optional
+	HostSendingRequestMustMatch *bool `json:"hostSendingRequestMustMatch,omitempty"`
+
+	// ClientURIsMustMatch requires the client URIs to match trusted hosts.
+	// +kubebuilder:default=true
+	// +optional
+	ClientURIsMustMatch *bool `json:"clientUrisMustMatch,omitempty"`
+}
+
+// MaxClientsPolicy defines max-clients client registration policy.
+type MaxClientsPolicy struct {
+	// Limit is a maximum number of clients in the realm.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=200
+	// +optional
+	Limit int32 `json:"limit,omitempty"`
+}
+
+// AllowedProtocolMappersPolicy defines allowed-protocol-mappers client registration policy.
+type AllowedProtocolMappersPolicy struct {
+	// MapperTypes is a list of allowed protocol mapper types.
+	// +kubebuilder:example={"oidc-full-name-mapper", "oidc-usermodel-property-mapper"}
+	// +optional
+	MapperTypes []string `json:"mapperTypes,omitempty"`
+}
+
+// AllowedClientScopesPolicy defines allowed-client-templates client registration policy.
+type AllowedClientScopesPolicy struct {
+	// Scopes is a list of allowed client scopes.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// AllowDefaultScopes allows realm default client scopes.
+	// +kubebuilder:default=true
+	// +optional
+	AllowDefaultScopes *bool `json:"allowDefaultScopes,omitempty"`
+}
+
+// CustomClientRegistrationPolicy defines a client registration policy with a raw configuration.
+type CustomClientRegistrationPolicy struct {
+	// ProviderID is a type of the policy.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:example="consent-required"
+	ProviderID string `json:"providerId"`
+
+	// Config is a map of policy configuration.
+	// +nullable
+	// +optional
+	Config map[string][]string `json:"config,omitempty"`
+}
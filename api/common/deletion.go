@@ -0,0 +1,18 @@
+package common
+
+// DeletionPolicy defines what happens to the Keycloak object when the resource is deleted.
+// +kubebuilder:validation:Enum=Delete;Retain
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the Keycloak object together with the resource.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyRetain keeps the Keycloak object when the resource is deleted.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// +kubebuilder:object:generate=false
+type HasDeletionPolicy interface {
+	GetDeletionPolicy() DeletionPolicy
+}
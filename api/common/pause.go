@@ -0,0 +1,26 @@
+package common
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// PausedAnnotation stops reconciliation of the resource when set to "true".
+	// The operator doesn't make any changes in Keycloak, including deletion, until the annotation is removed.
+	PausedAnnotation = "edp.epam.com/paused"
+
+	// ResyncAnnotation triggers immediate reconciliation of the resource when its value changes.
+	// Any unique value, for example, a timestamp, can be used. The handled value is stored in status.lastHandledResync.
+	ResyncAnnotation = "edp.epam.com/resync"
+)
+
+// HasConditions is an object with status conditions.
+// +kubebuilder:object:generate=false
+type HasConditions interface {
+	GetConditions() *[]metav1.Condition
+}
+
+// HasResyncStatus is an object that stores the last handled value of the resync annotation.
+// +kubebuilder:object:generate=false
+type HasResyncStatus interface {
+	GetLastHandledResync() string
+	SetLastHandledResync(value string)
+}
@@ -399,3 +399,63 @@ type RealmSSOLoginSettings struct {
 	// +kubebuilder:default=300
 	AccessCodeLifespanUserAction int `json:"accessCodeLifespanUserAction,omitempty"`
 }
+
+// AuthenticationFlowBindings binds realm authentication flows by alias.
+// Each flow must exist in the realm, either built-in or created by KeycloakAuthFlow resource.
+type AuthenticationFlowBindings struct {
+	// BrowserFlow specifies the authentication flow to use for the realm's browser clients.
+	// +optional
+	// +kubebuilder:example="browser"
+	BrowserFlow string `json:"browserFlow,omitempty"`
+
+	// RegistrationFlow specifies the authentication flow to use for user registration.
+	// +optional
+	// +kubebuilder:example="registration"
+	RegistrationFlow string `json:"registrationFlow,omitempty"`
+
+	// DirectGrantFlow specifies the authentication flow to use for direct access grants.
+	// +optional
+	// +kubebuilder:example="direct grant"
+	DirectGrantFlow string `json:"directGrantFlow,omitempty"`
+
+	// ResetCredentialsFlow specifies the authentication flow to use for resetting credentials.
+	// +optional
+	// +kubebuilder:example="reset credentials"
+	ResetCredentialsFlow string `json:"resetCredentialsFlow,omitempty"`
+
+	// ClientAuthenticationFlow specifies the authentication flow to use for client authentication.
+	// +optional
+	// +kubebuilder:example="clients"
+	ClientAuthenticationFlow string `json:"clientAuthenticationFlow,omitempty"`
+
+	// DockerAuthenticationFlow specifies the authentication flow to use for docker authentication.
+	// +optional
+	// +kubebuilder:example="docker auth"
+	DockerAuthenticationFlow string `json:"dockerAuthenticationFlow,omitempty"`
+
+	// FirstBrokerLoginFlow specifies the authentication flow to use for the first login with an identity provider.
+	// +optional
+	// +kubebuilder:example="first broker login"
+	FirstBrokerLoginFlow string `json:"firstBrokerLoginFlow,omitempty"`
+}
+
+// Aliases returns the list of flow aliases that are bound.
+func (in *AuthenticationFlowBindings) Aliases() []string {
+	aliases := make([]string, 0, 7)
+
+	for _, alias := range []string{
+		in.BrowserFlow,
+		in.RegistrationFlow,
+		in.DirectGrantFlow,
+		in.ResetCredentialsFlow,
+		in.ClientAuthenticationFlow,
+		in.DockerAuthenticationFlow,
+		in.FirstBrokerLoginFlow,
+	} {
+		if alias != "" {
+			aliases = append(aliases, alias)
+		}
+	}
+
+	return aliases
+}
@@ -0,0 +1,19 @@
+package common
+
+// ReconcileMode defines how the operator reconciles a resource.
+// +kubebuilder:validation:Enum=apply;observe
+type ReconcileMode string
+
+const (
+	// ReconcileModeApply applies the desired state to Keycloak.
+	ReconcileModeApply ReconcileMode = "apply"
+
+	// ReconcileModeObserve compares the desired state with Keycloak and reports the difference
+	// without modifying Keycloak.
+	ReconcileModeObserve ReconcileMode = "observe"
+)
+
+// +kubebuilder:object:generate=false
+type HasReconcileMode interface {
+	GetReconcileMode() ReconcileMode
+}
@@ -5,3 +5,10 @@ const (
 	StatusError   string = "error"
 	FinalizerName string = "v1.edp.epam.com/finalizer"
 )
+
+// HasObservedGeneration is an object that stores the most recent generation observed by the operator.
+// +kubebuilder:object:generate=false
+type HasObservedGeneration interface {
+	GetObservedGeneration() int64
+	SetObservedGeneration(generation int64)
+}
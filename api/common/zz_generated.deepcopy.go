@@ -6,6 +6,51 @@ package common
 
 import ()
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedClientScopesPolicy) DeepCopyInto(out *AllowedClientScopesPolicy) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowDefaultScopes != nil {
+		in, out := &in.AllowDefaultScopes, &out.AllowDefaultScopes
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedClientScopesPolicy.
+func (in *AllowedClientScopesPolicy) DeepCopy() *AllowedClientScopesPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedClientScopesPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedProtocolMappersPolicy) DeepCopyInto(out *AllowedProtocolMappersPolicy) {
+	*out = *in
+	if in.MapperTypes != nil {
+		in, out := &in.MapperTypes, &out.MapperTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedProtocolMappersPolicy.
+func (in *AllowedProtocolMappersPolicy) DeepCopy() *AllowedProtocolMappersPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedProtocolMappersPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthSpec) DeepCopyInto(out *AuthSpec) {
 	*out = *in
@@ -31,6 +76,21 @@ func (in *AuthSpec) DeepCopy() *AuthSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationFlowBindings) DeepCopyInto(out *AuthenticationFlowBindings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationFlowBindings.
+func (in *AuthenticationFlowBindings) DeepCopy() *AuthenticationFlowBindings {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationFlowBindings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BruteForceDetection) DeepCopyInto(out *BruteForceDetection) {
 	*out = *in
@@ -108,6 +168,75 @@ func (in *ClientCredentialsConfig) DeepCopy() *ClientCredentialsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientRegistrationPolicies) DeepCopyInto(out *ClientRegistrationPolicies) {
+	*out = *in
+	if in.Anonymous != nil {
+		in, out := &in.Anonymous, &out.Anonymous
+		*out = make([]ClientRegistrationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Authenticated != nil {
+		in, out := &in.Authenticated, &out.Authenticated
+		*out = make([]ClientRegistrationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientRegistrationPolicies.
+func (in *ClientRegistrationPolicies) DeepCopy() *ClientRegistrationPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientRegistrationPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientRegistrationPolicy) DeepCopyInto(out *ClientRegistrationPolicy) {
+	*out = *in
+	if in.TrustedHosts != nil {
+		in, out := &in.TrustedHosts, &out.TrustedHosts
+		*out = new(TrustedHostsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxClients != nil {
+		in, out := &in.MaxClients, &out.MaxClients
+		*out = new(MaxClientsPolicy)
+		**out = **in
+	}
+	if in.AllowedProtocolMappers != nil {
+		in, out := &in.AllowedProtocolMappers, &out.AllowedProtocolMappers
+		*out = new(AllowedProtocolMappersPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedClientScopes != nil {
+		in, out := &in.AllowedClientScopes, &out.AllowedClientScopes
+		*out = new(AllowedClientScopesPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomClientRegistrationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientRegistrationPolicy.
+func (in *ClientRegistrationPolicy) DeepCopy() *ClientRegistrationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientRegistrationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
 	*out = *in
@@ -124,6 +253,37 @@ func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomClientRegistrationPolicy) DeepCopyInto(out *CustomClientRegistrationPolicy) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomClientRegistrationPolicy.
+func (in *CustomClientRegistrationPolicy) DeepCopy() *CustomClientRegistrationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomClientRegistrationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmailAuthentication) DeepCopyInto(out *EmailAuthentication) {
 	*out = *in
@@ -206,6 +366,21 @@ func (in *KeycloakRef) DeepCopy() *KeycloakRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxClientsPolicy) DeepCopyInto(out *MaxClientsPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxClientsPolicy.
+func (in *MaxClientsPolicy) DeepCopy() *MaxClientsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxClientsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PasswordGrantConfig) DeepCopyInto(out *PasswordGrantConfig) {
 	*out = *in
@@ -467,6 +642,36 @@ func (in *TokenSettings) DeepCopy() *TokenSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedHostsPolicy) DeepCopyInto(out *TrustedHostsPolicy) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostSendingRequestMustMatch != nil {
+		in, out := &in.HostSendingRequestMustMatch, &out.HostSendingRequestMustMatch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientURIsMustMatch != nil {
+		in, out := &in.ClientURIsMustMatch, &out.ClientURIsMustMatch
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedHostsPolicy.
+func (in *TrustedHostsPolicy) DeepCopy() *TrustedHostsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedHostsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserProfileAttribute) DeepCopyInto(out *UserProfileAttribute) {
 	*out = *in
@@ -1,12 +1,15 @@
 package v1
 
 const (
-	PolicyTypeAggregate = "aggregate"
-	PolicyTypeClient    = "client"
-	PolicyTypeGroup     = "group"
-	PolicyTypeRole      = "role"
-	PolicyTypeTime      = "time"
-	PolicyTypeUser      = "user"
+	PolicyTypeAggregate   = "aggregate"
+	PolicyTypeClient      = "client"
+	PolicyTypeClientScope = "client-scope"
+	PolicyTypeGroup       = "group"
+	PolicyTypeJS          = "js"
+	PolicyTypeRegex       = "regex"
+	PolicyTypeRole        = "role"
+	PolicyTypeTime        = "time"
+	PolicyTypeUser        = "user"
 
 	PolicyDecisionStrategyUnanimous   = "UNANIMOUS"
 	PolicyDecisionStrategyAffirmative = "AFFIRMATIVE"
@@ -17,13 +20,16 @@ const (
 
 	PermissionTypeResource = "resource"
 	PermissionTypeScope    = "scope"
+
+	AuthorizationDecisionPermit = "PERMIT"
+	AuthorizationDecisionDeny   = "DENY"
 )
 
 // Policy represents a client authorization policy.
 type Policy struct {
 	// Type is a policy type.
 	// +required
-	// +kubebuilder:validation:Enum=aggregate;client;group;role;time;user
+	// +kubebuilder:validation:Enum=aggregate;client;client-scope;group;js;regex;role;time;user
 	Type string `json:"type"`
 
 	// Name is a policy name.
@@ -52,9 +58,18 @@ type Policy struct {
 	// ClientPolicy is a client policy settings.
 	ClientPolicy *ClientPolicyData `json:"clientPolicy,omitempty"`
 
+	// ClientScopePolicy is a client scope policy settings.
+	ClientScopePolicy *ClientScopePolicyData `json:"clientScopePolicy,omitempty"`
+
 	// GroupPolicy is a group policy settings.
 	GroupPolicy *GroupPolicyData `json:"groupPolicy,omitempty"`
 
+	// JSPolicy is a JavaScript policy settings.
+	JSPolicy *JSPolicyData `json:"jsPolicy,omitempty"`
+
+	// RegexPolicy is a regex policy settings.
+	RegexPolicy *RegexPolicyData `json:"regexPolicy,omitempty"`
+
 	// RolePolicy is a role policy settings.
 	RolePolicy *RolePolicyData `json:"rolePolicy,omitempty"`
 
@@ -97,6 +112,54 @@ type ClientPolicyData struct {
 	Clients []string `json:"clients"`
 }
 
+// ClientScopePolicyData represents client scope based policies.
+type ClientScopePolicyData struct {
+	// ClientScopes is a list of client scopes. Specifies which client scope(s) are allowed by this policy.
+	// +required
+	// +kubebuilder:example={clientScopes:{{name:"profile",required:true},{name:"email"}}}
+	ClientScopes []ClientScopeDefinition `json:"clientScopes"`
+}
+
+// ClientScopeDefinition represents a client scope in a ClientScopePolicyData.
+type ClientScopeDefinition struct {
+	// Name is a client scope name.
+	// +required
+	// +kubebuilder:example="profile"
+	Name string `json:"name"`
+
+	// Required is a flag that specifies whether the client scope is required.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// RegexPolicyData represents regex based policies.
+type RegexPolicyData struct {
+	// TargetClaim is a claim of the token, or an attribute of the context if TargetContextAttributes is set,
+	// whose value is matched against the pattern.
+	// +required
+	// +kubebuilder:example="department"
+	TargetClaim string `json:"targetClaim"`
+
+	// Pattern is a regular expression the value of the target claim must match.
+	// +required
+	// +kubebuilder:example="^engineering$"
+	Pattern string `json:"pattern"`
+
+	// TargetContextAttributes is a flag that specifies whether the target claim is an attribute
+	// of the evaluation context instead of a claim of the token.
+	// +optional
+	TargetContextAttributes bool `json:"targetContextAttributes,omitempty"`
+}
+
+// JSPolicyData represents JavaScript policies deployed to Keycloak.
+type JSPolicyData struct {
+	// Script is the file name of the JavaScript policy deployed to Keycloak in a JAR file.
+	// Uploading scripts through the Admin API is not supported by Keycloak, so the script must be deployed in advance.
+	// +required
+	// +kubebuilder:example="my-policy.js"
+	Script string `json:"script"`
+}
+
 // TimePolicyData represents time based policies.
 type TimePolicyData struct {
 	// NotBefore defines the time before which the policy MUST NOT be granted.
@@ -162,8 +225,9 @@ type UserPolicyData struct {
 
 // AggregatedPolicyData represents aggregated policies.
 type AggregatedPolicyData struct {
-	// Policies is a list of aggregated policies names.
+	// Policies is a list of aggregated policies or permissions names.
 	// Specifies all the policies that must be applied to the scopes defined by this policy or permission.
+	// Aggregate policies that reference permissions of the client are synced after the permissions are created.
 	// +required
 	// +kubebuilder:example={policies:{policy1,policy2}}
 	Policies []string `json:"policies"`
@@ -280,3 +344,56 @@ type Resource struct {
 	// +nullable
 	Scopes []string `json:"scopes"`
 }
+
+// AuthorizationTest is a test case of the client authorization settings.
+// It is evaluated by Keycloak for the user or the set of realm roles.
+type AuthorizationTest struct {
+	// Name is a test case name.
+	// +required
+	Name string `json:"name"`
+
+	// User is a username of the identity the permissions are evaluated for.
+	// +optional
+	// +kubebuilder:example="john"
+	User string `json:"user,omitempty"`
+
+	// Roles is a list of realm roles names the identity has.
+	// If User is set, the roles are added to the roles of the user.
+	// +optional
+	// +nullable
+	// +kubebuilder:example={role1,role2}
+	Roles []string `json:"roles,omitempty"`
+
+	// Resource is a name of the resource the permissions are evaluated for.
+	// +required
+	// +kubebuilder:example="resource1"
+	Resource string `json:"resource"`
+
+	// Scope is a name of the authorization scope of the resource.
+	// If not specified, all scopes of the resource are evaluated.
+	// +optional
+	// +kubebuilder:example="scope1"
+	Scope string `json:"scope,omitempty"`
+
+	// Expected is the expected decision.
+	// +required
+	// +kubebuilder:validation:Enum=PERMIT;DENY
+	Expected string `json:"expected"`
+}
+
+// AuthorizationTestResult is a result of the authorization test case.
+type AuthorizationTestResult struct {
+	// Name is a test case name.
+	Name string `json:"name"`
+
+	// Passed is true if the decision matches the expected one.
+	Passed bool `json:"passed"`
+
+	// Decision is the decision of Keycloak.
+	// +optional
+	Decision string `json:"decision,omitempty"`
+
+	// Message is a reason why the test case could not be evaluated.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
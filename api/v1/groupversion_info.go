@@ -24,4 +24,18 @@ const (
 	// KeycloakRealmComponentKind is a string value of the kind of KeycloakClient CR.
 	KeycloakRealmComponentKind = "KeycloakRealmComponent"
 	KeycloakKind               = "Keycloak"
+	// KeycloakClientKind is a string value of the kind of KeycloakClient CR.
+	KeycloakClientKind = "KeycloakClient"
+	// KeycloakRealmRoleKind is a string value of the kind of KeycloakRealmRole CR.
+	KeycloakRealmRoleKind = "KeycloakRealmRole"
+	// KeycloakRealmGroupKind is a string value of the kind of KeycloakRealmGroup CR.
+	KeycloakRealmGroupKind = "KeycloakRealmGroup"
+	// KeycloakClientScopeKind is a string value of the kind of KeycloakClientScope CR.
+	KeycloakClientScopeKind = "KeycloakClientScope"
+	// KeycloakAuthFlowKind is a string value of the kind of KeycloakAuthFlow CR.
+	KeycloakAuthFlowKind = "KeycloakAuthFlow"
+	// KeycloakRealmIdentityProviderKind is a string value of the kind of KeycloakRealmIdentityProvider CR.
+	KeycloakRealmIdentityProviderKind = "KeycloakRealmIdentityProvider"
+	// KeycloakClientTemplateKind is a string value of the kind of KeycloakClientTemplate CR.
+	KeycloakClientTemplateKind = "KeycloakClientTemplate"
 )
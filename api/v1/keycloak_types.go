@@ -55,11 +55,25 @@ func (in *Keycloak) GetAdminType() string {
 type KeycloakStatus struct {
 	// Connected shows if keycloak service is up and running.
 	Connected bool `json:"connected"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Connected",type="boolean",JSONPath=".status.connected",description="Is connected to keycloak"
 
 // Keycloak is the Schema for the keycloaks API.
@@ -73,6 +87,26 @@ type Keycloak struct {
 	Status KeycloakStatus `json:"status,omitempty"`
 }
 
+func (in *Keycloak) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *Keycloak) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *Keycloak) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *Keycloak) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *Keycloak) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakList contains a list of Keycloak.
@@ -44,6 +44,13 @@ type KeycloakAuthFlowSpec struct {
 	// ChildRequirement is requirement for child execution. Available options: REQUIRED, ALTERNATIVE, DISABLED, CONDITIONAL.
 	// +optional
 	ChildRequirement string `json:"childRequirement,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak authentication flow when the resource is deleted.
+	// Delete - the authentication flow is deleted from Keycloak.
+	// Retain - the authentication flow is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // AuthenticationExecution defines keycloak authentication execution.
@@ -93,11 +100,25 @@ type KeycloakAuthFlowStatus struct {
 	// ID is the Keycloak internal ID of the auth flow.
 	// +optional
 	ID string `json:"id,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 
 // KeycloakAuthFlow is the Schema for the keycloak authentication flow API.
@@ -121,6 +142,30 @@ func (in *KeycloakAuthFlow) SetStatus(value string) {
 	in.Status.Value = value
 }
 
+func (in *KeycloakAuthFlow) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakAuthFlow) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakAuthFlow) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakAuthFlow) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakAuthFlow) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakAuthFlow) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakAuthFlowList contains a list of KeycloakAuthFlow.
@@ -11,8 +11,37 @@ const (
 	ReconciliationStrategyAddOnly = "addOnly"
 	// ClientSecretKey is a key for client secret in secret data.
 	ClientSecretKey = "clientSecret"
+	// ClientPreviousSecretKey is a key for the previous client secret in secret data.
+	// It is set after the secret rotation until the grace period ends.
+	ClientPreviousSecretKey = "previousClientSecret"
 )
 
+// Client authenticator types.
+const (
+	ClientAuthenticatorSecret    = "client-secret"
+	ClientAuthenticatorJWT       = "client-jwt"
+	ClientAuthenticatorSecretJWT = "client-secret-jwt"
+	ClientAuthenticatorX509      = "client-x509"
+)
+
+// SecretRotation defines the rotation of the client secret generated by the operator.
+type SecretRotation struct {
+	// Interval is a time in seconds between secret rotations.
+	// +kubebuilder:default=7776000
+	// +kubebuilder:validation:Minimum=3600
+	// +optional
+	Interval int32 `json:"interval,omitempty"`
+
+	// GracePeriod is a time in seconds during which the previous secret stays valid after the rotation.
+	// The previous secret is stored in the Secret under the previousClientSecret key,
+	// and it is invalidated in Keycloak and removed from the Secret when the grace period ends.
+	// The rotated secret expiration of the secret-rotation executor should not be shorter than the grace period.
+	// +kubebuilder:default=86400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriod int32 `json:"gracePeriod,omitempty"`
+}
+
 // KeycloakClientAdvancedSettings contains advanced client configuration options.
 type KeycloakClientAdvancedSettings struct {
 	// AccessTokenLifespan is the access token lifespan in seconds for this client.
@@ -23,6 +52,69 @@ type KeycloakClientAdvancedSettings struct {
 	AccessTokenLifespan *int `json:"accessTokenLifespan,omitempty"`
 }
 
+// ConnectionSecret defines the Secret with the OIDC connection details of the client.
+// The Secret contains the following keys: clientId, clientSecret, issuerUrl, discoveryUrl,
+// authorizationUrl, tokenUrl, userinfoUrl, endSessionUrl and jwksUrl.
+// The URLs are based on the realm frontend URL if it is set.
+type ConnectionSecret struct {
+	// Name is a name of the Secret.
+	// The Secret is created in the namespace of the KeycloakClient and is owned by it.
+	// +required
+	Name string `json:"name"`
+
+	// Template is a map of additional Secret keys to Go templates of their values.
+	// It allows to use key names expected by applications, for example, GF_AUTH_GENERIC_OAUTH_CLIENT_ID for Grafana.
+	// The templates can use the following fields: .ClientID, .ClientSecret, .Realm, .IssuerURL, .DiscoveryURL,
+	// .AuthorizationURL, .TokenURL, .UserinfoURL, .EndSessionURL and .JWKSURL.
+	// +optional
+	// +kubebuilder:example={"OAUTH2_PROXY_CLIENT_ID": "{{ .ClientID }}", "OAUTH2_PROXY_OIDC_ISSUER_URL": "{{ .IssuerURL }}"}
+	Template map[string]string `json:"template,omitempty"`
+}
+
+// ClientTemplateRef is a reference to KeycloakClientTemplate or ClusterKeycloakClientTemplate.
+type ClientTemplateRef struct {
+	// Kind specifies the kind of the template.
+	// KeycloakClientTemplate must be in the namespace of the KeycloakClient.
+	// +kubebuilder:validation:Enum=KeycloakClientTemplate;ClusterKeycloakClientTemplate
+	// +kubebuilder:default=KeycloakClientTemplate
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name specifies the name of the template.
+	// +required
+	Name string `json:"name"`
+}
+
+const (
+	// RedirectSourceIngress is the kind of the Ingress redirect source.
+	RedirectSourceIngress = "Ingress"
+
+	// RedirectSourceHTTPRoute is the kind of the Gateway API HTTPRoute redirect source.
+	RedirectSourceHTTPRoute = "HTTPRoute"
+)
+
+// RedirectSource is a reference to an Ingress or HTTPRoute object
+// whose hostnames are used to build the redirect URIs of the client.
+type RedirectSource struct {
+	// Kind specifies the kind of the object.
+	// +kubebuilder:validation:Enum=Ingress;HTTPRoute
+	// +required
+	Kind string `json:"kind"`
+
+	// Name specifies the name of the object in the namespace of the KeycloakClient.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// PathSuffix is a template of the path appended to the URL of each hostname to build a redirect URI.
+	// It uses the Go template syntax with the .Host and .Path fields,
+	// where .Path is the path of the routing rule without the trailing slash.
+	// If not specified, "{{ .Path }}/*" is used.
+	// +optional
+	// +kubebuilder:example="{{ .Path }}/oauth2/callback"
+	PathSuffix string `json:"pathSuffix,omitempty"`
+}
+
 // KeycloakClientSpec defines the desired state of KeycloakClient.
 type KeycloakClientSpec struct {
 	// ClientId is a unique keycloak client ID referenced in URI and tokens.
@@ -32,6 +124,13 @@ type KeycloakClientSpec struct {
 	// +required
 	RealmRef common.RealmRef `json:"realmRef"`
 
+	// TemplateRef is a reference to the template with the settings shared by multiple clients.
+	// Attributes and protocol mappers of the template are merged with the client ones by key and name,
+	// other fields of the template are used only if they are not set in the client.
+	// Changes to the template are applied to all clients that reference it.
+	// +optional
+	TemplateRef *ClientTemplateRef `json:"templateRef,omitempty"`
+
 	// Secret is kubernetes secret name where the client's secret will be stored.
 	// Secret should have the following format: $secretName:secretKey.
 	// If not specified, a client secret will be generated and stored in a secret with the name keycloak-client-{metadata.name}-secret.
@@ -40,6 +139,18 @@ type KeycloakClientSpec struct {
 	// +kubebuilder:example="$keycloak-secret:client_secret"
 	Secret string `json:"secret,omitempty"`
 
+	// SecretRotation enables periodic rotation of the client secret.
+	// Rotation is supported only for secrets generated by the operator, so Secret should be empty
+	// or reference the Secret created by the operator for this KeycloakClient.
+	// The secret is rotated only if the secret-rotation executor is enabled in the realm client policies,
+	// which is reported in the SecretRotationReady condition.
+	// +optional
+	SecretRotation *SecretRotation `json:"secretRotation,omitempty"`
+
+	// ConnectionSecret is a Secret where the operator stores the OIDC connection details of the client.
+	// +optional
+	ConnectionSecret *ConnectionSecret `json:"connectionSecret,omitempty"`
+
 	// RealmRoles is a list of realm roles assigned to client.
 	// +nullable
 	// +optional
@@ -139,6 +250,13 @@ type KeycloakClientSpec struct {
 	// +kubebuilder:example={"https://example.com/*"}
 	WebOrigins []string `json:"webOrigins,omitempty"`
 
+	// RedirectFrom is a list of Ingress and HTTPRoute objects in the namespace of the KeycloakClient.
+	// URLs of their hostnames are added to the redirect URIs and web origins of the client
+	// and are used as the web url if it is not specified.
+	// +nullable
+	// +optional
+	RedirectFrom []RedirectSource `json:"redirectFrom,omitempty"`
+
 	// ImplicitFlowEnabled is a flag to enable support for OpenID Connect redirect based authentication without authorization code.
 	// +optional
 	ImplicitFlowEnabled bool `json:"implicitFlowEnabled,omitempty"`
@@ -162,6 +280,17 @@ type KeycloakClientSpec struct {
 	// +kubebuilder:default="client-secret"
 	ClientAuthenticatorType string `json:"clientAuthenticatorType,omitempty"`
 
+	// ClientAuthentication configures the client authenticator and its key material.
+	// If set, the authenticator type overrides ClientAuthenticatorType.
+	// +nullable
+	// +optional
+	ClientAuthentication *ClientAuthentication `json:"clientAuthentication,omitempty"`
+
+	// SAML is a configuration of the SAML client. It can be set only when Protocol is saml.
+	// +nullable
+	// +optional
+	SAML *SAMLSettings `json:"saml,omitempty"`
+
 	// ConsentRequired is a flag to enable consent.
 	// +optional
 	ConsentRequired bool `json:"consentRequired,omitempty"`
@@ -180,6 +309,12 @@ type KeycloakClientSpec struct {
 	// +kubebuilder:default=true
 	FullScopeAllowed bool `json:"fullScopeAllowed"`
 
+	// ScopeMappings is a list of realm and client roles in the scope of the client.
+	// Only these roles are included in tokens issued for the client when FullScopeAllowed is false.
+	// +nullable
+	// +optional
+	ScopeMappings *ScopeMappings `json:"scopeMappings,omitempty"`
+
 	// Name is a client name.
 	// +optional
 	Name string `json:"name,omitempty"`
@@ -209,6 +344,21 @@ type KeycloakClientSpec struct {
 	// AdvancedSettings contains advanced client configuration.
 	// +optional
 	AdvancedSettings *KeycloakClientAdvancedSettings `json:"advancedSettings,omitempty"`
+
+	// ReconcileMode defines how the operator reconciles the client.
+	// apply - the client configuration is applied to Keycloak.
+	// observe - the difference between the client configuration and Keycloak is reported
+	// in the Drifted condition, Keycloak is not modified.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	ReconcileMode common.ReconcileMode `json:"reconcileMode,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak client when the resource is deleted.
+	// Delete - the client is deleted from Keycloak.
+	// Retain - the client is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 type ServiceAccount struct {
@@ -244,6 +394,154 @@ type ServiceAccount struct {
 	Groups []string `json:"groups,omitempty"`
 }
 
+// ClientAuthentication defines how a confidential client authenticates to Keycloak.
+type ClientAuthentication struct {
+	// Type is the client authenticator type.
+	// client-jwt - the client signs a JWT with its private key, the public key is taken from JWKSURL or Certificate.
+	// client-secret-jwt - the client signs a JWT with the client secret.
+	// client-x509 - the client authenticates with a TLS client certificate that matches SubjectDN.
+	// +kubebuilder:validation:Enum=client-jwt;client-secret-jwt;client-x509
+	// +required
+	Type string `json:"type"`
+
+	// JWKSURL is the URL of the JSON Web Key Set with the client public keys.
+	// Used with the client-jwt authenticator, mutually exclusive with Certificate.
+	// +optional
+	// +kubebuilder:example="https://app.example.com/.well-known/jwks.json"
+	JWKSURL string `json:"jwksUrl,omitempty"`
+
+	// Certificate is a reference to a ConfigMap or Secret key with the PEM-encoded client certificate.
+	// Used with the client-jwt authenticator, mutually exclusive with JWKSURL.
+	// +optional
+	Certificate *common.SourceRef `json:"certificate,omitempty"`
+
+	// SubjectDN is a regular expression that the subject DN of the client certificate must match.
+	// The expression is evaluated by Keycloak using the Java regular expression syntax.
+	// Required for the client-x509 authenticator.
+	// +optional
+	// +kubebuilder:example="(.*?)(?:$)"
+	SubjectDN string `json:"subjectDn,omitempty"`
+
+	// SigningAlgorithm is the algorithm the client must use to sign the JWT.
+	// Used with the client-jwt and client-secret-jwt authenticators. If empty, any algorithm is accepted.
+	// +optional
+	// +kubebuilder:example="RS256"
+	SigningAlgorithm string `json:"signingAlgorithm,omitempty"`
+}
+
+// SAMLSettings defines the settings of the SAML client.
+type SAMLSettings struct {
+	// Metadata is a reference to a ConfigMap or Secret key with the SAML metadata of the service provider.
+	// The settings from the metadata are imported into the client, the other SAML settings and spec fields take precedence.
+	// +optional
+	Metadata *common.SourceRef `json:"metadata,omitempty"`
+
+	// NameIDFormat is the name ID format of the subject.
+	// +kubebuilder:validation:Enum=username;email;transient;persistent
+	// +optional
+	NameIDFormat string `json:"nameIdFormat,omitempty"`
+
+	// ForceNameIDFormat ignores the name ID format requested by the service provider and uses NameIDFormat.
+	// +optional
+	ForceNameIDFormat bool `json:"forceNameIdFormat,omitempty"`
+
+	// ForcePostBinding makes Keycloak use the POST binding for responses.
+	// +optional
+	ForcePostBinding bool `json:"forcePostBinding,omitempty"`
+
+	// AssertionConsumerServicePostURL is the URL of the assertion consumer service for the POST binding.
+	// +optional
+	// +kubebuilder:example="https://app.example.com/saml/acs"
+	AssertionConsumerServicePostURL string `json:"assertionConsumerServicePostUrl,omitempty"`
+
+	// AssertionConsumerServiceRedirectURL is the URL of the assertion consumer service for the redirect binding.
+	// +optional
+	AssertionConsumerServiceRedirectURL string `json:"assertionConsumerServiceRedirectUrl,omitempty"`
+
+	// SingleLogoutServicePostURL is the URL of the single logout service for the POST binding.
+	// +optional
+	SingleLogoutServicePostURL string `json:"singleLogoutServicePostUrl,omitempty"`
+
+	// SingleLogoutServiceRedirectURL is the URL of the single logout service for the redirect binding.
+	// +optional
+	SingleLogoutServiceRedirectURL string `json:"singleLogoutServiceRedirectUrl,omitempty"`
+
+	// IDPInitiatedSSOURLName is the URL fragment name of the client for IdP-initiated SSO.
+	// The SSO URL is {keycloak}/realms/{realm}/protocol/saml/clients/{name}.
+	// +optional
+	// +kubebuilder:example="my-app"
+	IDPInitiatedSSOURLName string `json:"idpInitiatedSsoUrlName,omitempty"`
+
+	// IDPInitiatedSSORelayState is the relay state sent with the SAML response in IdP-initiated SSO.
+	// +optional
+	IDPInitiatedSSORelayState string `json:"idpInitiatedSsoRelayState,omitempty"`
+
+	// Signing is the signature configuration of SAML documents.
+	// +optional
+	Signing *SAMLSigning `json:"signing,omitempty"`
+
+	// Encryption is the encryption configuration of SAML assertions.
+	// +optional
+	Encryption *SAMLEncryption `json:"encryption,omitempty"`
+}
+
+// SAMLSigning defines the signature configuration of the SAML client.
+type SAMLSigning struct {
+	// SignDocuments makes Keycloak sign SAML documents.
+	// +optional
+	SignDocuments bool `json:"signDocuments,omitempty"`
+
+	// SignAssertions makes Keycloak sign SAML assertions.
+	// +optional
+	SignAssertions bool `json:"signAssertions,omitempty"`
+
+	// Algorithm is the signature algorithm.
+	// +kubebuilder:validation:Enum=RSA_SHA1;RSA_SHA256;RSA_SHA256_MGF1;RSA_SHA512;RSA_SHA512_MGF1;DSA_SHA1
+	// +kubebuilder:default=RSA_SHA256
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// ClientSignatureRequired makes Keycloak require signed requests from the client.
+	// The signatures are verified with the certificate from KeyPair.
+	// +optional
+	ClientSignatureRequired bool `json:"clientSignatureRequired,omitempty"`
+
+	// KeyPair is the signing key pair of the client.
+	// +optional
+	KeyPair *SAMLKeyPair `json:"keyPair,omitempty"`
+}
+
+// SAMLEncryption defines the encryption configuration of the SAML client.
+type SAMLEncryption struct {
+	// EncryptAssertions makes Keycloak encrypt SAML assertions with the certificate from KeyPair.
+	// +optional
+	EncryptAssertions bool `json:"encryptAssertions,omitempty"`
+
+	// KeyPair is the encryption key pair of the client.
+	// +optional
+	KeyPair *SAMLKeyPair `json:"keyPair,omitempty"`
+}
+
+// SAMLKeyPair is a reference to a key pair of the SAML client.
+type SAMLKeyPair struct {
+	// SecretName is the name of a kubernetes.io/tls Secret with the PEM-encoded certificate under the tls.crt key
+	// and the optional PEM-encoded private key under the tls.key key.
+	// +required
+	SecretName string `json:"secretName"`
+}
+
+type ScopeMappings struct {
+	// RealmRoles is a list of realm roles in the scope of the client.
+	// +nullable
+	// +optional
+	RealmRoles []string `json:"realmRoles,omitempty"`
+
+	// ClientRoles is a list of client roles in the scope of the client.
+	// +nullable
+	// +optional
+	ClientRoles []UserClientRole `json:"clientRoles,omitempty"`
+}
+
 type UserClientRole struct {
 	// ClientID is a client ID.
 	ClientID string `json:"clientId"`
@@ -299,6 +597,15 @@ type RealmRole struct {
 }
 
 type Authorization struct {
+	// FromConfigMap is a reference to a ConfigMap key with the authorization settings of the resource server
+	// exported from Keycloak in JSON format: scopes, resources, policies, permissions,
+	// policyEnforcementMode and decisionStrategy.
+	// The settings are imported into the client, so Scopes, Policies, Permissions and Resources must be empty.
+	// With the full reconciliation strategy, the settings missing in the export are deleted from the client.
+	// The settings are imported again only when the ConfigMap data changes.
+	// +optional
+	FromConfigMap *common.ConfigMapKeySelector `json:"fromConfigMap,omitempty"`
+
 	Scopes []string `json:"scopes,omitempty"`
 
 	Policies []Policy `json:"policies,omitempty"`
@@ -306,6 +613,13 @@ type Authorization struct {
 	Permissions []Permission `json:"permissions,omitempty"`
 
 	Resources []Resource `json:"resources,omitempty"`
+
+	// Tests is a list of test cases of the authorization settings.
+	// The test cases are evaluated after the permissions are synced,
+	// the results are reported in the AuthorizationTestsPassed condition and status.authorizationTests.
+	// Failed test cases don't fail the reconciliation.
+	// +optional
+	Tests []AuthorizationTest `json:"tests,omitempty"`
 }
 
 type AuthenticationFlowBindingOverrides struct {
@@ -335,15 +649,42 @@ type KeycloakClientStatus struct {
 	// +optional
 	FailureCount int64 `json:"failureCount,omitempty"`
 
+	// SecretRotatedAt is a time of the last client secret rotation.
+	// +nullable
+	// +optional
+	SecretRotatedAt *metav1.Time `json:"secretRotatedAt,omitempty"`
+
+	// TemplateGeneration is the generation of the template resolved during the last reconciliation.
+	// +optional
+	TemplateGeneration int64 `json:"templateGeneration,omitempty"`
+
+	// AuthorizationImportHash is a SHA-256 hash of the authorization settings last imported from the ConfigMap.
+	// The settings are imported again only when the ConfigMap data changes.
+	// +optional
+	AuthorizationImportHash string `json:"authorizationImportHash,omitempty"`
+
+	// AuthorizationTests is a list of results of the authorization test cases.
+	// +optional
+	AuthorizationTests []AuthorizationTestResult `json:"authorizationTests,omitempty"`
+
 	// Conditions represent the latest available observations of an object's state.
 	// +optional
 	// +nullable
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 
 // KeycloakClient is the Schema for the keycloak clients API.
@@ -355,6 +696,10 @@ type KeycloakClient struct {
 	Status KeycloakClientStatus `json:"status,omitempty"`
 }
 
+func (in *KeycloakClient) GetReconcileMode() common.ReconcileMode {
+	return in.Spec.ReconcileMode
+}
+
 func (in *KeycloakClient) GetFailureCount() int64 {
 	return in.Status.FailureCount
 }
@@ -383,6 +728,30 @@ func (in *KeycloakClient) GetRealmRef() common.RealmRef {
 	return in.Spec.RealmRef
 }
 
+func (in *KeycloakClient) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakClient) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakClient) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakClient) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakClient) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakClient) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakClientList contains a list of KeycloakClient.
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+)
+
+// ClientInitialAccessTokenSecretKey is a key of the Secret that holds the initial access token.
+const ClientInitialAccessTokenSecretKey = "token"
+
+// KeycloakClientInitialAccessTokenSpec defines the desired state of KeycloakClientInitialAccessToken.
+type KeycloakClientInitialAccessTokenSpec struct {
+	// RealmRef is reference to Realm custom resource.
+	// +required
+	RealmRef common.RealmRef `json:"realmRef"`
+
+	// Count is a number of clients that can be registered with the token.
+	// When the token is exhausted, a new one is created.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// Expiration is a token lifetime in seconds. 0 means the token doesn't expire.
+	// When the token expires, a new one is created.
+	// +kubebuilder:default=86400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Expiration int32 `json:"expiration,omitempty"`
+
+	// WebOrigins is a list of allowed web origins for clients registered with the token.
+	// +optional
+	WebOrigins []string `json:"webOrigins,omitempty"`
+
+	// SecretName is a name of the Secret where the token is stored under the "token" key.
+	// The Secret is owned by the KeycloakClientInitialAccessToken.
+	// If not specified, the KeycloakClientInitialAccessToken name is used.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak token when the resource is deleted.
+	// Delete - the token is deleted from Keycloak.
+	// Retain - the token is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// KeycloakClientInitialAccessTokenStatus defines the observed state of KeycloakClientInitialAccessToken.
+type KeycloakClientInitialAccessTokenStatus struct {
+	// Value is a status of the last reconciliation.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// FailureCount is a number of failed reconciliations in a row.
+	// +optional
+	FailureCount int64 `json:"failureCount,omitempty"`
+
+	// TokenID is a Keycloak ID of the current token.
+	// +optional
+	TokenID string `json:"tokenId,omitempty"`
+
+	// SecretName is a name of the Secret with the current token.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// RemainingCount is a number of clients that can still be registered with the current token.
+	// +optional
+	RemainingCount int32 `json:"remainingCount,omitempty"`
+
+	// IssuedAt is a time when the current token was created.
+	// +nullable
+	// +optional
+	IssuedAt *metav1.Time `json:"issuedAt,omitempty"`
+
+	// ExpiresAt is a time when the current token expires.
+	// +nullable
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".status.secretName",description="Secret with the token"
+// +kubebuilder:printcolumn:name="Remaining",type="integer",JSONPath=".status.remainingCount",description="Remaining number of registrations"
+// +kubebuilder:printcolumn:name="Expires",type="date",JSONPath=".status.expiresAt",description="Token expiration time"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
+
+// KeycloakClientInitialAccessToken is the Schema for the client initial access tokens API.
+type KeycloakClientInitialAccessToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeycloakClientInitialAccessTokenSpec   `json:"spec,omitempty"`
+	Status KeycloakClientInitialAccessTokenStatus `json:"status,omitempty"`
+}
+
+func (in *KeycloakClientInitialAccessToken) GetFailureCount() int64 {
+	return in.Status.FailureCount
+}
+
+func (in *KeycloakClientInitialAccessToken) SetFailureCount(count int64) {
+	in.Status.FailureCount = count
+}
+
+func (in *KeycloakClientInitialAccessToken) GetStatus() string {
+	return in.Status.Value
+}
+
+func (in *KeycloakClientInitialAccessToken) SetStatus(value string) {
+	in.Status.Value = value
+}
+
+func (in *KeycloakClientInitialAccessToken) GetRealmRef() common.RealmRef {
+	return in.Spec.RealmRef
+}
+
+// GetSecretName returns the name of the Secret where the token is stored.
+func (in *KeycloakClientInitialAccessToken) GetSecretName() string {
+	if in.Spec.SecretName != "" {
+		return in.Spec.SecretName
+	}
+
+	return in.Name
+}
+
+func (in *KeycloakClientInitialAccessToken) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakClientInitialAccessToken) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakClientInitialAccessToken) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakClientInitialAccessToken) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakClientInitialAccessToken) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakClientInitialAccessToken) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// +kubebuilder:object:root=true
+
+// KeycloakClientInitialAccessTokenList contains a list of KeycloakClientInitialAccessToken.
+type KeycloakClientInitialAccessTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KeycloakClientInitialAccessToken `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KeycloakClientInitialAccessToken{}, &KeycloakClientInitialAccessTokenList{})
+}
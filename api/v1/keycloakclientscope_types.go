@@ -51,6 +51,13 @@ type KeycloakClientScopeSpec struct {
 	// +nullable
 	// +optional
 	ProtocolMappers []ProtocolMapper `json:"protocolMappers,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak client scope when the resource is deleted.
+	// Delete - the client scope is deleted from Keycloak.
+	// Retain - the client scope is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // KeycloakClientScopeStatus defines the observed state of KeycloakClientScope.
@@ -63,11 +70,25 @@ type KeycloakClientScopeStatus struct {
 
 	// +optional
 	FailureCount int64 `json:"failureCount,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 
 // KeycloakClientScope is the Schema for the keycloakclientscopes API.
@@ -121,6 +142,30 @@ func (in *KeycloakClientScope) IsTypeNone() bool {
 	return in.GetType() == KeycloakClientScopeTypeNone
 }
 
+func (in *KeycloakClientScope) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakClientScope) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakClientScope) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakClientScope) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakClientScope) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakClientScope) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakClientScopeList contains a list of KeycloakClientScope.
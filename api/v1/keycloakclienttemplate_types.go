@@ -0,0 +1,91 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KeycloakClientTemplateSpec defines the settings shared by KeycloakClient resources that reference the template.
+// The template is merged with the KeycloakClient spec:
+// attributes and protocol mappers are merged by key and name, other fields are taken from the template
+// only if they are not set in the KeycloakClient. Values set in the KeycloakClient always take precedence.
+type KeycloakClientTemplateSpec struct {
+	// Protocol is a client protocol.
+	// +optional
+	// +kubebuilder:example="openid-connect"
+	Protocol *string `json:"protocol,omitempty"`
+
+	// StandardFlowEnabled is a flag to enable standard flow.
+	// It is used if the KeycloakClient doesn't disable standard flow.
+	// +optional
+	StandardFlowEnabled *bool `json:"standardFlowEnabled,omitempty"`
+
+	// DirectAccess is a flag to set client as direct access.
+	// It is used if the KeycloakClient doesn't enable direct access.
+	// +optional
+	DirectAccess *bool `json:"directAccess,omitempty"`
+
+	// ImplicitFlowEnabled is a flag to enable support for OpenID Connect redirect based authentication without authorization code.
+	// It is used if the KeycloakClient doesn't enable implicit flow.
+	// +optional
+	ImplicitFlowEnabled *bool `json:"implicitFlowEnabled,omitempty"`
+
+	// Attributes is a map of client attributes.
+	// +nullable
+	// +optional
+	// +kubebuilder:example={"pkce.code.challenge.method": "S256"}
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// DefaultClientScopes is a list of default client scopes assigned to client.
+	// +nullable
+	// +optional
+	DefaultClientScopes []string `json:"defaultClientScopes,omitempty"`
+
+	// OptionalClientScopes is a list of optional client scopes assigned to client.
+	// +nullable
+	// +optional
+	OptionalClientScopes []string `json:"optionalClientScopes,omitempty"`
+
+	// WebOrigins is a list of allowed CORS origins.
+	// +nullable
+	// +optional
+	WebOrigins []string `json:"webOrigins,omitempty"`
+
+	// ProtocolMappers is a list of protocol mappers assigned to client.
+	// +nullable
+	// +optional
+	ProtocolMappers []ProtocolMapper `json:"protocolMappers,omitempty"`
+
+	// AuthenticationFlowBindingOverrides client auth flow overrides.
+	// +optional
+	AuthenticationFlowBindingOverrides *AuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides,omitempty"`
+
+	// AdvancedSettings contains advanced client configuration, for example, token lifespans.
+	// +optional
+	AdvancedSettings *KeycloakClientAdvancedSettings `json:"advancedSettings,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// KeycloakClientTemplate is the Schema for the keycloakclienttemplates API.
+// It holds the defaults shared by KeycloakClient resources in the namespace.
+type KeycloakClientTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec KeycloakClientTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeycloakClientTemplateList contains a list of KeycloakClientTemplate.
+type KeycloakClientTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KeycloakClientTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KeycloakClientTemplate{}, &KeycloakClientTemplateList{})
+}
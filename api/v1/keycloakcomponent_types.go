@@ -34,6 +34,13 @@ type KeycloakComponentSpec struct {
 	// +nullable
 	// +optional
 	Config map[string][]string `json:"config,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak component when the resource is deleted.
+	// Delete - the component is deleted from Keycloak.
+	// Retain - the component is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // KeycloakComponentStatus defines the observed state of KeycloakRealmComponent.
@@ -43,6 +50,24 @@ type KeycloakComponentStatus struct {
 
 	// +optional
 	Value string `json:"value,omitempty"`
+
+	// ConfigHash is a hash of the configuration last applied to Keycloak.
+	// Keycloak doesn't return secrets, so changes of secret values are detected by the hash.
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // ParentComponent defines the parent component of KeycloakRealmComponent.
@@ -61,6 +86,7 @@ type ParentComponent struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 
 // KeycloakRealmComponent is the Schema for the keycloak component API.
@@ -84,6 +110,30 @@ func (in *KeycloakRealmComponent) GetRealmRef() common.RealmRef {
 	return in.Spec.RealmRef
 }
 
+func (in *KeycloakRealmComponent) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakRealmComponent) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakRealmComponent) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakRealmComponent) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakRealmComponent) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakRealmComponent) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakRealmComponentList contains a list of KeycloakRealmComponent.
@@ -23,10 +23,17 @@ type KeycloakRealmSpec struct {
 	Users []User `json:"users,omitempty"`
 
 	// BrowserFlow specifies the authentication flow to use for the realm's browser clients.
+	// Deprecated: use AuthenticationFlows.BrowserFlow instead.
 	// +nullable
 	// +optional
 	BrowserFlow *string `json:"browserFlow,omitempty"`
 
+	// AuthenticationFlows binds realm authentication flows.
+	// If a flow is managed by KeycloakAuthFlow resource, the binding waits until the resource is ready.
+	// +nullable
+	// +optional
+	AuthenticationFlows *common.AuthenticationFlowBindings `json:"authenticationFlows,omitempty"`
+
 	// Themes is a map of themes to apply to the realm.
 	// +nullable
 	// +optional
@@ -115,6 +122,25 @@ type KeycloakRealmSpec struct {
 	// +nullable
 	// +optional
 	BruteForceDetection *common.BruteForceDetection `json:"bruteForceDetection,omitempty"`
+
+	// ClientRegistrationPolicies defines anonymous and authenticated client registration policies of the realm.
+	// +optional
+	ClientRegistrationPolicies *common.ClientRegistrationPolicies `json:"clientRegistrationPolicies,omitempty"`
+
+	// ReconcileMode defines how the operator reconciles the realm.
+	// apply - the realm configuration is applied to Keycloak.
+	// observe - the difference between the realm settings and Keycloak is reported
+	// in the Drifted condition, Keycloak is not modified.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	ReconcileMode common.ReconcileMode `json:"reconcileMode,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak realm when the resource is deleted.
+	// Delete - the realm is deleted from Keycloak.
+	// Retain - the realm is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 type User struct {
@@ -247,6 +273,28 @@ type KeycloakRealmStatus struct {
 
 	// +optional
 	Value string `json:"value,omitempty"`
+
+	// ClientRegistrationPolicies is a list of client registration policies created by the operator
+	// in the form of <subType>/<name>. Only these policies are deleted when removed from the spec.
+	// +optional
+	ClientRegistrationPolicies []string `json:"clientRegistrationPolicies,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
+}
+
+func (in *KeycloakRealm) GetReconcileMode() common.ReconcileMode {
+	return in.Spec.ReconcileMode
 }
 
 func (in *KeycloakRealm) GetFailureCount() int64 {
@@ -260,6 +308,7 @@ func (in *KeycloakRealm) SetFailureCount(count int64) {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Available",type="boolean",JSONPath=".status.available",description="Is the resource available"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 // +kubebuilder:printcolumn:name="Realm",type="boolean",JSONPath=".spec.realmName",description="Keycloak realm name"
@@ -274,6 +323,30 @@ type KeycloakRealm struct {
 	Status KeycloakRealmStatus `json:"status,omitempty"`
 }
 
+func (in *KeycloakRealm) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakRealm) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakRealm) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakRealm) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakRealm) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakRealm) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakRealmList contains a list of KeycloakRealm.
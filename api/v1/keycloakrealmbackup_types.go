@@ -0,0 +1,213 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+)
+
+// KeycloakRealmBackupSpec defines the desired state of KeycloakRealmBackup.
+type KeycloakRealmBackupSpec struct {
+	// RealmRef is reference to Realm custom resource.
+	// +required
+	RealmRef common.RealmRef `json:"realmRef"`
+
+	// Schedule is a cron expression that defines when the realm backup is taken.
+	// Standard five-field format (minute hour day-of-month month day-of-week) is supported,
+	// as well as descriptors @yearly, @monthly, @weekly, @daily, @hourly and @every <duration>.
+	// Schedule is evaluated in UTC.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:example="0 2 * * *"
+	Schedule string `json:"schedule"`
+
+	// IncludeClients includes realm clients into the backup.
+	// +optional
+	IncludeClients bool `json:"includeClients,omitempty"`
+
+	// IncludeGroupsAndRoles includes realm groups and roles into the backup.
+	// +optional
+	IncludeGroupsAndRoles bool `json:"includeGroupsAndRoles,omitempty"`
+
+	// RedactSecrets replaces values of secret fields (client secrets, passwords, credentials, private keys)
+	// with a mask before the backup is stored.
+	// Keycloak already masks most of the secret values in the export; this option covers the rest of them.
+	// +kubebuilder:default=true
+	// +optional
+	RedactSecrets *bool `json:"redactSecrets,omitempty"`
+
+	// Retention is a number of the latest backups to keep. Older backups are removed.
+	// +kubebuilder:default=7
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Retention int `json:"retention,omitempty"`
+
+	// Destination defines where backups are stored.
+	// +required
+	Destination RealmBackupDestination `json:"destination"`
+}
+
+// RealmBackupDestination defines where realm backups are stored.
+// Exactly one of the destinations must be set.
+// +kubebuilder:validation:XValidation:rule="(has(self.secret) ? 1 : 0) + (has(self.configMap) ? 1 : 0) + (has(self.volume) ? 1 : 0) == 1",message="exactly one of secret, configMap or volume must be set"
+type RealmBackupDestination struct {
+	// Secret stores backups in Secrets in the namespace of the KeycloakRealmBackup.
+	// +optional
+	Secret *RealmBackupObjectDestination `json:"secret,omitempty"`
+
+	// ConfigMap stores backups in ConfigMaps in the namespace of the KeycloakRealmBackup.
+	// +optional
+	ConfigMap *RealmBackupObjectDestination `json:"configMap,omitempty"`
+
+	// Volume stores backups as files on a volume mounted into the operator pod.
+	// +optional
+	Volume *RealmBackupVolumeDestination `json:"volume,omitempty"`
+}
+
+// RealmBackupObjectDestination defines Secret or ConfigMap destination of realm backups.
+type RealmBackupObjectDestination struct {
+	// NamePrefix is a prefix for names of objects that hold backup data.
+	// If not specified, the KeycloakRealmBackup name is used.
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+
+	// ChunkSize is a maximum size of backup data in bytes stored in a single object.
+	// Backups that exceed this size are split across several objects.
+	// +kubebuilder:default=524288
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=1000000
+	// +optional
+	ChunkSize int `json:"chunkSize,omitempty"`
+}
+
+// RealmBackupVolumeDestination defines volume destination of realm backups.
+type RealmBackupVolumeDestination struct {
+	// Path is a directory where backup files are written.
+	// The volume must be mounted into the operator pod, for example, with the realmBackups.volume Helm chart values.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:example="/backups"
+	Path string `json:"path"`
+}
+
+// KeycloakRealmBackupStatus defines the observed state of KeycloakRealmBackup.
+type KeycloakRealmBackupStatus struct {
+	// Value is a status of the last reconciliation.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// FailureCount is a number of failed reconciliations in a row.
+	// +optional
+	FailureCount int64 `json:"failureCount,omitempty"`
+
+	// LastBackupTime is a time of the last successful backup.
+	// +nullable
+	// +optional
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// NextBackupTime is a time of the next scheduled backup.
+	// +nullable
+	// +optional
+	NextBackupTime *metav1.Time `json:"nextBackupTime,omitempty"`
+
+	// LastBackupName is a name of the last backup.
+	// For Secret and ConfigMap destinations it is a common name prefix of the objects holding the backup,
+	// for volume destination it is a file path.
+	// +optional
+	LastBackupName string `json:"lastBackupName,omitempty"`
+
+	// LastBackupChecksum is a SHA-256 checksum of the last backup data.
+	// +optional
+	LastBackupChecksum string `json:"lastBackupChecksum,omitempty"`
+
+	// LastBackupSize is a size of the last backup data in bytes.
+	// +optional
+	LastBackupSize int64 `json:"lastBackupSize,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="Backup schedule"
+// +kubebuilder:printcolumn:name="Last Backup",type="date",JSONPath=".status.lastBackupTime",description="Time of the last backup"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
+
+// KeycloakRealmBackup is the Schema for the scheduled realm backups API.
+type KeycloakRealmBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeycloakRealmBackupSpec   `json:"spec,omitempty"`
+	Status KeycloakRealmBackupStatus `json:"status,omitempty"`
+}
+
+func (in *KeycloakRealmBackup) GetFailureCount() int64 {
+	return in.Status.FailureCount
+}
+
+func (in *KeycloakRealmBackup) SetFailureCount(count int64) {
+	in.Status.FailureCount = count
+}
+
+func (in *KeycloakRealmBackup) GetStatus() string {
+	return in.Status.Value
+}
+
+func (in *KeycloakRealmBackup) SetStatus(value string) {
+	in.Status.Value = value
+}
+
+func (in *KeycloakRealmBackup) GetRealmRef() common.RealmRef {
+	return in.Spec.RealmRef
+}
+
+// ShouldRedactSecrets returns true if secret values must be masked in the backup.
+func (in *KeycloakRealmBackup) ShouldRedactSecrets() bool {
+	return in.Spec.RedactSecrets == nil || *in.Spec.RedactSecrets
+}
+
+func (in *KeycloakRealmBackup) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakRealmBackup) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakRealmBackup) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakRealmBackup) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakRealmBackup) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// +kubebuilder:object:root=true
+
+// KeycloakRealmBackupList contains a list of KeycloakRealmBackup.
+type KeycloakRealmBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KeycloakRealmBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KeycloakRealmBackup{}, &KeycloakRealmBackupList{})
+}
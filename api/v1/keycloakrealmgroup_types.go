@@ -55,6 +55,13 @@ type KeycloakRealmGroupSpec struct {
 	// +nullable
 	// +optional
 	ClientRoles []UserClientRole `json:"clientRoles,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak group when the resource is deleted.
+	// Delete - the group is deleted from Keycloak.
+	// Retain - the group is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // KeycloakRealmGroupStatus defines the observed state of KeycloakRealmGroup.
@@ -68,6 +75,19 @@ type KeycloakRealmGroupStatus struct {
 
 	// +optional
 	FailureCount int64 `json:"failureCount,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 func (in *KeycloakRealmGroup) GetFailureCount() int64 {
@@ -93,6 +113,7 @@ func (in *KeycloakRealmGroup) GetRealmRef() common.RealmRef {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 
 // KeycloakRealmGroup is the Schema for the keycloak group API.
@@ -104,6 +125,30 @@ type KeycloakRealmGroup struct {
 	Status KeycloakRealmGroupStatus `json:"status,omitempty"`
 }
 
+func (in *KeycloakRealmGroup) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakRealmGroup) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakRealmGroup) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakRealmGroup) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakRealmGroup) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakRealmGroup) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakRealmGroupList contains a list of KeycloakRealmGroup.
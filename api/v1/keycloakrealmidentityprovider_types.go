@@ -79,6 +79,13 @@ type KeycloakRealmIdentityProviderSpec struct {
 	// If hidden, login with this provider is possible only if requested explicitly, for example using the 'kc_idp_hint' parameter.
 	// +optional
 	HideOnLogin *bool `json:"hideOnLogin,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak identity provider when the resource is deleted.
+	// Delete - the identity provider is deleted from Keycloak.
+	// Retain - the identity provider is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 type IdentityProviderMapper struct {
@@ -107,11 +114,30 @@ type KeycloakRealmIdentityProviderStatus struct {
 
 	// +optional
 	FailureCount int64 `json:"failureCount,omitempty"`
+
+	// ConfigHash is a hash of the configuration last applied to Keycloak.
+	// Keycloak doesn't return secrets, so changes of secret values are detected by the hash.
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 
 // KeycloakRealmIdentityProvider is the Schema for the keycloak realm identity provider API.
@@ -143,6 +169,30 @@ func (in *KeycloakRealmIdentityProvider) GetRealmRef() common.RealmRef {
 	return in.Spec.RealmRef
 }
 
+func (in *KeycloakRealmIdentityProvider) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakRealmIdentityProvider) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakRealmIdentityProvider) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakRealmIdentityProvider) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakRealmIdentityProvider) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakRealmIdentityProvider) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakRealmIdentityProviderList contains a list of KeycloakRealmIdentityProvider.
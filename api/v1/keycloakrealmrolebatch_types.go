@@ -16,6 +16,13 @@ type KeycloakRealmRoleBatchSpec struct {
 
 	// Roles is a list of roles to be created.
 	Roles []BatchRole `json:"roles"`
+
+	// DeletionPolicy defines what happens to the Keycloak roles created by the batch when the resource is deleted.
+	// Delete - the roles created by the batch are deleted from Keycloak.
+	// Retain - the roles created by the batch are kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 type BatchRole struct {
@@ -52,11 +59,25 @@ type KeycloakRealmRoleBatchStatus struct {
 
 	// +optional
 	FailureCount int64 `json:"failureCount,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 
 // KeycloakRealmRoleBatch is the Schema for the keycloak roles API.
@@ -92,6 +113,30 @@ func (in *KeycloakRealmRoleBatch) GetRealmRef() common.RealmRef {
 	return in.Spec.RealmRef
 }
 
+func (in *KeycloakRealmRoleBatch) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakRealmRoleBatch) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakRealmRoleBatch) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakRealmRoleBatch) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakRealmRoleBatch) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakRealmRoleBatch) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakRealmRoleBatchList contains a list of KeycloakRealmRoleBatch.
@@ -0,0 +1,586 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+)
+
+const (
+	// UserFederationSyncAnnotation triggers synchronization of users from the federation provider.
+	// Supported values are "full" and "changed". The annotation is removed after the synchronization.
+	UserFederationSyncAnnotation = "edp.epam.com/user-federation-sync"
+
+	// UserFederationTestConnectionAnnotation triggers a connection and authentication test of the LDAP server
+	// when set to "true". The annotation is removed after the test.
+	UserFederationTestConnectionAnnotation = "edp.epam.com/user-federation-test-connection"
+
+	// UserFederationSyncFull is a value of UserFederationSyncAnnotation that triggers full synchronization.
+	UserFederationSyncFull = "full"
+
+	// UserFederationSyncChanged is a value of UserFederationSyncAnnotation that triggers
+	// synchronization of users changed since the last synchronization.
+	UserFederationSyncChanged = "changed"
+)
+
+// KeycloakUserFederationSpec defines the desired state of KeycloakUserFederation.
+// +kubebuilder:validation:XValidation:rule="self.providerId != 'ldap' || has(self.ldap)",message="ldap is required for ldap provider"
+// +kubebuilder:validation:XValidation:rule="self.providerId != 'kerberos' || has(self.kerberos)",message="kerberos is required for kerberos provider"
+// +kubebuilder:validation:XValidation:rule="self.providerId != 'kerberos' || (!has(self.ldap) && !has(self.mappers))",message="ldap and mappers are not supported for kerberos provider"
+type KeycloakUserFederationSpec struct {
+	// Name of the user federation provider in Keycloak.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// RealmRef is reference to Realm custom resource.
+	// +required
+	RealmRef common.RealmRef `json:"realmRef"`
+
+	// ProviderID is a type of the user federation provider.
+	// +kubebuilder:validation:Enum=ldap;kerberos
+	// +kubebuilder:default=ldap
+	// +optional
+	ProviderID string `json:"providerId,omitempty"`
+
+	// Enabled indicates whether the provider is enabled.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Priority of the provider when doing a user lookup. Lowest first.
+	// +optional
+	Priority int `json:"priority,omitempty"`
+
+	// CachePolicy is a cache policy for the provider.
+	// +kubebuilder:validation:Enum=DEFAULT;EVICT_DAILY;EVICT_WEEKLY;MAX_LIFESPAN;NO_CACHE
+	// +optional
+	CachePolicy string `json:"cachePolicy,omitempty"`
+
+	// LDAP contains settings of the LDAP provider.
+	// +optional
+	LDAP *LDAPFederationSettings `json:"ldap,omitempty"`
+
+	// Kerberos contains Kerberos settings.
+	// Required for kerberos provider. For ldap provider enables Kerberos authentication.
+	// +optional
+	Kerberos *KerberosFederationSettings `json:"kerberos,omitempty"`
+
+	// Mappers is a list of LDAP mappers of the provider.
+	// Mappers created by Keycloak by default are kept untouched unless they are listed here.
+	// +optional
+	Mappers []UserFederationMapper `json:"mappers,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak user federation when the resource is deleted.
+	// Delete - the user federation is deleted from Keycloak.
+	// Retain - the user federation is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// LDAPFederationSettings defines settings of the LDAP user federation provider.
+type LDAPFederationSettings struct {
+	// Vendor is an LDAP vendor.
+	// +kubebuilder:validation:Enum=other;ad;rhds;tivoli;edirectory
+	// +kubebuilder:default=other
+	// +optional
+	Vendor string `json:"vendor,omitempty"`
+
+	// Connection defines how Keycloak connects to the LDAP server.
+	// +required
+	Connection LDAPConnection `json:"connection"`
+
+	// EditMode defines how Keycloak propagates user changes to LDAP.
+	// +kubebuilder:validation:Enum=READ_ONLY;WRITABLE;UNSYNCED
+	// +kubebuilder:default=READ_ONLY
+	// +optional
+	EditMode string `json:"editMode,omitempty"`
+
+	// ImportEnabled indicates whether LDAP users are imported into the Keycloak database.
+	// +kubebuilder:default=true
+	// +optional
+	ImportEnabled *bool `json:"importEnabled,omitempty"`
+
+	// SyncRegistrations indicates whether newly created users are created in LDAP.
+	// +optional
+	SyncRegistrations bool `json:"syncRegistrations,omitempty"`
+
+	// UsersDN is a full DN of the LDAP tree where users are.
+	// +kubebuilder:example="ou=users,dc=example,dc=com"
+	// +kubebuilder:validation:MinLength=1
+	UsersDN string `json:"usersDn"`
+
+	// UsernameLDAPAttribute is an LDAP attribute mapped as Keycloak username.
+	// +kubebuilder:default=uid
+	// +optional
+	UsernameLDAPAttribute string `json:"usernameLDAPAttribute,omitempty"`
+
+	// RDNLDAPAttribute is an LDAP attribute used as RDN of the user DN.
+	// +kubebuilder:default=uid
+	// +optional
+	RDNLDAPAttribute string `json:"rdnLDAPAttribute,omitempty"`
+
+	// UUIDLDAPAttribute is an LDAP attribute used as a unique object identifier.
+	// +kubebuilder:default=entryUUID
+	// +optional
+	UUIDLDAPAttribute string `json:"uuidLDAPAttribute,omitempty"`
+
+	// UserObjectClasses are object classes of users in LDAP.
+	// +kubebuilder:default={"inetOrgPerson","organizationalPerson"}
+	// +optional
+	UserObjectClasses []string `json:"userObjectClasses,omitempty"`
+
+	// CustomUserSearchFilter is an additional LDAP filter for users. Must start with "(" and end with ")".
+	// +optional
+	CustomUserSearchFilter string `json:"customUserSearchFilter,omitempty"`
+
+	// SearchScope defines whether users are searched only in UsersDN or in the whole subtree.
+	// +kubebuilder:validation:Enum=OneLevel;Subtree
+	// +kubebuilder:default=OneLevel
+	// +optional
+	SearchScope string `json:"searchScope,omitempty"`
+
+	// Pagination indicates whether the LDAP server supports pagination.
+	// +optional
+	Pagination bool `json:"pagination,omitempty"`
+
+	// Sync defines periodic synchronization of users.
+	// +optional
+	Sync *UserFederationSyncSettings `json:"sync,omitempty"`
+}
+
+// LDAPConnection defines connection to the LDAP server.
+type LDAPConnection struct {
+	// URL is a connection URL of the LDAP server.
+	// +kubebuilder:example="ldaps://ldap.example.com:636"
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// StartTLS enables encryption of the connection with StartTLS.
+	// +optional
+	StartTLS bool `json:"startTls,omitempty"`
+
+	// UseTruststoreSPI defines whether the Keycloak truststore is used for LDAPS connections.
+	// +kubebuilder:validation:Enum=always;never
+	// +kubebuilder:default=always
+	// +optional
+	UseTruststoreSPI string `json:"useTruststoreSpi,omitempty"`
+
+	// ConnectionTimeout is an LDAP connection timeout in milliseconds.
+	// +optional
+	ConnectionTimeout *int `json:"connectionTimeout,omitempty"`
+
+	// ConnectionPooling indicates whether connection pooling is used.
+	// +kubebuilder:default=true
+	// +optional
+	ConnectionPooling *bool `json:"connectionPooling,omitempty"`
+
+	// BindType is an authentication type of the LDAP bind operation.
+	// +kubebuilder:validation:Enum=simple;none
+	// +kubebuilder:default=simple
+	// +optional
+	BindType string `json:"bindType,omitempty"`
+
+	// BindDN is a DN of the LDAP admin used by Keycloak to access the LDAP server.
+	// +kubebuilder:example="cn=admin,dc=example,dc=com"
+	// +optional
+	BindDN string `json:"bindDn,omitempty"`
+
+	// BindCredential is a reference to a Secret or ConfigMap key containing the password of the LDAP admin.
+	// +optional
+	BindCredential *common.SourceRef `json:"bindCredential,omitempty"`
+}
+
+// UserFederationSyncSettings defines periodic synchronization of users.
+type UserFederationSyncSettings struct {
+	// FullSyncPeriod is a period of full synchronization in seconds.
+	// If not specified, periodic full synchronization is disabled.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FullSyncPeriod *int `json:"fullSyncPeriod,omitempty"`
+
+	// ChangedSyncPeriod is a period of synchronization of changed users in seconds.
+	// If not specified, periodic synchronization of changed users is disabled.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ChangedSyncPeriod *int `json:"changedSyncPeriod,omitempty"`
+}
+
+// KerberosFederationSettings defines Kerberos settings of the user federation provider.
+type KerberosFederationSettings struct {
+	// KerberosRealm is a name of the Kerberos realm.
+	// +kubebuilder:example="EXAMPLE.COM"
+	// +kubebuilder:validation:MinLength=1
+	KerberosRealm string `json:"kerberosRealm"`
+
+	// ServerPrincipal is a full name of the server principal for HTTP service.
+	// +kubebuilder:example="HTTP/host.example.com@EXAMPLE.COM"
+	// +kubebuilder:validation:MinLength=1
+	ServerPrincipal string `json:"serverPrincipal"`
+
+	// KeyTab is a location of the Kerberos KeyTab file on the Keycloak server.
+	// +kubebuilder:validation:MinLength=1
+	KeyTab string `json:"keyTab"`
+
+	// UseKerberosForPasswordAuthentication indicates whether the Kerberos login module is used
+	// for password authentication instead of LDAP. Applies only to ldap provider.
+	// +optional
+	UseKerberosForPasswordAuthentication bool `json:"useKerberosForPasswordAuthentication,omitempty"`
+
+	// AllowPasswordAuthentication enables username/password authentication against Kerberos.
+	// Applies only to kerberos provider.
+	// +optional
+	AllowPasswordAuthentication bool `json:"allowPasswordAuthentication,omitempty"`
+
+	// Debug enables debug logging of Kerberos.
+	// +optional
+	Debug bool `json:"debug,omitempty"`
+}
+
+// UserFederationMapper defines an LDAP mapper of the user federation provider.
+// Exactly one mapper type must be set.
+// +kubebuilder:validation:XValidation:rule="(has(self.userAttribute) ? 1 : 0) + (has(self.fullName) ? 1 : 0) + (has(self.group) ? 1 : 0) + (has(self.role) ? 1 : 0) + (has(self.hardcodedRole) ? 1 : 0) + (has(self.custom) ? 1 : 0) == 1",message="exactly one mapper type must be set"
+type UserFederationMapper struct {
+	// Name of the mapper.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// UserAttribute maps an LDAP attribute to a Keycloak user attribute.
+	// +optional
+	UserAttribute *UserAttributeLDAPMapper `json:"userAttribute,omitempty"`
+
+	// FullName maps the full name of the LDAP user to the first and last name of the Keycloak user.
+	// +optional
+	FullName *FullNameLDAPMapper `json:"fullName,omitempty"`
+
+	// Group maps LDAP groups to Keycloak groups.
+	// +optional
+	Group *GroupLDAPMapper `json:"group,omitempty"`
+
+	// Role maps LDAP roles to Keycloak realm or client roles.
+	// +optional
+	Role *RoleLDAPMapper `json:"role,omitempty"`
+
+	// HardcodedRole grants a role to every user imported from LDAP.
+	// +optional
+	HardcodedRole *HardcodedRoleLDAPMapper `json:"hardcodedRole,omitempty"`
+
+	// Custom is a mapper of any other type with a raw configuration.
+	// +optional
+	Custom *CustomLDAPMapper `json:"custom,omitempty"`
+}
+
+// UserAttributeLDAPMapper defines user-attribute-ldap-mapper.
+type UserAttributeLDAPMapper struct {
+	// UserModelAttribute is a name of the Keycloak user attribute.
+	// +kubebuilder:example="email"
+	// +kubebuilder:validation:MinLength=1
+	UserModelAttribute string `json:"userModelAttribute"`
+
+	// LDAPAttribute is a name of the LDAP attribute.
+	// +kubebuilder:example="mail"
+	// +kubebuilder:validation:MinLength=1
+	LDAPAttribute string `json:"ldapAttribute"`
+
+	// ReadOnly indicates that the attribute is not propagated to LDAP.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// AlwaysReadValueFromLDAP indicates that the value is always read from LDAP.
+	// +optional
+	AlwaysReadValueFromLDAP bool `json:"alwaysReadValueFromLdap,omitempty"`
+
+	// IsMandatoryInLDAP indicates that the attribute is required in LDAP.
+	// +optional
+	IsMandatoryInLDAP bool `json:"isMandatoryInLdap,omitempty"`
+
+	// DefaultValue is used in LDAP when the attribute is mandatory and the Keycloak value is empty.
+	// +optional
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+// FullNameLDAPMapper defines full-name-ldap-mapper.
+type FullNameLDAPMapper struct {
+	// LDAPFullNameAttribute is a name of the LDAP attribute with the full name.
+	// +kubebuilder:default=cn
+	// +optional
+	LDAPFullNameAttribute string `json:"ldapFullNameAttribute,omitempty"`
+
+	// ReadOnly indicates that the full name is not propagated to LDAP.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// WriteOnly indicates that the full name is only propagated to LDAP.
+	// +optional
+	WriteOnly bool `json:"writeOnly,omitempty"`
+}
+
+// LDAPMembershipSettings defines how memberships are stored in LDAP.
+type LDAPMembershipSettings struct {
+	// MembershipLDAPAttribute is a name of the LDAP attribute with members.
+	// +kubebuilder:default=member
+	// +optional
+	MembershipLDAPAttribute string `json:"membershipLdapAttribute,omitempty"`
+
+	// MembershipAttributeType defines whether members are referenced by DN or by UID.
+	// +kubebuilder:validation:Enum=DN;UID
+	// +kubebuilder:default=DN
+	// +optional
+	MembershipAttributeType string `json:"membershipAttributeType,omitempty"`
+
+	// MembershipUserLDAPAttribute is a user LDAP attribute used for membership when MembershipAttributeType is UID.
+	// +kubebuilder:default=uid
+	// +optional
+	MembershipUserLDAPAttribute string `json:"membershipUserLdapAttribute,omitempty"`
+
+	// Mode defines how memberships are retrieved and stored.
+	// +kubebuilder:validation:Enum=READ_ONLY;LDAP_ONLY;IMPORT
+	// +kubebuilder:default=READ_ONLY
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// UserRolesRetrieveStrategy defines how memberships of a user are retrieved.
+	// +kubebuilder:validation:Enum=LOAD_GROUPS_BY_MEMBER_ATTRIBUTE;GET_GROUPS_FROM_USER_MEMBEROF_ATTRIBUTE;LOAD_GROUPS_BY_MEMBER_ATTRIBUTE_RECURSIVELY;LOAD_ROLES_BY_MEMBER_ATTRIBUTE;GET_ROLES_FROM_USER_MEMBEROF_ATTRIBUTE;LOAD_ROLES_BY_MEMBER_ATTRIBUTE_RECURSIVELY
+	// +optional
+	UserRolesRetrieveStrategy string `json:"userRolesRetrieveStrategy,omitempty"`
+}
+
+// GroupLDAPMapper defines group-ldap-mapper.
+type GroupLDAPMapper struct {
+	LDAPMembershipSettings `json:",inline"`
+
+	// GroupsDN is a DN of the LDAP tree where groups are.
+	// +kubebuilder:example="ou=groups,dc=example,dc=com"
+	// +kubebuilder:validation:MinLength=1
+	GroupsDN string `json:"groupsDn"`
+
+	// GroupNameLDAPAttribute is an LDAP attribute with the group name.
+	// +kubebuilder:default=cn
+	// +optional
+	GroupNameLDAPAttribute string `json:"groupNameLdapAttribute,omitempty"`
+
+	// GroupObjectClasses are object classes of groups in LDAP.
+	// +kubebuilder:default={"groupOfNames"}
+	// +optional
+	GroupObjectClasses []string `json:"groupObjectClasses,omitempty"`
+
+	// GroupsLDAPFilter is an additional LDAP filter for groups.
+	// +optional
+	GroupsLDAPFilter string `json:"groupsLdapFilter,omitempty"`
+
+	// PreserveGroupInheritance keeps the LDAP group hierarchy in Keycloak.
+	// +optional
+	PreserveGroupInheritance bool `json:"preserveGroupInheritance,omitempty"`
+
+	// IgnoreMissingGroups ignores missing groups in the group hierarchy.
+	// +optional
+	IgnoreMissingGroups bool `json:"ignoreMissingGroups,omitempty"`
+
+	// DropNonExistingGroupsDuringSync removes Keycloak groups that don't exist in LDAP during synchronization.
+	// +optional
+	DropNonExistingGroupsDuringSync bool `json:"dropNonExistingGroupsDuringSync,omitempty"`
+
+	// GroupsPath is a Keycloak group path where LDAP groups are added.
+	// +optional
+	GroupsPath string `json:"groupsPath,omitempty"`
+}
+
+// RoleLDAPMapper defines role-ldap-mapper.
+type RoleLDAPMapper struct {
+	LDAPMembershipSettings `json:",inline"`
+
+	// RolesDN is a DN of the LDAP tree where roles are.
+	// +kubebuilder:example="ou=roles,dc=example,dc=com"
+	// +kubebuilder:validation:MinLength=1
+	RolesDN string `json:"rolesDn"`
+
+	// RoleNameLDAPAttribute is an LDAP attribute with the role name.
+	// +kubebuilder:default=cn
+	// +optional
+	RoleNameLDAPAttribute string `json:"roleNameLdapAttribute,omitempty"`
+
+	// RoleObjectClasses are object classes of roles in LDAP.
+	// +kubebuilder:default={"groupOfNames"}
+	// +optional
+	RoleObjectClasses []string `json:"roleObjectClasses,omitempty"`
+
+	// RolesLDAPFilter is an additional LDAP filter for roles.
+	// +optional
+	RolesLDAPFilter string `json:"rolesLdapFilter,omitempty"`
+
+	// ClientID is a client ID of the client whose roles are mapped.
+	// If not specified, roles are mapped to realm roles.
+	// +optional
+	ClientID string `json:"clientId,omitempty"`
+}
+
+// HardcodedRoleLDAPMapper defines hardcoded-ldap-role-mapper.
+type HardcodedRoleLDAPMapper struct {
+	// Role is a role granted to users. Client roles are specified in format clientId.roleName.
+	// +kubebuilder:validation:MinLength=1
+	Role string `json:"role"`
+}
+
+// CustomLDAPMapper defines an LDAP mapper with a raw configuration.
+type CustomLDAPMapper struct {
+	// ProviderID is a type of the mapper.
+	// +kubebuilder:example="certificate-ldap-mapper"
+	// +kubebuilder:validation:MinLength=1
+	ProviderID string `json:"providerId"`
+
+	// Config is a map of mapper configuration.
+	// +nullable
+	// +optional
+	Config map[string][]string `json:"config,omitempty"`
+}
+
+// KeycloakUserFederationStatus defines the observed state of KeycloakUserFederation.
+type KeycloakUserFederationStatus struct {
+	// Value is a status of the last reconciliation.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ID is a Keycloak ID of the user federation provider.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Mappers is a list of mapper names managed by the operator.
+	// +optional
+	Mappers []string `json:"mappers,omitempty"`
+
+	// LastSync is a result of the last synchronization triggered by the operator.
+	// +nullable
+	// +optional
+	LastSync *UserFederationSyncResult `json:"lastSync,omitempty"`
+
+	// LastConnectionTest is a result of the last connection test triggered by the operator.
+	// +nullable
+	// +optional
+	LastConnectionTest *UserFederationConnectionTestResult `json:"lastConnectionTest,omitempty"`
+
+	// ConfigHash is a hash of the configuration last applied to Keycloak.
+	// Keycloak doesn't return secrets, so changes of secret values are detected by the hash.
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
+}
+
+// UserFederationSyncResult is a result of user synchronization.
+type UserFederationSyncResult struct {
+	// Type is a type of the synchronization: full or changed.
+	Type string `json:"type"`
+
+	// Time is a time when the synchronization finished.
+	Time metav1.Time `json:"time"`
+
+	// Added is a number of added users.
+	// +optional
+	Added int32 `json:"added,omitempty"`
+
+	// Updated is a number of updated users.
+	// +optional
+	Updated int32 `json:"updated,omitempty"`
+
+	// Removed is a number of removed users.
+	// +optional
+	Removed int32 `json:"removed,omitempty"`
+
+	// Failed is a number of users that failed to synchronize.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// Message is a status message returned by Keycloak.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// UserFederationConnectionTestResult is a result of the LDAP connection test.
+type UserFederationConnectionTestResult struct {
+	// Time is a time when the test finished.
+	Time metav1.Time `json:"time"`
+
+	// Success indicates whether both connection and authentication succeeded.
+	Success bool `json:"success"`
+
+	// Message describes the failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.providerId",description="User federation provider"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
+
+// KeycloakUserFederation is the Schema for the user federation API.
+type KeycloakUserFederation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeycloakUserFederationSpec   `json:"spec,omitempty"`
+	Status KeycloakUserFederationStatus `json:"status,omitempty"`
+}
+
+func (in *KeycloakUserFederation) GetStatus() string {
+	return in.Status.Value
+}
+
+func (in *KeycloakUserFederation) SetStatus(value string) {
+	in.Status.Value = value
+}
+
+func (in *KeycloakUserFederation) GetRealmRef() common.RealmRef {
+	return in.Spec.RealmRef
+}
+
+func (in *KeycloakUserFederation) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakUserFederation) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakUserFederation) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakUserFederation) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakUserFederation) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakUserFederation) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
+// +kubebuilder:object:root=true
+
+// KeycloakUserFederationList contains a list of KeycloakUserFederation.
+type KeycloakUserFederationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KeycloakUserFederation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KeycloakUserFederation{}, &KeycloakUserFederationList{})
+}
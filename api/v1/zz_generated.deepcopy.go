@@ -112,6 +112,11 @@ func (in *AuthenticatorConfig) DeepCopy() *AuthenticatorConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Authorization) DeepCopyInto(out *Authorization) {
 	*out = *in
+	if in.FromConfigMap != nil {
+		in, out := &in.FromConfigMap, &out.FromConfigMap
+		*out = new(common.ConfigMapKeySelector)
+		**out = **in
+	}
 	if in.Scopes != nil {
 		in, out := &in.Scopes, &out.Scopes
 		*out = make([]string, len(*in))
@@ -138,6 +143,13 @@ func (in *Authorization) DeepCopyInto(out *Authorization) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]AuthorizationTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorization.
@@ -150,6 +162,41 @@ func (in *Authorization) DeepCopy() *Authorization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTest) DeepCopyInto(out *AuthorizationTest) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTest.
+func (in *AuthorizationTest) DeepCopy() *AuthorizationTest {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationTestResult) DeepCopyInto(out *AuthorizationTestResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationTestResult.
+func (in *AuthorizationTestResult) DeepCopy() *AuthorizationTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BatchRole) DeepCopyInto(out *BatchRole) {
 	*out = *in
@@ -186,6 +233,26 @@ func (in *BatchRole) DeepCopy() *BatchRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientAuthentication) DeepCopyInto(out *ClientAuthentication) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientAuthentication.
+func (in *ClientAuthentication) DeepCopy() *ClientAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientPolicyData) DeepCopyInto(out *ClientPolicyData) {
 	*out = *in
@@ -226,6 +293,56 @@ func (in *ClientRole) DeepCopy() *ClientRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientScopeDefinition) DeepCopyInto(out *ClientScopeDefinition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientScopeDefinition.
+func (in *ClientScopeDefinition) DeepCopy() *ClientScopeDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientScopeDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientScopePolicyData) DeepCopyInto(out *ClientScopePolicyData) {
+	*out = *in
+	if in.ClientScopes != nil {
+		in, out := &in.ClientScopes, &out.ClientScopes
+		*out = make([]ClientScopeDefinition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientScopePolicyData.
+func (in *ClientScopePolicyData) DeepCopy() *ClientScopePolicyData {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientScopePolicyData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientTemplateRef) DeepCopyInto(out *ClientTemplateRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientTemplateRef.
+func (in *ClientTemplateRef) DeepCopy() *ClientTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Composite) DeepCopyInto(out *Composite) {
 	*out = *in
@@ -241,6 +358,74 @@ func (in *Composite) DeepCopy() *Composite {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecret) DeepCopyInto(out *ConnectionSecret) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecret.
+func (in *ConnectionSecret) DeepCopy() *ConnectionSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomLDAPMapper) DeepCopyInto(out *CustomLDAPMapper) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLDAPMapper.
+func (in *CustomLDAPMapper) DeepCopy() *CustomLDAPMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomLDAPMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FullNameLDAPMapper) DeepCopyInto(out *FullNameLDAPMapper) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FullNameLDAPMapper.
+func (in *FullNameLDAPMapper) DeepCopy() *FullNameLDAPMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(FullNameLDAPMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupDefinition) DeepCopyInto(out *GroupDefinition) {
 	*out = *in
@@ -256,6 +441,27 @@ func (in *GroupDefinition) DeepCopy() *GroupDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupLDAPMapper) DeepCopyInto(out *GroupLDAPMapper) {
+	*out = *in
+	out.LDAPMembershipSettings = in.LDAPMembershipSettings
+	if in.GroupObjectClasses != nil {
+		in, out := &in.GroupObjectClasses, &out.GroupObjectClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupLDAPMapper.
+func (in *GroupLDAPMapper) DeepCopy() *GroupLDAPMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupLDAPMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupPolicyData) DeepCopyInto(out *GroupPolicyData) {
 	*out = *in
@@ -276,6 +482,21 @@ func (in *GroupPolicyData) DeepCopy() *GroupPolicyData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardcodedRoleLDAPMapper) DeepCopyInto(out *HardcodedRoleLDAPMapper) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardcodedRoleLDAPMapper.
+func (in *HardcodedRoleLDAPMapper) DeepCopy() *HardcodedRoleLDAPMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(HardcodedRoleLDAPMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityProviderMapper) DeepCopyInto(out *IdentityProviderMapper) {
 	*out = *in
@@ -298,13 +519,43 @@ func (in *IdentityProviderMapper) DeepCopy() *IdentityProviderMapper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSPolicyData) DeepCopyInto(out *JSPolicyData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSPolicyData.
+func (in *JSPolicyData) DeepCopy() *JSPolicyData {
+	if in == nil {
+		return nil
+	}
+	out := new(JSPolicyData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KerberosFederationSettings) DeepCopyInto(out *KerberosFederationSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KerberosFederationSettings.
+func (in *KerberosFederationSettings) DeepCopy() *KerberosFederationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(KerberosFederationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Keycloak) DeepCopyInto(out *Keycloak) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Keycloak.
@@ -331,7 +582,7 @@ func (in *KeycloakAuthFlow) DeepCopyInto(out *KeycloakAuthFlow) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakAuthFlow.
@@ -410,6 +661,13 @@ func (in *KeycloakAuthFlowSpec) DeepCopy() *KeycloakAuthFlowSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakAuthFlowStatus) DeepCopyInto(out *KeycloakAuthFlowStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakAuthFlowStatus.
@@ -469,6 +727,116 @@ func (in *KeycloakClientAdvancedSettings) DeepCopy() *KeycloakClientAdvancedSett
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakClientInitialAccessToken) DeepCopyInto(out *KeycloakClientInitialAccessToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientInitialAccessToken.
+func (in *KeycloakClientInitialAccessToken) DeepCopy() *KeycloakClientInitialAccessToken {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakClientInitialAccessToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakClientInitialAccessToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakClientInitialAccessTokenList) DeepCopyInto(out *KeycloakClientInitialAccessTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeycloakClientInitialAccessToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientInitialAccessTokenList.
+func (in *KeycloakClientInitialAccessTokenList) DeepCopy() *KeycloakClientInitialAccessTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakClientInitialAccessTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakClientInitialAccessTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakClientInitialAccessTokenSpec) DeepCopyInto(out *KeycloakClientInitialAccessTokenSpec) {
+	*out = *in
+	out.RealmRef = in.RealmRef
+	if in.WebOrigins != nil {
+		in, out := &in.WebOrigins, &out.WebOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientInitialAccessTokenSpec.
+func (in *KeycloakClientInitialAccessTokenSpec) DeepCopy() *KeycloakClientInitialAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakClientInitialAccessTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakClientInitialAccessTokenStatus) DeepCopyInto(out *KeycloakClientInitialAccessTokenStatus) {
+	*out = *in
+	if in.IssuedAt != nil {
+		in, out := &in.IssuedAt, &out.IssuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientInitialAccessTokenStatus.
+func (in *KeycloakClientInitialAccessTokenStatus) DeepCopy() *KeycloakClientInitialAccessTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakClientInitialAccessTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakClientList) DeepCopyInto(out *KeycloakClientList) {
 	*out = *in
@@ -507,7 +875,7 @@ func (in *KeycloakClientScope) DeepCopyInto(out *KeycloakClientScope) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientScope.
@@ -593,6 +961,13 @@ func (in *KeycloakClientScopeSpec) DeepCopy() *KeycloakClientScopeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakClientScopeStatus) DeepCopyInto(out *KeycloakClientScopeStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientScopeStatus.
@@ -609,6 +984,21 @@ func (in *KeycloakClientScopeStatus) DeepCopy() *KeycloakClientScopeStatus {
 func (in *KeycloakClientSpec) DeepCopyInto(out *KeycloakClientSpec) {
 	*out = *in
 	out.RealmRef = in.RealmRef
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(ClientTemplateRef)
+		**out = **in
+	}
+	if in.SecretRotation != nil {
+		in, out := &in.SecretRotation, &out.SecretRotation
+		*out = new(SecretRotation)
+		**out = **in
+	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(ConnectionSecret)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RealmRoles != nil {
 		in, out := &in.RealmRoles, &out.RealmRoles
 		*out = new([]RealmRole)
@@ -678,6 +1068,26 @@ func (in *KeycloakClientSpec) DeepCopyInto(out *KeycloakClientSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RedirectFrom != nil {
+		in, out := &in.RedirectFrom, &out.RedirectFrom
+		*out = make([]RedirectSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientAuthentication != nil {
+		in, out := &in.ClientAuthentication, &out.ClientAuthentication
+		*out = new(ClientAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(SAMLSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScopeMappings != nil {
+		in, out := &in.ScopeMappings, &out.ScopeMappings
+		*out = new(ScopeMappings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
 		*out = new(Authorization)
@@ -713,6 +1123,15 @@ func (in *KeycloakClientSpec) DeepCopy() *KeycloakClientSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakClientStatus) DeepCopyInto(out *KeycloakClientStatus) {
 	*out = *in
+	if in.SecretRotatedAt != nil {
+		in, out := &in.SecretRotatedAt, &out.SecretRotatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.AuthorizationTests != nil {
+		in, out := &in.AuthorizationTests, &out.AuthorizationTests
+		*out = make([]AuthorizationTestResult, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -732,6 +1151,138 @@ func (in *KeycloakClientStatus) DeepCopy() *KeycloakClientStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakClientTemplate) DeepCopyInto(out *KeycloakClientTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientTemplate.
+func (in *KeycloakClientTemplate) DeepCopy() *KeycloakClientTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakClientTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakClientTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakClientTemplateList) DeepCopyInto(out *KeycloakClientTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeycloakClientTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientTemplateList.
+func (in *KeycloakClientTemplateList) DeepCopy() *KeycloakClientTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakClientTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakClientTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakClientTemplateSpec) DeepCopyInto(out *KeycloakClientTemplateSpec) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.StandardFlowEnabled != nil {
+		in, out := &in.StandardFlowEnabled, &out.StandardFlowEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DirectAccess != nil {
+		in, out := &in.DirectAccess, &out.DirectAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImplicitFlowEnabled != nil {
+		in, out := &in.ImplicitFlowEnabled, &out.ImplicitFlowEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DefaultClientScopes != nil {
+		in, out := &in.DefaultClientScopes, &out.DefaultClientScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OptionalClientScopes != nil {
+		in, out := &in.OptionalClientScopes, &out.OptionalClientScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WebOrigins != nil {
+		in, out := &in.WebOrigins, &out.WebOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtocolMappers != nil {
+		in, out := &in.ProtocolMappers, &out.ProtocolMappers
+		*out = make([]ProtocolMapper, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AuthenticationFlowBindingOverrides != nil {
+		in, out := &in.AuthenticationFlowBindingOverrides, &out.AuthenticationFlowBindingOverrides
+		*out = new(AuthenticationFlowBindingOverrides)
+		**out = **in
+	}
+	if in.AdvancedSettings != nil {
+		in, out := &in.AdvancedSettings, &out.AdvancedSettings
+		*out = new(KeycloakClientAdvancedSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakClientTemplateSpec.
+func (in *KeycloakClientTemplateSpec) DeepCopy() *KeycloakClientTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakClientTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakComponentSpec) DeepCopyInto(out *KeycloakComponentSpec) {
 	*out = *in
@@ -772,6 +1323,13 @@ func (in *KeycloakComponentSpec) DeepCopy() *KeycloakComponentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakComponentStatus) DeepCopyInto(out *KeycloakComponentStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakComponentStatus.
@@ -779,37 +1337,123 @@ func (in *KeycloakComponentStatus) DeepCopy() *KeycloakComponentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KeycloakComponentStatus)
-	in.DeepCopyInto(out)
-	return out
+	out := new(KeycloakComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakList) DeepCopyInto(out *KeycloakList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Keycloak, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakList.
+func (in *KeycloakList) DeepCopy() *KeycloakList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakRealm) DeepCopyInto(out *KeycloakRealm) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealm.
+func (in *KeycloakRealm) DeepCopy() *KeycloakRealm {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakRealm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakRealm) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakRealmBackup) DeepCopyInto(out *KeycloakRealmBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmBackup.
+func (in *KeycloakRealmBackup) DeepCopy() *KeycloakRealmBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakRealmBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakRealmBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeycloakList) DeepCopyInto(out *KeycloakList) {
+func (in *KeycloakRealmBackupList) DeepCopyInto(out *KeycloakRealmBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Keycloak, len(*in))
+		*out = make([]KeycloakRealmBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakList.
-func (in *KeycloakList) DeepCopy() *KeycloakList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmBackupList.
+func (in *KeycloakRealmBackupList) DeepCopy() *KeycloakRealmBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(KeycloakList)
+	out := new(KeycloakRealmBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KeycloakList) DeepCopyObject() runtime.Object {
+func (in *KeycloakRealmBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -817,30 +1461,55 @@ func (in *KeycloakList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeycloakRealm) DeepCopyInto(out *KeycloakRealm) {
+func (in *KeycloakRealmBackupSpec) DeepCopyInto(out *KeycloakRealmBackupSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	out.RealmRef = in.RealmRef
+	if in.RedactSecrets != nil {
+		in, out := &in.RedactSecrets, &out.RedactSecrets
+		*out = new(bool)
+		**out = **in
+	}
+	in.Destination.DeepCopyInto(&out.Destination)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealm.
-func (in *KeycloakRealm) DeepCopy() *KeycloakRealm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmBackupSpec.
+func (in *KeycloakRealmBackupSpec) DeepCopy() *KeycloakRealmBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KeycloakRealm)
+	out := new(KeycloakRealmBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KeycloakRealm) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakRealmBackupStatus) DeepCopyInto(out *KeycloakRealmBackupStatus) {
+	*out = *in
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
 	}
-	return nil
+	if in.NextBackupTime != nil {
+		in, out := &in.NextBackupTime, &out.NextBackupTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmBackupStatus.
+func (in *KeycloakRealmBackupStatus) DeepCopy() *KeycloakRealmBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakRealmBackupStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -849,7 +1518,7 @@ func (in *KeycloakRealmComponent) DeepCopyInto(out *KeycloakRealmComponent) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmComponent.
@@ -908,7 +1577,7 @@ func (in *KeycloakRealmGroup) DeepCopyInto(out *KeycloakRealmGroup) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmGroup.
@@ -1025,6 +1694,13 @@ func (in *KeycloakRealmGroupSpec) DeepCopy() *KeycloakRealmGroupSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakRealmGroupStatus) DeepCopyInto(out *KeycloakRealmGroupStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmGroupStatus.
@@ -1043,7 +1719,7 @@ func (in *KeycloakRealmIdentityProvider) DeepCopyInto(out *KeycloakRealmIdentity
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmIdentityProvider.
@@ -1139,6 +1815,13 @@ func (in *KeycloakRealmIdentityProviderSpec) DeepCopy() *KeycloakRealmIdentityPr
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakRealmIdentityProviderStatus) DeepCopyInto(out *KeycloakRealmIdentityProviderStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmIdentityProviderStatus.
@@ -1189,7 +1872,7 @@ func (in *KeycloakRealmRole) DeepCopyInto(out *KeycloakRealmRole) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmRole.
@@ -1216,7 +1899,7 @@ func (in *KeycloakRealmRoleBatch) DeepCopyInto(out *KeycloakRealmRoleBatch) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmRoleBatch.
@@ -1295,6 +1978,13 @@ func (in *KeycloakRealmRoleBatchSpec) DeepCopy() *KeycloakRealmRoleBatchSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakRealmRoleBatchStatus) DeepCopyInto(out *KeycloakRealmRoleBatchStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmRoleBatchStatus.
@@ -1395,6 +2085,13 @@ func (in *KeycloakRealmRoleSpec) DeepCopy() *KeycloakRealmRoleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakRealmRoleStatus) DeepCopyInto(out *KeycloakRealmRoleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmRoleStatus.
@@ -1423,6 +2120,11 @@ func (in *KeycloakRealmSpec) DeepCopyInto(out *KeycloakRealmSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AuthenticationFlows != nil {
+		in, out := &in.AuthenticationFlows, &out.AuthenticationFlows
+		*out = new(common.AuthenticationFlowBindings)
+		**out = **in
+	}
 	if in.Themes != nil {
 		in, out := &in.Themes, &out.Themes
 		*out = new(RealmThemes)
@@ -1504,6 +2206,11 @@ func (in *KeycloakRealmSpec) DeepCopyInto(out *KeycloakRealmSpec) {
 		*out = new(common.BruteForceDetection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientRegistrationPolicies != nil {
+		in, out := &in.ClientRegistrationPolicies, &out.ClientRegistrationPolicies
+		*out = new(common.ClientRegistrationPolicies)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmSpec.
@@ -1519,6 +2226,18 @@ func (in *KeycloakRealmSpec) DeepCopy() *KeycloakRealmSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakRealmStatus) DeepCopyInto(out *KeycloakRealmStatus) {
 	*out = *in
+	if in.ClientRegistrationPolicies != nil {
+		in, out := &in.ClientRegistrationPolicies, &out.ClientRegistrationPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmStatus.
@@ -1695,34 +2414,251 @@ func (in *KeycloakSpec) DeepCopyInto(out *KeycloakSpec) {
 		*out = new(common.AuthSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CACert != nil {
-		in, out := &in.CACert, &out.CACert
-		*out = new(common.SourceRef)
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakSpec.
+func (in *KeycloakSpec) DeepCopy() *KeycloakSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakStatus) DeepCopyInto(out *KeycloakStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakStatus.
+func (in *KeycloakStatus) DeepCopy() *KeycloakStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakUserFederation) DeepCopyInto(out *KeycloakUserFederation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakUserFederation.
+func (in *KeycloakUserFederation) DeepCopy() *KeycloakUserFederation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakUserFederation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakUserFederation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakUserFederationList) DeepCopyInto(out *KeycloakUserFederationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeycloakUserFederation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakUserFederationList.
+func (in *KeycloakUserFederationList) DeepCopy() *KeycloakUserFederationList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakUserFederationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeycloakUserFederationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakUserFederationSpec) DeepCopyInto(out *KeycloakUserFederationSpec) {
+	*out = *in
+	out.RealmRef = in.RealmRef
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(LDAPFederationSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kerberos != nil {
+		in, out := &in.Kerberos, &out.Kerberos
+		*out = new(KerberosFederationSettings)
+		**out = **in
+	}
+	if in.Mappers != nil {
+		in, out := &in.Mappers, &out.Mappers
+		*out = make([]UserFederationMapper, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakUserFederationSpec.
+func (in *KeycloakUserFederationSpec) DeepCopy() *KeycloakUserFederationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakUserFederationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakUserFederationStatus) DeepCopyInto(out *KeycloakUserFederationStatus) {
+	*out = *in
+	if in.Mappers != nil {
+		in, out := &in.Mappers, &out.Mappers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSync != nil {
+		in, out := &in.LastSync, &out.LastSync
+		*out = new(UserFederationSyncResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastConnectionTest != nil {
+		in, out := &in.LastConnectionTest, &out.LastConnectionTest
+		*out = new(UserFederationConnectionTestResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakUserFederationStatus.
+func (in *KeycloakUserFederationStatus) DeepCopy() *KeycloakUserFederationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakUserFederationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPConnection) DeepCopyInto(out *LDAPConnection) {
+	*out = *in
+	if in.ConnectionTimeout != nil {
+		in, out := &in.ConnectionTimeout, &out.ConnectionTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.ConnectionPooling != nil {
+		in, out := &in.ConnectionPooling, &out.ConnectionPooling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BindCredential != nil {
+		in, out := &in.BindCredential, &out.BindCredential
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPConnection.
+func (in *LDAPConnection) DeepCopy() *LDAPConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPFederationSettings) DeepCopyInto(out *LDAPFederationSettings) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.ImportEnabled != nil {
+		in, out := &in.ImportEnabled, &out.ImportEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserObjectClasses != nil {
+		in, out := &in.UserObjectClasses, &out.UserObjectClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(UserFederationSyncSettings)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakSpec.
-func (in *KeycloakSpec) DeepCopy() *KeycloakSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPFederationSettings.
+func (in *LDAPFederationSettings) DeepCopy() *LDAPFederationSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(KeycloakSpec)
+	out := new(LDAPFederationSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeycloakStatus) DeepCopyInto(out *KeycloakStatus) {
+func (in *LDAPMembershipSettings) DeepCopyInto(out *LDAPMembershipSettings) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakStatus.
-func (in *KeycloakStatus) DeepCopy() *KeycloakStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPMembershipSettings.
+func (in *LDAPMembershipSettings) DeepCopy() *LDAPMembershipSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(KeycloakStatus)
+	out := new(LDAPMembershipSettings)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1800,11 +2736,26 @@ func (in *Policy) DeepCopyInto(out *Policy) {
 		*out = new(ClientPolicyData)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientScopePolicy != nil {
+		in, out := &in.ClientScopePolicy, &out.ClientScopePolicy
+		*out = new(ClientScopePolicyData)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GroupPolicy != nil {
 		in, out := &in.GroupPolicy, &out.GroupPolicy
 		*out = new(GroupPolicyData)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.JSPolicy != nil {
+		in, out := &in.JSPolicy, &out.JSPolicy
+		*out = new(JSPolicyData)
+		**out = **in
+	}
+	if in.RegexPolicy != nil {
+		in, out := &in.RegexPolicy, &out.RegexPolicy
+		*out = new(RegexPolicyData)
+		**out = **in
+	}
 	if in.RolePolicy != nil {
 		in, out := &in.RolePolicy, &out.RolePolicy
 		*out = new(RolePolicyData)
@@ -1854,6 +2805,66 @@ func (in *ProtocolMapper) DeepCopy() *ProtocolMapper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealmBackupDestination) DeepCopyInto(out *RealmBackupDestination) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(RealmBackupObjectDestination)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(RealmBackupObjectDestination)
+		**out = **in
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(RealmBackupVolumeDestination)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealmBackupDestination.
+func (in *RealmBackupDestination) DeepCopy() *RealmBackupDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(RealmBackupDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealmBackupObjectDestination) DeepCopyInto(out *RealmBackupObjectDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealmBackupObjectDestination.
+func (in *RealmBackupObjectDestination) DeepCopy() *RealmBackupObjectDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(RealmBackupObjectDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealmBackupVolumeDestination) DeepCopyInto(out *RealmBackupVolumeDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealmBackupVolumeDestination.
+func (in *RealmBackupVolumeDestination) DeepCopy() *RealmBackupVolumeDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(RealmBackupVolumeDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RealmLocalization) DeepCopyInto(out *RealmLocalization) {
 	*out = *in
@@ -1972,6 +2983,36 @@ func (in *RealmThemes) DeepCopy() *RealmThemes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectSource) DeepCopyInto(out *RedirectSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectSource.
+func (in *RedirectSource) DeepCopy() *RedirectSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RedirectSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexPolicyData) DeepCopyInto(out *RegexPolicyData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexPolicyData.
+func (in *RegexPolicyData) DeepCopy() *RegexPolicyData {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexPolicyData)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resource) DeepCopyInto(out *Resource) {
 	*out = *in
@@ -2028,6 +3069,27 @@ func (in *RoleDefinition) DeepCopy() *RoleDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleLDAPMapper) DeepCopyInto(out *RoleLDAPMapper) {
+	*out = *in
+	out.LDAPMembershipSettings = in.LDAPMembershipSettings
+	if in.RoleObjectClasses != nil {
+		in, out := &in.RoleObjectClasses, &out.RoleObjectClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleLDAPMapper.
+func (in *RoleLDAPMapper) DeepCopy() *RoleLDAPMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleLDAPMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolePolicyData) DeepCopyInto(out *RolePolicyData) {
 	*out = *in
@@ -2048,6 +3110,91 @@ func (in *RolePolicyData) DeepCopy() *RolePolicyData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLEncryption) DeepCopyInto(out *SAMLEncryption) {
+	*out = *in
+	if in.KeyPair != nil {
+		in, out := &in.KeyPair, &out.KeyPair
+		*out = new(SAMLKeyPair)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLEncryption.
+func (in *SAMLEncryption) DeepCopy() *SAMLEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLKeyPair) DeepCopyInto(out *SAMLKeyPair) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLKeyPair.
+func (in *SAMLKeyPair) DeepCopy() *SAMLKeyPair {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLKeyPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLSettings) DeepCopyInto(out *SAMLSettings) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(common.SourceRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(SAMLSigning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(SAMLEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLSettings.
+func (in *SAMLSettings) DeepCopy() *SAMLSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLSigning) DeepCopyInto(out *SAMLSigning) {
+	*out = *in
+	if in.KeyPair != nil {
+		in, out := &in.KeyPair, &out.KeyPair
+		*out = new(SAMLKeyPair)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLSigning.
+func (in *SAMLSigning) DeepCopy() *SAMLSigning {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLSigning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSORealmMapper) DeepCopyInto(out *SSORealmMapper) {
 	*out = *in
@@ -2070,6 +3217,33 @@ func (in *SSORealmMapper) DeepCopy() *SSORealmMapper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScopeMappings) DeepCopyInto(out *ScopeMappings) {
+	*out = *in
+	if in.RealmRoles != nil {
+		in, out := &in.RealmRoles, &out.RealmRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientRoles != nil {
+		in, out := &in.ClientRoles, &out.ClientRoles
+		*out = make([]UserClientRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScopeMappings.
+func (in *ScopeMappings) DeepCopy() *ScopeMappings {
+	if in == nil {
+		return nil
+	}
+	out := new(ScopeMappings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScopePermissions) DeepCopyInto(out *ScopePermissions) {
 	*out = *in
@@ -2110,6 +3284,21 @@ func (in *ScopePolicyData) DeepCopy() *ScopePolicyData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotation) DeepCopyInto(out *SecretRotation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotation.
+func (in *SecretRotation) DeepCopy() *SecretRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
 	*out = *in
@@ -2200,6 +3389,21 @@ func (in *User) DeepCopy() *User {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserAttributeLDAPMapper) DeepCopyInto(out *UserAttributeLDAPMapper) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserAttributeLDAPMapper.
+func (in *UserAttributeLDAPMapper) DeepCopy() *UserAttributeLDAPMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(UserAttributeLDAPMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserClientRole) DeepCopyInto(out *UserClientRole) {
 	*out = *in
@@ -2220,6 +3424,108 @@ func (in *UserClientRole) DeepCopy() *UserClientRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserFederationConnectionTestResult) DeepCopyInto(out *UserFederationConnectionTestResult) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserFederationConnectionTestResult.
+func (in *UserFederationConnectionTestResult) DeepCopy() *UserFederationConnectionTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(UserFederationConnectionTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserFederationMapper) DeepCopyInto(out *UserFederationMapper) {
+	*out = *in
+	if in.UserAttribute != nil {
+		in, out := &in.UserAttribute, &out.UserAttribute
+		*out = new(UserAttributeLDAPMapper)
+		**out = **in
+	}
+	if in.FullName != nil {
+		in, out := &in.FullName, &out.FullName
+		*out = new(FullNameLDAPMapper)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(GroupLDAPMapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(RoleLDAPMapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HardcodedRole != nil {
+		in, out := &in.HardcodedRole, &out.HardcodedRole
+		*out = new(HardcodedRoleLDAPMapper)
+		**out = **in
+	}
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomLDAPMapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserFederationMapper.
+func (in *UserFederationMapper) DeepCopy() *UserFederationMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(UserFederationMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserFederationSyncResult) DeepCopyInto(out *UserFederationSyncResult) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserFederationSyncResult.
+func (in *UserFederationSyncResult) DeepCopy() *UserFederationSyncResult {
+	if in == nil {
+		return nil
+	}
+	out := new(UserFederationSyncResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserFederationSyncSettings) DeepCopyInto(out *UserFederationSyncSettings) {
+	*out = *in
+	if in.FullSyncPeriod != nil {
+		in, out := &in.FullSyncPeriod, &out.FullSyncPeriod
+		*out = new(int)
+		**out = **in
+	}
+	if in.ChangedSyncPeriod != nil {
+		in, out := &in.ChangedSyncPeriod, &out.ChangedSyncPeriod
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserFederationSyncSettings.
+func (in *UserFederationSyncSettings) DeepCopy() *UserFederationSyncSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(UserFederationSyncSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserPolicyData) DeepCopyInto(out *UserPolicyData) {
 	*out = *in
@@ -55,12 +55,26 @@ func (in *ClusterKeycloak) GetAdminType() string {
 type ClusterKeycloakStatus struct {
 	// Connected shows if keycloak service is up and running.
 	Connected bool `json:"connected"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Connected",type="boolean",JSONPath=".status.connected",description="Is connected to keycloak"
 
 // ClusterKeycloak is the Schema for the clusterkeycloaks API.
@@ -74,6 +88,26 @@ type ClusterKeycloak struct {
 	Status ClusterKeycloakStatus `json:"status,omitempty"`
 }
 
+func (in *ClusterKeycloak) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *ClusterKeycloak) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *ClusterKeycloak) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *ClusterKeycloak) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *ClusterKeycloak) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // ClusterKeycloakList contains a list of ClusterKeycloak.
@@ -0,0 +1,32 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ClusterKeycloakClientTemplate is the Schema for the clusterkeycloakclienttemplates API.
+// It holds the defaults shared by KeycloakClient resources in all namespaces.
+type ClusterKeycloakClientTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec keycloakApi.KeycloakClientTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterKeycloakClientTemplateList contains a list of ClusterKeycloakClientTemplate.
+type ClusterKeycloakClientTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterKeycloakClientTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterKeycloakClientTemplate{}, &ClusterKeycloakClientTemplateList{})
+}
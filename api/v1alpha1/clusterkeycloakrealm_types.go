@@ -103,6 +103,17 @@ type ClusterKeycloakRealmSpec struct {
 	// +nullable
 	// +optional
 	BruteForceDetection *common.BruteForceDetection `json:"bruteForceDetection,omitempty"`
+
+	// ClientRegistrationPolicies defines anonymous and authenticated client registration policies of the realm.
+	// +optional
+	ClientRegistrationPolicies *common.ClientRegistrationPolicies `json:"clientRegistrationPolicies,omitempty"`
+
+	// DeletionPolicy defines what happens to the Keycloak realm when the resource is deleted.
+	// Delete - the realm is deleted from Keycloak.
+	// Retain - the realm is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 type AuthenticationFlow struct {
@@ -110,6 +121,36 @@ type AuthenticationFlow struct {
 	// +optional
 	// +kubebuilder:example="browser"
 	BrowserFlow string `json:"browserFlow,omitempty"`
+
+	// RegistrationFlow specifies the authentication flow to use for user registration.
+	// +optional
+	// +kubebuilder:example="registration"
+	RegistrationFlow string `json:"registrationFlow,omitempty"`
+
+	// DirectGrantFlow specifies the authentication flow to use for direct access grants.
+	// +optional
+	// +kubebuilder:example="direct grant"
+	DirectGrantFlow string `json:"directGrantFlow,omitempty"`
+
+	// ResetCredentialsFlow specifies the authentication flow to use for resetting credentials.
+	// +optional
+	// +kubebuilder:example="reset credentials"
+	ResetCredentialsFlow string `json:"resetCredentialsFlow,omitempty"`
+
+	// ClientAuthenticationFlow specifies the authentication flow to use for client authentication.
+	// +optional
+	// +kubebuilder:example="clients"
+	ClientAuthenticationFlow string `json:"clientAuthenticationFlow,omitempty"`
+
+	// DockerAuthenticationFlow specifies the authentication flow to use for docker authentication.
+	// +optional
+	// +kubebuilder:example="docker auth"
+	DockerAuthenticationFlow string `json:"dockerAuthenticationFlow,omitempty"`
+
+	// FirstBrokerLoginFlow specifies the authentication flow to use for the first login with an identity provider.
+	// +optional
+	// +kubebuilder:example="first broker login"
+	FirstBrokerLoginFlow string `json:"firstBrokerLoginFlow,omitempty"`
 }
 
 type ClusterRealmThemes struct {
@@ -163,12 +204,31 @@ type ClusterKeycloakRealmStatus struct {
 
 	// +optional
 	Value string `json:"value,omitempty"`
+
+	// ClientRegistrationPolicies is a list of client registration policies created by the operator
+	// in the form of <subType>/<name>. Only these policies are deleted when removed from the spec.
+	// +optional
+	ClientRegistrationPolicies []string `json:"clientRegistrationPolicies,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Available",type="boolean",JSONPath=".status.available",description="Keycloak realm is available"
 // +kubebuilder:printcolumn:name="Realm",type="boolean",JSONPath=".spec.realmName",description="Keycloak realm name"
 // +kubebuilder:printcolumn:name="Cluster-Keycloak",type="boolean",JSONPath=".spec.clusterKeycloakRef",description="ClusterKeycloak instance name"
@@ -182,6 +242,30 @@ type ClusterKeycloakRealm struct {
 	Status ClusterKeycloakRealmStatus `json:"status,omitempty"`
 }
 
+func (in *ClusterKeycloakRealm) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *ClusterKeycloakRealm) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *ClusterKeycloakRealm) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *ClusterKeycloakRealm) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *ClusterKeycloakRealm) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *ClusterKeycloakRealm) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // ClusterKeycloakRealmList contains a list of ClusterKeycloakRealm.
@@ -21,6 +21,7 @@ var (
 )
 
 const (
-	ClusterKeycloakKind      = "ClusterKeycloak"
-	ClusterKeycloakRealmKind = "ClusterKeycloakRealm"
+	ClusterKeycloakKind               = "ClusterKeycloak"
+	ClusterKeycloakRealmKind          = "ClusterKeycloakRealm"
+	ClusterKeycloakClientTemplateKind = "ClusterKeycloakClientTemplate"
 )
@@ -48,6 +48,13 @@ type KeycloakOrganizationSpec struct {
 	// RealmRef is reference to Realm custom resource.
 	// +required
 	RealmRef common.RealmRef `json:"realmRef"`
+
+	// DeletionPolicy defines what happens to the Keycloak organization when the resource is deleted.
+	// Delete - the organization is deleted from Keycloak.
+	// Retain - the organization is kept in Keycloak.
+	// If not specified, the operator-wide default is used.
+	// +optional
+	DeletionPolicy common.DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // OrgIdentityProvider defines an identity provider for an organization.
@@ -70,6 +77,19 @@ type KeycloakOrganizationStatus struct {
 	// Error is the error message if the reconciliation failed.
 	// +optional
 	Error string `json:"error,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastHandledResync is the last handled value of the edp.epam.com/resync annotation.
+	// +optional
+	LastHandledResync string `json:"lastHandledResync,omitempty"`
 }
 
 func (in *KeycloakOrganizationStatus) SetOK() {
@@ -85,6 +105,7 @@ func (in *KeycloakOrganizationStatus) SetError(err string) {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Is the resource ready"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.value",description="Reconciliation status"
 // +kubebuilder:printcolumn:name="Organization ID",type="string",JSONPath=".status.organizationId",description="Keycloak organization ID"
 // +kubebuilder:printcolumn:name="Realm",type="string",JSONPath=".spec.realmName",description="Keycloak realm name"
@@ -103,6 +124,30 @@ func (in *KeycloakOrganization) GetRealmRef() common.RealmRef {
 	return in.Spec.RealmRef
 }
 
+func (in *KeycloakOrganization) GetDeletionPolicy() common.DeletionPolicy {
+	return in.Spec.DeletionPolicy
+}
+
+func (in *KeycloakOrganization) GetConditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *KeycloakOrganization) GetLastHandledResync() string {
+	return in.Status.LastHandledResync
+}
+
+func (in *KeycloakOrganization) SetLastHandledResync(value string) {
+	in.Status.LastHandledResync = value
+}
+
+func (in *KeycloakOrganization) GetObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *KeycloakOrganization) SetObservedGeneration(generation int64) {
+	in.Status.ObservedGeneration = generation
+}
+
 // +kubebuilder:object:root=true
 
 // KeycloakOrganizationList contains a list of KeycloakOrganization.
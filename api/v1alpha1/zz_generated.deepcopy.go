@@ -6,7 +6,8 @@ package v1alpha1
 
 import (
 	"github.com/epam/edp-keycloak-operator/api/common"
-	"github.com/epam/edp-keycloak-operator/api/v1"
+	apiv1 "github.com/epam/edp-keycloak-operator/api/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -31,7 +32,7 @@ func (in *ClusterKeycloak) DeepCopyInto(out *ClusterKeycloak) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKeycloak.
@@ -52,6 +53,64 @@ func (in *ClusterKeycloak) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterKeycloakClientTemplate) DeepCopyInto(out *ClusterKeycloakClientTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKeycloakClientTemplate.
+func (in *ClusterKeycloakClientTemplate) DeepCopy() *ClusterKeycloakClientTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterKeycloakClientTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterKeycloakClientTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterKeycloakClientTemplateList) DeepCopyInto(out *ClusterKeycloakClientTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterKeycloakClientTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKeycloakClientTemplateList.
+func (in *ClusterKeycloakClientTemplateList) DeepCopy() *ClusterKeycloakClientTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterKeycloakClientTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterKeycloakClientTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterKeycloakList) DeepCopyInto(out *ClusterKeycloakList) {
 	*out = *in
@@ -90,7 +149,7 @@ func (in *ClusterKeycloakRealm) DeepCopyInto(out *ClusterKeycloakRealm) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKeycloakRealm.
@@ -214,7 +273,7 @@ func (in *ClusterKeycloakRealmSpec) DeepCopyInto(out *ClusterKeycloakRealmSpec)
 	}
 	if in.Login != nil {
 		in, out := &in.Login, &out.Login
-		*out = new(v1.RealmLogin)
+		*out = new(apiv1.RealmLogin)
 		**out = **in
 	}
 	if in.Sessions != nil {
@@ -227,6 +286,11 @@ func (in *ClusterKeycloakRealmSpec) DeepCopyInto(out *ClusterKeycloakRealmSpec)
 		*out = new(common.BruteForceDetection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientRegistrationPolicies != nil {
+		in, out := &in.ClientRegistrationPolicies, &out.ClientRegistrationPolicies
+		*out = new(common.ClientRegistrationPolicies)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKeycloakRealmSpec.
@@ -242,6 +306,18 @@ func (in *ClusterKeycloakRealmSpec) DeepCopy() *ClusterKeycloakRealmSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterKeycloakRealmStatus) DeepCopyInto(out *ClusterKeycloakRealmStatus) {
 	*out = *in
+	if in.ClientRegistrationPolicies != nil {
+		in, out := &in.ClientRegistrationPolicies, &out.ClientRegistrationPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKeycloakRealmStatus.
@@ -282,6 +358,13 @@ func (in *ClusterKeycloakSpec) DeepCopy() *ClusterKeycloakSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterKeycloakStatus) DeepCopyInto(out *ClusterKeycloakStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKeycloakStatus.
@@ -335,7 +418,7 @@ func (in *KeycloakOrganization) DeepCopyInto(out *KeycloakOrganization) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakOrganization.
@@ -433,6 +516,13 @@ func (in *KeycloakOrganizationSpec) DeepCopy() *KeycloakOrganizationSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeycloakOrganizationStatus) DeepCopyInto(out *KeycloakOrganizationStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakOrganizationStatus.
@@ -29,23 +29,29 @@ import (
 
 	buildInfo "github.com/epam/edp-common/pkg/config"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	keycloakApi1alpha1 "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
 	"github.com/epam/edp-keycloak-operator/internal/controller/clusterkeycloak"
 	"github.com/epam/edp-keycloak-operator/internal/controller/clusterkeycloakrealm"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloak"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakauthflow"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakclient"
+	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakclientinitialaccesstoken"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakclientscope"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakorganization"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm"
+	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmbackup"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmcomponent"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmgroup"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmidentityprovider"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmrole"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmrolebatch"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmuser"
+	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakuserfederation"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	webhookv1 "github.com/epam/edp-keycloak-operator/internal/webhook/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/secretref"
 	"github.com/epam/edp-keycloak-operator/pkg/util"
@@ -60,6 +66,8 @@ const (
 	keycloakOperatorLock    = "edp-keycloak-operator-lock"
 	successReconcileTimeout = "SUCCESS_RECONCILE_TIMEOUT"
 	operatorNamespaceEnv    = "OPERATOR_NAMESPACE"
+	reconcileModeEnv        = "RECONCILE_MODE"
+	deletionPolicyEnv       = "DELETION_POLICY"
 )
 
 func init() {
@@ -79,6 +87,8 @@ func main() {
 		probeAddr                                        string
 		secureMetrics                                    bool
 		enableHTTP2                                      bool
+		resourceMetrics                                  bool
+		adminEventsPollInterval                          time.Duration
 		tlsOpts                                          []func(*tls.Config)
 	)
 
@@ -99,6 +109,12 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&resourceMetrics, "resource-metrics", true,
+		"If set, readiness, failure count, last sync time and drifted fields are reported for each custom resource. "+
+			"Use --resource-metrics=false to limit the metrics cardinality in large installations.")
+	flag.DurationVar(&adminEventsPollInterval, "admin-events-poll-interval", 0,
+		"If set, admin events of realms with admin events enabled are read with this interval "+
+			"and resources changed outside of the operator are reconciled immediately. Disabled by default.")
 
 	opts := zap.Options{
 		Development: true,
@@ -107,6 +123,7 @@ func main() {
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	metrics.SetResourceMetricsEnabled(resourceMetrics)
 
 	v := buildInfo.Get()
 
@@ -248,7 +265,31 @@ func main() {
 		os.Exit(1)
 	}
 
-	h := helper.MakeHelper(mgr.GetClient(), mgr.GetScheme(), operatorNamespace, helper.EnableOwnerRef(enableOwnerRef()))
+	reconcileMode, err := getReconcileMode()
+	if err != nil {
+		setupLog.Error(err, "unable to get reconcile mode")
+		os.Exit(1)
+	}
+
+	deletionPolicy, err := getDeletionPolicy()
+	if err != nil {
+		setupLog.Error(err, "unable to get deletion policy")
+		os.Exit(1)
+	}
+
+	h := helper.MakeHelper(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		operatorNamespace,
+		helper.EnableOwnerRef(enableOwnerRef()),
+		helper.WithDefaultReconcileMode(reconcileMode),
+		helper.WithDefaultDeletionPolicy(deletionPolicy),
+	)
+
+	var adminEventsWatcher *adminevents.Watcher
+	if adminEventsPollInterval > 0 {
+		adminEventsWatcher = adminevents.NewWatcher(mgr.GetClient(), h, adminEventsPollInterval, ns == "")
+	}
 
 	keycloakCtrl := keycloak.NewReconcileKeycloak(mgr.GetClient(), mgr.GetScheme(), h)
 	if err = keycloakCtrl.SetupWithManager(mgr, successReconcileTimeoutValue); err != nil {
@@ -256,25 +297,26 @@ func main() {
 		os.Exit(1)
 	}
 
-	keycloakClientCtrl := keycloakclient.NewReconcileKeycloakClient(mgr.GetClient(), h)
-	if err = keycloakClientCtrl.SetupWithManager(mgr, successReconcileTimeoutValue); err != nil {
+	keycloakClientCtrl := keycloakclient.NewReconcileKeycloakClient(mgr.GetClient(), h).WithAdminEvents(adminEventsWatcher)
+	if err = keycloakClientCtrl.SetupWithManager(mgr, successReconcileTimeoutValue, ns == ""); err != nil {
 		setupLog.Error(err, "unable to create keycloak-client controller")
 		os.Exit(1)
 	}
 
-	keycloakRealmCtrl := keycloakrealm.NewReconcileKeycloakRealm(mgr.GetClient(), mgr.GetScheme(), h)
+	keycloakRealmCtrl := keycloakrealm.NewReconcileKeycloakRealm(mgr.GetClient(), mgr.GetScheme(), h).
+		WithAdminEvents(adminEventsWatcher)
 	if err = keycloakRealmCtrl.SetupWithManager(mgr, successReconcileTimeoutValue); err != nil {
 		setupLog.Error(err, "unable to create keycloak-realm controller")
 		os.Exit(1)
 	}
 
-	krgCtrl := keycloakrealmgroup.NewReconcileKeycloakRealmGroup(mgr.GetClient(), h)
+	krgCtrl := keycloakrealmgroup.NewReconcileKeycloakRealmGroup(mgr.GetClient(), h).WithAdminEvents(adminEventsWatcher)
 	if err = krgCtrl.SetupWithManager(mgr, successReconcileTimeoutValue); err != nil {
 		setupLog.Error(err, "unable to create keycloak-realm-group controller")
 		os.Exit(1)
 	}
 
-	krrCtrl := keycloakrealmrole.NewReconcileKeycloakRealmRole(mgr.GetClient(), h)
+	krrCtrl := keycloakrealmrole.NewReconcileKeycloakRealmRole(mgr.GetClient(), h).WithAdminEvents(adminEventsWatcher)
 	if err = krrCtrl.SetupWithManager(mgr, successReconcileTimeoutValue); err != nil {
 		setupLog.Error(err, "unable to create keycloak-realm-role controller")
 		os.Exit(1)
@@ -286,7 +328,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	kafCtrl := keycloakauthflow.NewReconcile(mgr.GetClient(), h)
+	kafCtrl := keycloakauthflow.NewReconcile(mgr.GetClient(), h).WithAdminEvents(adminEventsWatcher)
 	if err = kafCtrl.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create keycloak-auth-flow controller")
 		os.Exit(1)
@@ -299,6 +341,7 @@ func main() {
 	}
 
 	if err = keycloakclientscope.NewReconcile(mgr.GetClient(), h).
+		WithAdminEvents(adminEventsWatcher).
 		SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create keycloak-client-scope controller")
 		os.Exit(1)
@@ -310,19 +353,40 @@ func main() {
 		h,
 		secretref.NewSecretRef(mgr.GetClient()),
 	).
+		WithAdminEvents(adminEventsWatcher).
 		SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create keycloak-realm-component controller")
 		os.Exit(1)
 	}
 
 	if err = keycloakrealmidentityprovider.NewIdentityProviderReconciler(mgr.GetClient(), h).
+		WithAdminEvents(adminEventsWatcher).
 		SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create keycloak-realm-identity-provider controller")
 		os.Exit(1)
 	}
 
+	if err = keycloakrealmbackup.NewReconcileKeycloakRealmBackup(mgr.GetClient(), h).
+		SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create keycloak-realm-backup controller")
+		os.Exit(1)
+	}
+
+	if err = keycloakuserfederation.NewUserFederationReconciler(mgr.GetClient(), h).
+		WithAdminEvents(adminEventsWatcher).
+		SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create keycloak-user-federation controller")
+		os.Exit(1)
+	}
+
+	if err = keycloakclientinitialaccesstoken.NewReconcileKeycloakClientInitialAccessToken(mgr.GetClient(), h).
+		SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create keycloak-client-initial-access-token controller")
+		os.Exit(1)
+	}
+
 	if ns == "" {
-		if err = clusterkeycloak.NewReconcile(mgr.GetClient(), mgr.GetScheme(), h).
+		if err = clusterkeycloak.NewReconcile(mgr.GetClient(), mgr.GetScheme(), h, operatorNamespace).
 			SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create clusterkeycloak controller")
 			os.Exit(1)
@@ -334,18 +398,27 @@ func main() {
 			h,
 			operatorNamespace,
 		).
+			WithAdminEvents(adminEventsWatcher).
 			SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "ClusterKeycloakRealm")
 			os.Exit(1)
 		}
 	}
 
-	organizationCtrl := keycloakorganization.NewReconcileOrganization(mgr.GetClient(), h)
+	organizationCtrl := keycloakorganization.NewReconcileOrganization(mgr.GetClient(), h).
+		WithAdminEvents(adminEventsWatcher)
 	if err = organizationCtrl.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create keycloak-organization controller")
 		os.Exit(1)
 	}
 
+	if adminEventsWatcher != nil {
+		if err = mgr.Add(adminEventsWatcher); err != nil {
+			setupLog.Error(err, "unable to create admin events watcher")
+			os.Exit(1)
+		}
+	}
+
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		// Setup k8s client without cache to enable reading from non-default namespaces.
 		k8sClient, err := client.New(cfg, client.Options{Scheme: scheme})
@@ -448,3 +521,37 @@ func enableOwnerRef() bool {
 
 	return b
 }
+
+// getReconcileMode returns the operator-wide reconcile mode.
+// Resources can override it with the spec.reconcileMode field.
+func getReconcileMode() (common.ReconcileMode, error) {
+	val, exists := os.LookupEnv(reconcileModeEnv)
+	if !exists || strings.TrimSpace(val) == "" {
+		return common.ReconcileModeApply, nil
+	}
+
+	mode := common.ReconcileMode(strings.TrimSpace(val))
+	if mode != common.ReconcileModeApply && mode != common.ReconcileModeObserve {
+		return "", fmt.Errorf("environment variable %s has unsupported value %q, expected %q or %q",
+			reconcileModeEnv, val, common.ReconcileModeApply, common.ReconcileModeObserve)
+	}
+
+	return mode, nil
+}
+
+// getDeletionPolicy returns the operator-wide deletion policy.
+// Resources can override it with the spec.deletionPolicy field.
+func getDeletionPolicy() (common.DeletionPolicy, error) {
+	val, exists := os.LookupEnv(deletionPolicyEnv)
+	if !exists || strings.TrimSpace(val) == "" {
+		return common.DeletionPolicyDelete, nil
+	}
+
+	policy := common.DeletionPolicy(strings.TrimSpace(val))
+	if policy != common.DeletionPolicyDelete && policy != common.DeletionPolicyRetain {
+		return "", fmt.Errorf("environment variable %s has unsupported value %q, expected %q or %q",
+			deletionPolicyEnv, val, common.DeletionPolicyDelete, common.DeletionPolicyRetain)
+	}
+
+	return policy, nil
+}
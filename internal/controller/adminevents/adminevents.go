@@ -0,0 +1,237 @@
+// Package adminevents watches Keycloak admin events and reconciles custom resources
+// whose Keycloak objects were changed outside of the operator, for example, in the Keycloak admin console.
+// Custom resources are indexed by the realm and the admin event resource paths of the Keycloak objects they manage,
+// so only the affected resources are enqueued instead of waiting for the next periodic resync.
+package adminevents
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
+)
+
+// IndexField is the name of the field index of admin event resource keys.
+const IndexField = "adminevents.resource"
+
+// Admin event resource path prefixes of Keycloak objects managed by custom resources.
+const (
+	ResourceClients           = "clients"
+	ResourceClientScopes      = "client-scopes"
+	ResourceGroups            = "groups"
+	ResourceRoles             = "roles"
+	ResourceRolesByID         = "roles-by-id"
+	ResourceComponents        = "components"
+	ResourceAuthFlows         = "authentication/flows"
+	ResourceIdentityProviders = "identity-provider/instances"
+	ResourceOrganizations     = "organizations"
+)
+
+// nestedResources are resource path prefixes that consist of two path segments.
+var nestedResources = []string{ResourceAuthFlows, ResourceIdentityProviders}
+
+// eventsBufferSize is the size of the channel of events sent to each controller.
+const eventsBufferSize = 1024
+
+// KeysFunc returns admin event resource keys of Keycloak objects managed by the custom resource.
+type KeysFunc func(obj client.Object) []string
+
+// Key returns the admin event resource key of the Keycloak object, for example, clients/<id>.
+// It returns an empty string if the object ID is unknown.
+func Key(resource, id string) string {
+	if id == "" {
+		return ""
+	}
+
+	return resource + "/" + id
+}
+
+// ResourceKey returns the resource key of the admin event resource path.
+// Paths of nested objects are mapped to the key of the parent object,
+// for example, clients/<id>/roles/<name> is mapped to clients/<id>.
+func ResourceKey(resourcePath string) string {
+	for _, prefix := range nestedResources {
+		if rest, ok := strings.CutPrefix(resourcePath, prefix+"/"); ok {
+			id, _, _ := strings.Cut(rest, "/")
+
+			return Key(prefix, id)
+		}
+	}
+
+	resource, rest, ok := strings.Cut(resourcePath, "/")
+	if !ok {
+		return ""
+	}
+
+	id, _, _ := strings.Cut(rest, "/")
+
+	return Key(resource, id)
+}
+
+// watched is a custom resource kind that is reconciled on admin events.
+type watched struct {
+	kind    string
+	indexed bool
+	newList func() client.ObjectList
+	events  chan event.GenericEvent
+}
+
+// Setup registers the field index of admin event resource keys of the watched objects
+// and the source of events sent by the watcher. If keys is nil, the objects are enqueued
+// only on admin events of their own realm, this is used by the realm controllers.
+// If the watcher is nil, admin events are not read and nothing is registered.
+func Setup(
+	ctx context.Context,
+	mgr ctrl.Manager,
+	b *builder.Builder,
+	watcher *Watcher,
+	obj client.Object,
+	newList func() client.ObjectList,
+	keys KeysFunc,
+) error {
+	if watcher == nil {
+		return nil
+	}
+
+	w := &watched{
+		kind:    metrics.KindOf(obj),
+		indexed: keys != nil,
+		newList: newList,
+		events:  make(chan event.GenericEvent, eventsBufferSize),
+	}
+
+	if w.indexed {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, obj, IndexField, IndexFunc(keys)); err != nil {
+			return fmt.Errorf("unable to index admin event resource keys: %w", err)
+		}
+	}
+
+	b.WatchesRawSource(source.Channel(w.events, &handler.EnqueueRequestForObject{}))
+
+	watcher.register(w)
+
+	return nil
+}
+
+// IndexFunc returns a field index function of admin event resource keys of the object.
+// The keys are prefixed with the realm referenced by the object, so objects with the same ID or name
+// in different realms are not mixed up.
+func IndexFunc(keys KeysFunc) client.IndexerFunc {
+	return func(obj client.Object) []string {
+		realm := realmRefKey(obj)
+		if realm == "" {
+			return nil
+		}
+
+		var values []string
+
+		for _, key := range keys(obj) {
+			if key != "" {
+				values = append(values, realmResourceKey(realm, key))
+			}
+		}
+
+		slices.Sort(values)
+
+		return slices.Compact(values)
+	}
+}
+
+// realmResourceKey returns the index value of the resource key in the realm with the given key.
+func realmResourceKey(realm, key string) string {
+	return realm + ":" + key
+}
+
+// realmRefKey returns the key of the realm referenced by the object in the format of realmKey.
+// It returns an empty string if the object doesn't reference a realm.
+func realmRefKey(obj client.Object) string {
+	o, ok := obj.(common.HasRealmRef)
+	if !ok || o.GetRealmRef().Name == "" {
+		return ""
+	}
+
+	ref := o.GetRealmRef()
+	if ref.Kind == keycloakAlpha.ClusterKeycloakRealmKind {
+		return fmt.Sprintf("%s//%s", ref.Kind, ref.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", keycloakApi.KeycloakRealmKind, obj.GetNamespace(), ref.Name)
+}
+
+// register adds the custom resource kind to the kinds the watcher sends events to.
+func (w *Watcher) register(kind *watched) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.watched = append(w.watched, kind)
+}
+
+// enqueueKey sends events for all custom resources that manage the Keycloak object with the given index key.
+func (w *Watcher) enqueueKey(ctx context.Context, key string) {
+	log := ctrl.LoggerFrom(ctx)
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	for _, kind := range w.watched {
+		if !kind.indexed {
+			continue
+		}
+
+		list := kind.newList()
+		if err := w.client.List(ctx, list, client.MatchingFields{IndexField: key}); err != nil {
+			log.Error(err, "Unable to list objects for admin event", "kind", kind.kind, "resource", key)
+
+			continue
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			continue
+		}
+
+		for _, item := range items {
+			if o, ok := item.(client.Object); ok {
+				kind.send(ctx, o)
+			}
+		}
+	}
+}
+
+// enqueueObject sends an event for the custom resource.
+func (w *Watcher) enqueueObject(ctx context.Context, obj client.Object) {
+	objKind := metrics.KindOf(obj)
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	for _, kind := range w.watched {
+		if kind.kind == objKind {
+			kind.send(ctx, obj)
+		}
+	}
+}
+
+// send sends an event without blocking the watcher.
+// If the controller is busy, the event is dropped, the object is reconciled on the next periodic resync.
+func (w *watched) send(ctx context.Context, obj client.Object) {
+	select {
+	case w.events <- event.GenericEvent{Object: obj}:
+		ctrl.LoggerFrom(ctx).V(1).Info("Object enqueued on admin event", "kind", w.kind, "name", obj.GetName())
+	default:
+		ctrl.LoggerFrom(ctx).Info("Admin event is dropped, controller queue is full", "kind", w.kind, "name", obj.GetName())
+	}
+}
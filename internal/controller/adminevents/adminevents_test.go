@@ -0,0 +1,182 @@
+package adminevents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestResourceKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "clients/a1b2", want: "clients/a1b2"},
+		{path: "clients/a1b2/roles/viewer", want: "clients/a1b2"},
+		{path: "groups/g1/role-mappings/realm", want: "groups/g1"},
+		{path: "roles-by-id/r1/composites", want: "roles-by-id/r1"},
+		{path: "authentication/flows/f1", want: "authentication/flows/f1"},
+		{path: "authentication/flows/browser-copy/executions", want: "authentication/flows/browser-copy"},
+		{path: "identity-provider/instances/github/mappers/m1", want: "identity-provider/instances/github"},
+		{path: "users", want: ""},
+		{path: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ResourceKey(tt.path))
+		})
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	t.Parallel()
+
+	index := IndexFunc(func(client.Object) []string {
+		return []string{Key(ResourceRoles, "admin"), Key(ResourceRolesByID, ""), Key(ResourceRoles, "admin")}
+	})
+
+	role := &keycloakApi.KeycloakRealmRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "default"},
+		Spec:       keycloakApi.KeycloakRealmRoleSpec{RealmRef: common.RealmRef{Name: "realm"}},
+	}
+	clusterRole := &keycloakApi.KeycloakRealmRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "default"},
+		Spec: keycloakApi.KeycloakRealmRoleSpec{
+			RealmRef: common.RealmRef{Kind: keycloakAlpha.ClusterKeycloakRealmKind, Name: "realm"},
+		},
+	}
+
+	assert.Equal(t, []string{"KeycloakRealm/default/realm:roles/admin"}, index(role))
+	assert.Equal(t, []string{"ClusterKeycloakRealm//realm:roles/admin"}, index(clusterRole))
+	assert.Empty(t, index(&keycloakApi.KeycloakRealmRole{}))
+}
+
+func TestChangedResources(t *testing.T) {
+	t.Parallel()
+
+	adminEvents := []keycloakapi.AdminEventRepresentation{
+		{Time: ptr.To(int64(90)), ResourcePath: ptr.To("clients/old")},
+		{Time: ptr.To(int64(110)), ResourcePath: ptr.To("clients/c1"), AuthDetails: &keycloakapi.AuthDetailsRepresentation{UserId: ptr.To("admin")}},
+		{Time: ptr.To(int64(120)), ResourcePath: ptr.To("clients/c1/roles/viewer")},
+		{Time: ptr.To(int64(130)), ResourcePath: ptr.To("groups/g1"), AuthDetails: &keycloakapi.AuthDetailsRepresentation{UserId: ptr.To("operator")}},
+		{Time: ptr.To(int64(140)), ResourcePath: ptr.To("client-scopes/s1"), Error: ptr.To("unknown_error")},
+		{Time: ptr.To(int64(150)), ResourceType: ptr.To(resourceTypeRealm)},
+	}
+
+	keys, realmChanged, lastEventTime := changedResources(adminEvents, 100, "operator")
+
+	assert.Equal(t, []string{"clients/c1"}, keys)
+	assert.True(t, realmChanged)
+	assert.Equal(t, int64(150), lastEventTime)
+}
+
+type fakeHelper struct {
+	apiClient *keycloakapi.KeycloakClient
+}
+
+func (h fakeHelper) CreateKeycloakClientFromRealm(context.Context, *keycloakApi.KeycloakRealm) (*keycloakapi.KeycloakClient, error) {
+	return h.apiClient, nil
+}
+
+func (h fakeHelper) CreateKeycloakClientFromClusterRealm(
+	context.Context,
+	*keycloakAlpha.ClusterKeycloakRealm,
+) (*keycloakapi.KeycloakClient, error) {
+	return h.apiClient, nil
+}
+
+func TestWatcher_Poll(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+	realm := &keycloakApi.KeycloakRealm{
+		ObjectMeta: metav1.ObjectMeta{Name: "realm", Namespace: "default"},
+		Spec: keycloakApi.KeycloakRealmSpec{
+			RealmName:        "master",
+			RealmEventConfig: &common.RealmEventConfig{AdminEventsEnabled: ptr.To(true)},
+		},
+	}
+	role := &keycloakApi.KeycloakRealmRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "default"},
+		Spec:       keycloakApi.KeycloakRealmRoleSpec{Name: "admin", RealmRef: common.RealmRef{Name: "realm"}},
+		Status:     keycloakApi.KeycloakRealmRoleStatus{ID: "r1"},
+	}
+	otherRole := &keycloakApi.KeycloakRealmRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-role", Namespace: "default"},
+		Spec:       keycloakApi.KeycloakRealmRoleSpec{Name: "viewer", RealmRef: common.RealmRef{Name: "realm"}},
+		Status:     keycloakApi.KeycloakRealmRoleStatus{ID: "r2"},
+	}
+	otherRealmRole := &keycloakApi.KeycloakRealmRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-realm-role", Namespace: "default"},
+		Spec:       keycloakApi.KeycloakRealmRoleSpec{Name: "admin", RealmRef: common.RealmRef{Name: "other-realm"}},
+		Status:     keycloakApi.KeycloakRealmRoleStatus{ID: "r1"},
+	}
+
+	roleKeys := func(obj client.Object) []string {
+		r, ok := obj.(*keycloakApi.KeycloakRealmRole)
+		if !ok {
+			return nil
+		}
+
+		return []string{Key(ResourceRolesByID, r.Status.ID), Key(ResourceRoles, r.Spec.Name)}
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(realm, role, otherRole, otherRealmRole).
+		WithIndex(&keycloakApi.KeycloakRealmRole{}, IndexField, IndexFunc(roleKeys)).
+		Build()
+
+	roles := &watched{
+		kind:    keycloakApi.KeycloakRealmRoleKind,
+		indexed: true,
+		newList: func() client.ObjectList { return &keycloakApi.KeycloakRealmRoleList{} },
+		events:  make(chan event.GenericEvent, 10),
+	}
+	realms := &watched{
+		kind:    keycloakApi.KeycloakRealmKind,
+		newList: func() client.ObjectList { return &keycloakApi.KeycloakRealmList{} },
+		events:  make(chan event.GenericEvent, 10),
+	}
+
+	eventsClient := v2mocks.NewMockEventsClient(t)
+	eventsClient.On("GetAdminEvents", mock.Anything, "master", mock.Anything).
+		Return([]keycloakapi.AdminEventRepresentation{
+			{Time: ptr.To(int64(1 << 62)), ResourcePath: ptr.To("roles-by-id/r1/composites")},
+			{Time: ptr.To(int64(1 << 62)), ResourceType: ptr.To(resourceTypeRealm)},
+		}, nil, nil)
+
+	w := NewWatcher(k8sClient, fakeHelper{apiClient: &keycloakapi.KeycloakClient{Events: eventsClient}}, 0, false)
+	w.register(roles)
+	w.register(realms)
+
+	w.Poll(context.Background())
+
+	require.Len(t, roles.events, 1)
+	assert.Equal(t, "role", (<-roles.events).Object.GetName())
+
+	require.Len(t, realms.events, 1)
+	assert.Equal(t, "realm", (<-realms.events).Object.GetName())
+}
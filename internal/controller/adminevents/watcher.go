@@ -0,0 +1,253 @@
+package adminevents
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+const (
+	// maxEvents is the maximum number of admin events read from the realm per poll.
+	maxEvents = 1000
+
+	// resourceTypeRealm is the admin event resource type of realm settings changes.
+	resourceTypeRealm = "REALM"
+)
+
+// Helper creates Keycloak API clients for realms.
+type Helper interface {
+	CreateKeycloakClientFromRealm(ctx context.Context, realm *keycloakApi.KeycloakRealm) (*keycloakapi.KeycloakClient, error)
+	CreateKeycloakClientFromClusterRealm(ctx context.Context, realm *keycloakAlpha.ClusterKeycloakRealm) (*keycloakapi.KeycloakClient, error)
+}
+
+// realmState holds the Keycloak API client and the time of the last handled admin event of the realm.
+type realmState struct {
+	apiClient     *keycloakapi.KeycloakClient
+	lastEventTime int64
+}
+
+// Watcher periodically reads admin events of realms with admin events enabled
+// and enqueues custom resources that manage the changed Keycloak objects.
+// Changes made by the operator itself are ignored.
+// Controllers register the kinds of custom resources to enqueue with Setup.
+type Watcher struct {
+	client        client.Client
+	helper        Helper
+	interval      time.Duration
+	clusterRealms bool
+	realms        map[string]*realmState
+
+	mu      sync.RWMutex
+	watched []*watched
+}
+
+// NewWatcher returns a watcher that reads admin events with the given interval.
+// If clusterRealms is true, admin events of ClusterKeycloakRealm resources are also read.
+func NewWatcher(k8sClient client.Client, h Helper, interval time.Duration, clusterRealms bool) *Watcher {
+	return &Watcher{
+		client:        k8sClient,
+		helper:        h,
+		interval:      interval,
+		clusterRealms: clusterRealms,
+		realms:        make(map[string]*realmState),
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, only the leader reads admin events.
+func (w *Watcher) NeedLeaderElection() bool {
+	return true
+}
+
+// Start reads admin events until the context is canceled.
+func (w *Watcher) Start(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("admin-events")
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	log.Info("Starting admin events watcher", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.Poll(ctx)
+		}
+	}
+}
+
+// Poll reads new admin events of all realms and enqueues the affected custom resources.
+func (w *Watcher) Poll(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx)
+	seen := make(map[string]bool)
+
+	realms := &keycloakApi.KeycloakRealmList{}
+	if err := w.client.List(ctx, realms); err != nil {
+		log.Error(err, "Unable to list KeycloakRealms")
+	}
+
+	for i := range realms.Items {
+		realm := &realms.Items[i]
+		if !adminEventsEnabled(realm.Spec.RealmEventConfig) {
+			continue
+		}
+
+		key := realmKey(realm)
+		seen[key] = true
+
+		if err := w.pollRealm(ctx, key, realm, realm.Spec.RealmName, func() (*keycloakapi.KeycloakClient, error) {
+			return w.helper.CreateKeycloakClientFromRealm(ctx, realm)
+		}); err != nil {
+			log.Error(err, "Unable to read admin events", "realm", realm.Name)
+		}
+	}
+
+	if w.clusterRealms {
+		clusterRealms := &keycloakAlpha.ClusterKeycloakRealmList{}
+		if err := w.client.List(ctx, clusterRealms); err != nil {
+			log.Error(err, "Unable to list ClusterKeycloakRealms")
+		}
+
+		for i := range clusterRealms.Items {
+			realm := &clusterRealms.Items[i]
+			if !adminEventsEnabled(realm.Spec.RealmEventConfig) {
+				continue
+			}
+
+			key := realmKey(realm)
+			seen[key] = true
+
+			if err := w.pollRealm(ctx, key, realm, realm.Spec.RealmName, func() (*keycloakapi.KeycloakClient, error) {
+				return w.helper.CreateKeycloakClientFromClusterRealm(ctx, realm)
+			}); err != nil {
+				log.Error(err, "Unable to read admin events", "realm", realm.Name)
+			}
+		}
+	}
+
+	for key := range w.realms {
+		if !seen[key] {
+			delete(w.realms, key)
+		}
+	}
+}
+
+// pollRealm reads admin events of the realm that happened after the last handled event.
+// Events that happened before the realm was first polled are skipped.
+func (w *Watcher) pollRealm(
+	ctx context.Context,
+	key string,
+	realm client.Object,
+	realmName string,
+	newAPIClient func() (*keycloakapi.KeycloakClient, error),
+) error {
+	state, ok := w.realms[key]
+	if !ok {
+		state = &realmState{lastEventTime: time.Now().UnixMilli()}
+		w.realms[key] = state
+	}
+
+	if state.apiClient == nil {
+		apiClient, err := newAPIClient()
+		if err != nil {
+			return fmt.Errorf("unable to create keycloak client: %w", err)
+		}
+
+		state.apiClient = apiClient
+	}
+
+	dateFrom := strconv.FormatInt(state.lastEventTime, 10)
+	maxResults := int32(maxEvents)
+
+	adminEvents, _, err := state.apiClient.Events.GetAdminEvents(ctx, realmName, &keycloakapi.GetAdminEventsParams{
+		DateFrom: &dateFrom,
+		Max:      &maxResults,
+	})
+	if err != nil {
+		// The client is recreated on the next poll in case the credentials have changed.
+		state.apiClient = nil
+
+		return fmt.Errorf("unable to get admin events: %w", err)
+	}
+
+	keys, realmChanged, lastEventTime := changedResources(adminEvents, state.lastEventTime, state.apiClient.TokenSubject())
+	state.lastEventTime = lastEventTime
+
+	if realmChanged {
+		w.enqueueObject(ctx, realm)
+	}
+
+	for _, resourceKey := range keys {
+		w.enqueueKey(ctx, realmResourceKey(key, resourceKey))
+	}
+
+	return nil
+}
+
+// changedResources returns resource keys of objects changed after the given time by users other than the operator,
+// whether the realm settings were changed, and the time of the last event.
+func changedResources(
+	adminEvents []keycloakapi.AdminEventRepresentation,
+	after int64,
+	operatorUserID string,
+) (keys []string, realmChanged bool, lastEventTime int64) {
+	lastEventTime = after
+	seen := make(map[string]bool)
+
+	for i := range adminEvents {
+		e := &adminEvents[i]
+
+		if e.Time == nil || *e.Time <= after {
+			continue
+		}
+
+		lastEventTime = max(lastEventTime, *e.Time)
+
+		if e.Error != nil && *e.Error != "" {
+			continue
+		}
+
+		if operatorUserID != "" && e.AuthDetails != nil && e.AuthDetails.UserId != nil &&
+			*e.AuthDetails.UserId == operatorUserID {
+			continue
+		}
+
+		if e.ResourceType != nil && *e.ResourceType == resourceTypeRealm {
+			realmChanged = true
+
+			continue
+		}
+
+		if e.ResourcePath == nil {
+			continue
+		}
+
+		if key := ResourceKey(*e.ResourcePath); key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, realmChanged, lastEventTime
+}
+
+func adminEventsEnabled(config *common.RealmEventConfig) bool {
+	return config != nil && config.AdminEventsEnabled != nil && *config.AdminEventsEnabled
+}
+
+func realmKey(realm client.Object) string {
+	return fmt.Sprintf("%s/%s/%s", metrics.KindOf(realm), realm.GetNamespace(), realm.GetName())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -15,6 +16,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -26,19 +31,22 @@ func NewReconcile(
 	k8sClient client.Client,
 	scheme *runtime.Scheme,
 	controllerHelper keycloakClientProvider,
+	operatorNamespace string,
 ) *Reconciler {
 	return &Reconciler{
-		client: k8sClient,
-		scheme: scheme,
-		helper: controllerHelper,
+		client:            k8sClient,
+		scheme:            scheme,
+		helper:            controllerHelper,
+		operatorNamespace: operatorNamespace,
 	}
 }
 
 // Reconciler reconciles a Keycloak object.
 type Reconciler struct {
-	client client.Client
-	scheme *runtime.Scheme
-	helper keycloakClientProvider
+	client            client.Client
+	scheme            *runtime.Scheme
+	helper            keycloakClientProvider
+	operatorNamespace string
 }
 
 const (
@@ -91,17 +99,44 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		},
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakAlpha.ClusterKeycloak{}, builder.WithPredicates(pred)).
-		Complete(r)
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakAlpha.ClusterKeycloak{}, builder.WithPredicates(pred))
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakAlpha.ClusterKeycloak{},
+		func() client.ObjectList { return &keycloakAlpha.ClusterKeycloakList{} },
+		r.secretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup ClusterKeycloak secret watches: %w", err)
+	}
 
-	if err != nil {
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakAlpha.ClusterKeycloak{}, pause.NewReconciler(mgr.GetClient(), &keycloakAlpha.ClusterKeycloak{}, r)))); err != nil {
 		return fmt.Errorf("failed to setup ClusterKeycloak controller: %w", err)
 	}
 
 	return nil
 }
 
+// secretRefs returns Secrets and ConfigMaps with credentials and CA certificate of the ClusterKeycloak.
+// They are located in the operator namespace.
+func (r *Reconciler) secretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	kc, ok := obj.(*keycloakAlpha.ClusterKeycloak)
+	if !ok {
+		return refs
+	}
+
+	refs.AddSecret(r.operatorNamespace, kc.Spec.Secret)
+	refs.AddAuth(r.operatorNamespace, kc.Spec.Auth)
+	refs.AddSourceRef(r.operatorNamespace, kc.Spec.CACert)
+
+	return refs
+}
+
 func (r *Reconciler) updateConnectionStatusToKeycloak(ctx context.Context, instance *keycloakAlpha.ClusterKeycloak) error {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start updating connection status to ClusterKeycloak")
@@ -109,19 +144,27 @@ func (r *Reconciler) updateConnectionStatusToKeycloak(ctx context.Context, insta
 	err := r.createClient(ctx, instance)
 	if err != nil {
 		log.Error(err, "Unable to connect to Keycloak")
+		events.Warning(ctx, instance, events.ReasonKeycloakAPIError, "Unable to connect to Keycloak: %s", err.Error())
 	}
 
 	connected := err == nil
+	oldStatus := instance.Status.DeepCopy()
+
+	instance.Status.Connected = connected
 
-	if instance.Status.Connected == connected {
+	if connected {
+		status.SetReady(instance, "Connected to Keycloak")
+	} else {
+		status.SetNotReady(instance, events.ReasonKeycloakAPIError, fmt.Sprintf("Unable to connect to Keycloak: %s", err.Error()))
+	}
+
+	if equality.Semantic.DeepEqual(&instance.Status, oldStatus) {
 		log.Info("Connection status hasn't been changed", "status", instance.Status.Connected)
 
 		return nil
 	}
 
-	log.Info("Connection status has been changed", "from", instance.Status.Connected, "to", connected)
-
-	instance.Status.Connected = connected
+	log.Info("Connection status has been changed", "from", oldStatus.Connected, "to", connected)
 
 	err = r.client.Status().Update(ctx, instance)
 	if err != nil {
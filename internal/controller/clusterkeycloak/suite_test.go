@@ -80,7 +80,7 @@ var _ = BeforeSuite(func() {
 
 	h := helper.MakeHelper(k8sManager.GetClient(), k8sManager.GetScheme(), "default")
 
-	err = NewReconcile(k8sManager.GetClient(), k8sManager.GetScheme(), h).
+	err = NewReconcile(k8sManager.GetClient(), k8sManager.GetScheme(), h, "default").
 		SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
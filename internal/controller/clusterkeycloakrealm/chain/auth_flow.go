@@ -5,29 +5,48 @@ import (
 	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/realmbuilder"
 )
 
 type AuthFlow struct {
+	client client.Client
 }
 
-func NewAuthFlow() *AuthFlow {
-	return &AuthFlow{}
+func NewAuthFlow(client client.Client) *AuthFlow {
+	return &AuthFlow{client: client}
 }
 
 func (a AuthFlow) ServeRequest(ctx context.Context, realm *keycloakApi.ClusterKeycloakRealm, kClient *keycloakapi.KeycloakClient) error {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start configuring authentication flow")
 
-	if realm.Spec.AuthenticationFlow == nil || realm.Spec.AuthenticationFlow.BrowserFlow == "" {
+	bindings := realmbuilder.FlowBindingsFromV1Alpha1(realm)
+	aliases := bindings.Aliases()
+
+	if len(aliases) == 0 {
 		log.Info("Authentication flow is not provided, skip configuring")
 		return nil
 	}
 
-	if _, err := kClient.Realms.SetRealmBrowserFlow(ctx, realm.Spec.RealmName, realm.Spec.AuthenticationFlow.BrowserFlow); err != nil {
-		return fmt.Errorf("setting realm browser flow: %w", err)
+	// KeycloakAuthFlow resources that reference ClusterKeycloakRealm can be in any namespace.
+	if err := helper.CheckAuthFlowsReady(
+		ctx,
+		a.client,
+		common.RealmRef{Kind: keycloakApi.ClusterKeycloakRealmKind, Name: realm.Name},
+		"",
+		aliases,
+	); err != nil {
+		return err
+	}
+
+	if err := realmbuilder.ApplyRealmFlowBindings(ctx, realm.Spec.RealmName, bindings, kClient.Realms); err != nil {
+		return fmt.Errorf("setting realm authentication flows: %w", err)
 	}
 
 	log.Info("Authentication flow has been configured")
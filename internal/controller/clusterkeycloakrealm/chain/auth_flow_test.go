@@ -8,8 +8,15 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakv1 "github.com/epam/edp-keycloak-operator/api/v1"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1alpha1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -21,6 +28,7 @@ func TestAuthFlow_ServeRequest(t *testing.T) {
 	tests := []struct {
 		name      string
 		realm     *keycloakApi.ClusterKeycloakRealm
+		objects   []client.Object
 		mockRealm func(t *testing.T) *v2mocks.MockRealmClient
 		wantErr   require.ErrorAssertionFunc
 	}{
@@ -44,13 +52,96 @@ func TestAuthFlow_ServeRequest(t *testing.T) {
 			},
 			mockRealm: func(t *testing.T) *v2mocks.MockRealmClient {
 				m := v2mocks.NewMockRealmClient(t)
-				m.EXPECT().SetRealmBrowserFlow(mock.Anything, "realm1", "flow-alias-1").
-					Return(nil, nil)
+				m.EXPECT().GetAuthenticationFlows(mock.Anything, "realm1").
+					Return([]keycloakapi.AuthenticationFlowRepresentation{{Alias: ptr.To("flow-alias-1")}}, nil, nil)
+				m.EXPECT().GetRealm(mock.Anything, "realm1").
+					Return(&keycloakapi.RealmRepresentation{}, nil, nil)
+				m.EXPECT().UpdateRealm(mock.Anything, "realm1", mock.MatchedBy(func(rep keycloakapi.RealmRepresentation) bool {
+					return rep.BrowserFlow != nil && *rep.BrowserFlow == "flow-alias-1"
+				})).Return(nil, nil)
 
 				return m
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "all flows are already bound",
+			realm: &keycloakApi.ClusterKeycloakRealm{
+				Spec: keycloakApi.ClusterKeycloakRealmSpec{
+					RealmName: "realm1",
+					AuthenticationFlow: &keycloakApi.AuthenticationFlow{
+						BrowserFlow:          "flow-alias-1",
+						FirstBrokerLoginFlow: "broker-flow",
+					},
+				},
+			},
+			mockRealm: func(t *testing.T) *v2mocks.MockRealmClient {
+				m := v2mocks.NewMockRealmClient(t)
+				m.EXPECT().GetAuthenticationFlows(mock.Anything, "realm1").
+					Return([]keycloakapi.AuthenticationFlowRepresentation{
+						{Alias: ptr.To("flow-alias-1")},
+						{Alias: ptr.To("broker-flow")},
+					}, nil, nil)
+				m.EXPECT().GetRealm(mock.Anything, "realm1").
+					Return(&keycloakapi.RealmRepresentation{
+						BrowserFlow:          ptr.To("flow-alias-1"),
+						FirstBrokerLoginFlow: ptr.To("broker-flow"),
+					}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "flow does not exist in realm",
+			realm: &keycloakApi.ClusterKeycloakRealm{
+				Spec: keycloakApi.ClusterKeycloakRealmSpec{
+					RealmName: "realm1",
+					AuthenticationFlow: &keycloakApi.AuthenticationFlow{
+						DirectGrantFlow: "missing-flow",
+					},
+				},
+			},
+			mockRealm: func(t *testing.T) *v2mocks.MockRealmClient {
+				m := v2mocks.NewMockRealmClient(t)
+				m.EXPECT().GetAuthenticationFlows(mock.Anything, "realm1").
+					Return([]keycloakapi.AuthenticationFlowRepresentation{{Alias: ptr.To("direct grant")}}, nil, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, i ...any) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "missing-flow")
+			},
+		},
+		{
+			name: "waiting for KeycloakAuthFlow in another namespace",
+			realm: &keycloakApi.ClusterKeycloakRealm{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-realm"},
+				Spec: keycloakApi.ClusterKeycloakRealmSpec{
+					RealmName: "realm1",
+					AuthenticationFlow: &keycloakApi.AuthenticationFlow{
+						ResetCredentialsFlow: "custom-reset",
+					},
+				},
+			},
+			objects: []client.Object{
+				&keycloakv1.KeycloakAuthFlow{
+					ObjectMeta: metav1.ObjectMeta{Name: "reset", Namespace: "team-a"},
+					Spec: keycloakv1.KeycloakAuthFlowSpec{
+						RealmRef: common.RealmRef{Kind: keycloakApi.ClusterKeycloakRealmKind, Name: "cluster-realm"},
+						Alias:    "custom-reset",
+					},
+				},
+			},
+			mockRealm: func(t *testing.T) *v2mocks.MockRealmClient {
+				return v2mocks.NewMockRealmClient(t)
+			},
+			wantErr: func(t require.TestingT, err error, i ...any) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "team-a/reset")
+			},
+		},
 		{
 			name: "error on setting realm browser flow",
 			realm: &keycloakApi.ClusterKeycloakRealm{
@@ -63,14 +154,18 @@ func TestAuthFlow_ServeRequest(t *testing.T) {
 			},
 			mockRealm: func(t *testing.T) *v2mocks.MockRealmClient {
 				m := v2mocks.NewMockRealmClient(t)
-				m.EXPECT().SetRealmBrowserFlow(mock.Anything, "realm1", "flow-alias-1").
+				m.EXPECT().GetAuthenticationFlows(mock.Anything, "realm1").
+					Return([]keycloakapi.AuthenticationFlowRepresentation{{Alias: ptr.To("flow-alias-1")}}, nil, nil)
+				m.EXPECT().GetRealm(mock.Anything, "realm1").
+					Return(&keycloakapi.RealmRepresentation{}, nil, nil)
+				m.EXPECT().UpdateRealm(mock.Anything, "realm1", mock.Anything).
 					Return(nil, errors.New("failed to set realm browser flow"))
 
 				return m
 			},
 			wantErr: func(t require.TestingT, err error, i ...any) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "setting realm browser flow")
+				require.Contains(t, err.Error(), "setting realm authentication flows")
 			},
 		},
 	}
@@ -81,7 +176,10 @@ func TestAuthFlow_ServeRequest(t *testing.T) {
 
 			kClient := &keycloakapi.KeycloakClient{Realms: tt.mockRealm(t)}
 
-			a := NewAuthFlow()
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakv1.AddToScheme(s))
+
+			a := NewAuthFlow(fake.NewClientBuilder().WithScheme(s).WithObjects(tt.objects...).Build())
 			err := a.ServeRequest(
 				ctrl.LoggerInto(context.Background(), logr.Discard()),
 				tt.realm,
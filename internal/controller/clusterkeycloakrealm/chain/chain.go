@@ -7,6 +7,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -31,6 +32,8 @@ func (ch *chain) ServeRequest(ctx context.Context, realm *v1alpha1.ClusterKeyclo
 		h := ch.handlers[i]
 
 		err := h.ServeRequest(ctx, realm, kClient)
+		status.SetStep(realm, stepCondition(h), err)
+
 		if err != nil {
 			log.Info("ClusterKeycloak chain finished with error")
 
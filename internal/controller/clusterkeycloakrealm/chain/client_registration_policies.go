@@ -0,0 +1,48 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/realmbuilder"
+)
+
+type ClientRegistrationPolicies struct {
+}
+
+func NewClientRegistrationPolicies() *ClientRegistrationPolicies {
+	return &ClientRegistrationPolicies{}
+}
+
+func (h ClientRegistrationPolicies) ServeRequest(ctx context.Context, realm *v1alpha1.ClusterKeycloakRealm, kClient *keycloakapi.KeycloakClient) error {
+	l := ctrl.LoggerFrom(ctx)
+
+	if realm.Spec.ClientRegistrationPolicies == nil && len(realm.Status.ClientRegistrationPolicies) == 0 {
+		l.Info("Client registration policies are empty, skipping configuration")
+
+		return nil
+	}
+
+	l.Info("Start configuring keycloak realm client registration policies")
+
+	managed, err := realmbuilder.SyncClientRegistrationPolicies(
+		ctx,
+		realm.Spec.RealmName,
+		realm.Spec.ClientRegistrationPolicies,
+		realm.Status.ClientRegistrationPolicies,
+		kClient.RealmComponents,
+	)
+	realm.Status.ClientRegistrationPolicies = managed
+
+	if err != nil {
+		return fmt.Errorf("unable to sync client registration policies: %w", err)
+	}
+
+	l.Info("Client registration policies have been configured")
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionRealmSynced indicates whether the realm has been created in Keycloak.
+	ConditionRealmSynced = "RealmSynced"
+
+	// ConditionRealmSettingsSynced indicates whether the realm settings have been applied.
+	ConditionRealmSettingsSynced = "RealmSettingsSynced"
+
+	// ConditionLocalizationTextsSynced indicates whether the realm localization texts have been synced to Keycloak.
+	ConditionLocalizationTextsSynced = "LocalizationTextsSynced"
+
+	// ConditionUserProfileSynced indicates whether the user profile has been synced to Keycloak.
+	ConditionUserProfileSynced = "UserProfileSynced"
+
+	// ConditionClientRegistrationPoliciesSynced indicates whether the client registration policies have been synced to Keycloak.
+	ConditionClientRegistrationPoliciesSynced = "ClientRegistrationPoliciesSynced"
+
+	// ConditionEmailSynced indicates whether the realm email settings have been applied.
+	ConditionEmailSynced = "EmailSynced"
+
+	// ConditionAuthFlowsSynced indicates whether the realm authentication flows have been applied.
+	ConditionAuthFlowsSynced = "AuthFlowsSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h RealmHandler) string {
+	switch h.(type) {
+	case *PutRealm:
+		return ConditionRealmSynced
+	case *PutRealmSettings:
+		return ConditionRealmSettingsSynced
+	case *PutRealmLocalizationTexts:
+		return ConditionLocalizationTextsSynced
+	case *UserProfile:
+		return ConditionUserProfileSynced
+	case *ClientRegistrationPolicies:
+		return ConditionClientRegistrationPoliciesSynced
+	case *ConfigureEmail:
+		return ConditionEmailSynced
+	case *AuthFlow:
+		return ConditionAuthFlowsSynced
+	default:
+		return ""
+	}
+}
@@ -11,8 +11,9 @@ func MakeChain(c client.Client, operatorNs string) RealmHandler {
 		NewPutRealmSettings(),
 		NewPutRealmLocalizationTexts(),
 		NewUserProfile(),
+		NewClientRegistrationPolicies(),
 		NewConfigureEmail(c, operatorNs),
-		NewAuthFlow(),
+		NewAuthFlow(c),
 	)
 
 	return ch
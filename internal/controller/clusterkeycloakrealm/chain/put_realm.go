@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -42,6 +43,7 @@ func (h PutRealm) ServeRequest(ctx context.Context, realm *v1alpha1.ClusterKeycl
 	}
 
 	log.Info("Realm has been created")
+	events.Normal(ctx, realm, events.ReasonCreated, "Realm %s created", realm.Spec.RealmName)
 
 	return nil
 }
@@ -6,6 +6,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/realmbuilder"
 )
@@ -22,16 +24,28 @@ func (h PutRealmSettings) ServeRequest(ctx context.Context, realm *v1alpha1.Clus
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start updating of keycloak realm settings")
 
-	if err := realmbuilder.ApplyRealmEventConfig(ctx, realm.Spec.RealmName, realm.Spec.RealmEventConfig, kClient.Events); err != nil {
+	eventsUpdated, err := realmbuilder.ApplyRealmEventConfig(ctx, realm.Spec.RealmName, realm.Spec.RealmEventConfig, kClient.Events)
+	if err != nil {
 		return err
 	}
 
+	if realm.Spec.RealmEventConfig != nil {
+		metrics.RecordWrite(v1alpha1.ClusterKeycloakRealmKind, eventsUpdated)
+	}
+
 	overlay := realmbuilder.BuildRealmRepresentationFromV1Alpha1(realm)
 
-	if err := realmbuilder.ApplyRealmSettings(ctx, realm.Spec.RealmName, overlay, kClient.Realms); err != nil {
+	updated, err := realmbuilder.ApplyRealmSettings(ctx, realm.Spec.RealmName, overlay, kClient.Realms)
+	if err != nil {
 		return err
 	}
 
+	metrics.RecordWrite(v1alpha1.ClusterKeycloakRealmKind, updated)
+
+	if updated || eventsUpdated {
+		events.Normal(ctx, realm, events.ReasonUpdated, "Realm %s updated", realm.Spec.RealmName)
+	}
+
 	log.Info("Realm settings is updating done.")
 
 	return nil
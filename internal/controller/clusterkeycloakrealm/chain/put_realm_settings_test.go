@@ -27,7 +27,7 @@ func TestPutRealmSettings_ServeRequest(t *testing.T) {
 		expectedError   string
 	}{
 		{
-			name: "successful realm settings update with minimal configuration",
+			name: "realm update skipped with minimal configuration",
 			realm: &v1alpha1.ClusterKeycloakRealm{
 				Spec: v1alpha1.ClusterKeycloakRealmSpec{
 					RealmName: "test-realm",
@@ -36,8 +36,6 @@ func TestPutRealmSettings_ServeRequest(t *testing.T) {
 			setupMocks: func(m *v2mocks.MockRealmClient) {
 				m.EXPECT().GetRealm(mock.Anything, "test-realm").
 					Return(&keycloakapi.RealmRepresentation{}, nil, nil)
-				m.EXPECT().UpdateRealm(mock.Anything, "test-realm", mock.Anything).
-					Return(nil, nil)
 			},
 			setupEventsMock: func(_ *v2mocks.MockEventsClient) {},
 		},
@@ -130,7 +128,8 @@ func TestPutRealmSettings_ServeRequest(t *testing.T) {
 			name: "error when UpdateRealm fails",
 			realm: &v1alpha1.ClusterKeycloakRealm{
 				Spec: v1alpha1.ClusterKeycloakRealmSpec{
-					RealmName: "test-realm",
+					RealmName:   "test-realm",
+					DisplayName: ptr.To("Test Realm"),
 				},
 			},
 			setupMocks: func(m *v2mocks.MockRealmClient) {
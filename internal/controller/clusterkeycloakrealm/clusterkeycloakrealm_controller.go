@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -14,9 +15,14 @@ import (
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
 	"github.com/epam/edp-keycloak-operator/internal/controller/clusterkeycloakrealm/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
@@ -26,6 +32,8 @@ type Helper interface {
 	TryToDelete(ctx context.Context, obj client.Object, terminator helper.Terminator, finalizer string) (isDeleted bool, resultErr error)
 	CreateKeycloakClientFromClusterRealm(ctx context.Context, realm *keycloakAlpha.ClusterKeycloakRealm) (*keycloakapi.KeycloakClient, error)
 	SetKeycloakOwnerRef(ctx context.Context, object helper.ObjectWithKeycloakRef) error
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 // ClusterKeycloakRealmReconciler reconciles a ClusterKeycloakRealm object.
@@ -34,6 +42,7 @@ type ClusterKeycloakRealmReconciler struct {
 	scheme            *runtime.Scheme
 	helper            Helper
 	operatorNamespace string
+	adminEvents       *adminevents.Watcher
 }
 
 func NewClusterKeycloakRealmReconciler(
@@ -54,6 +63,7 @@ const (
 // +kubebuilder:rbac:groups=v1.edp.epam.com,resources=clusterkeycloakrealms,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,resources=clusterkeycloakrealms/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,resources=clusterkeycloakrealms/finalizers,verbs=update
+// +kubebuilder:rbac:groups=v1.edp.epam.com,resources=keycloakauthflows,verbs=get;list;watch
 
 // Reconcile is loop for reconciling ClusterKeycloakRealm object.
 func (r *ClusterKeycloakRealmReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -87,7 +97,7 @@ func (r *ClusterKeycloakRealmReconciler) Reconcile(ctx context.Context, req ctrl
 	if deleted, err := r.helper.TryToDelete(
 		ctx,
 		clusterRealm,
-		keycloakrealm.MakeTerminator(clusterRealm.Spec.RealmName, kClient.Realms, objectmeta.PreserveResourcesOnDeletion(clusterRealm)),
+		keycloakrealm.MakeTerminator(clusterRealm.Spec.RealmName, kClient.Realms, objectmeta.PreserveResourcesOnDeletion(clusterRealm, r.helper.DefaultDeletionPolicy())),
 		keyCloakRealmOperatorFinalizerName,
 	); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to delete realm %w", err)
@@ -95,7 +105,12 @@ func (r *ClusterKeycloakRealmReconciler) Reconcile(ctx context.Context, req ctrl
 		return reconcile.Result{}, nil
 	}
 
+	oldStatus := clusterRealm.Status.DeepCopy()
+
 	if err := chain.MakeChain(r.client, r.operatorNamespace).ServeRequest(ctx, clusterRealm, kClient); err != nil {
+		events.Error(ctx, clusterRealm, err)
+		status.SetFailed(clusterRealm, err)
+
 		clusterRealm.Status.Available = false
 		clusterRealm.Status.Value = err.Error()
 		requeue := r.helper.SetFailureCount(clusterRealm)
@@ -109,7 +124,7 @@ func (r *ClusterKeycloakRealmReconciler) Reconcile(ctx context.Context, req ctrl
 		}, fmt.Errorf("error during ClusterRealm chain: %w", err)
 	}
 
-	if err := r.updateSuccessStatus(ctx, clusterRealm); err != nil {
+	if err := r.updateSuccessStatus(ctx, clusterRealm, oldStatus); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -118,8 +133,12 @@ func (r *ClusterKeycloakRealmReconciler) Reconcile(ctx context.Context, req ctrl
 	}, nil
 }
 
-func (r *ClusterKeycloakRealmReconciler) updateSuccessStatus(ctx context.Context, clusterRealm *keycloakAlpha.ClusterKeycloakRealm) error {
-	if clusterRealm.Status.Available {
+func (r *ClusterKeycloakRealmReconciler) updateSuccessStatus(
+	ctx context.Context,
+	clusterRealm *keycloakAlpha.ClusterKeycloakRealm,
+	oldStatus *keycloakAlpha.ClusterKeycloakRealmStatus,
+) error {
+	if oldStatus.Available && equality.Semantic.DeepEqual(&clusterRealm.Status, oldStatus) {
 		return nil
 	}
 
@@ -127,6 +146,8 @@ func (r *ClusterKeycloakRealmReconciler) updateSuccessStatus(ctx context.Context
 	clusterRealm.Status.Value = common.StatusOK
 	clusterRealm.Status.FailureCount = 0
 
+	status.SetReady(clusterRealm, "Reconciliation succeeded")
+
 	if err := r.client.Status().Update(ctx, clusterRealm); err != nil {
 		return fmt.Errorf("unable to update cluster realm status: %w", err)
 	}
@@ -134,15 +155,56 @@ func (r *ClusterKeycloakRealmReconciler) updateSuccessStatus(ctx context.Context
 	return nil
 }
 
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *ClusterKeycloakRealmReconciler) WithAdminEvents(watcher *adminevents.Watcher) *ClusterKeycloakRealmReconciler {
+	r.adminEvents = watcher
+
+	return r
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterKeycloakRealmReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakAlpha.ClusterKeycloakRealm{}).
-		Complete(r)
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakAlpha.ClusterKeycloakRealm{})
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakAlpha.ClusterKeycloakRealm{},
+		func() client.ObjectList { return &keycloakAlpha.ClusterKeycloakRealmList{} },
+		r.secretRefs,
+	); err != nil {
+		return fmt.Errorf("unable to setup ClusterKeycloakRealm secret watches: %w", err)
+	}
 
-	if err != nil {
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakAlpha.ClusterKeycloakRealm{},
+		func() client.ObjectList { return &keycloakAlpha.ClusterKeycloakRealmList{} },
+		nil,
+	); err != nil {
+		return fmt.Errorf("unable to setup ClusterKeycloakRealm admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakAlpha.ClusterKeycloakRealm{}, pause.NewReconciler(mgr.GetClient(), &keycloakAlpha.ClusterKeycloakRealm{}, r, pause.WithObserveModeSkip(r.helper, keyCloakRealmOperatorFinalizerName))))); err != nil {
 		return fmt.Errorf("unable to create ClusterKeycloakRealm controller: %w", err)
 	}
 
 	return nil
 }
+
+// secretRefs returns Secrets and ConfigMaps referenced by the realm.
+// They are located in the operator namespace.
+func (r *ClusterKeycloakRealmReconciler) secretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	if realm, ok := obj.(*keycloakAlpha.ClusterKeycloakRealm); ok {
+		refs.AddSMTP(r.operatorNamespace, realm.Spec.Smtp)
+	}
+
+	return refs
+}
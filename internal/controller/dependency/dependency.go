@@ -0,0 +1,354 @@
+// Package dependency tracks references between custom resources that manage Keycloak objects.
+// Dependent resources are indexed by the objects they reference, so they can be enqueued
+// as soon as the custom resource that manages the referenced object becomes ready.
+// Dependencies are resolved within the namespace of the dependent resource.
+package dependency
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
+)
+
+const (
+	// ConditionWaitingForDependency indicates that the resource waits for referenced objects
+	// managed by other custom resources.
+	ConditionWaitingForDependency = "WaitingForDependency"
+
+	// ReasonDependencyNotReady is set when at least one referenced custom resource is not ready.
+	ReasonDependencyNotReady = "DependencyNotReady"
+
+	// ReasonDependenciesReady is set when all referenced custom resources are ready.
+	ReasonDependenciesReady = "DependenciesReady"
+)
+
+// Kinds is a list of custom resource kinds that can be referenced by other resources.
+var Kinds = []string{
+	keycloakApi.KeycloakRealmRoleKind,
+	keycloakApi.KeycloakRealmGroupKind,
+	keycloakApi.KeycloakClientScopeKind,
+	keycloakApi.KeycloakAuthFlowKind,
+	keycloakApi.KeycloakClientKind,
+	keycloakApi.KeycloakRealmIdentityProviderKind,
+}
+
+// Ref is a reference to a Keycloak object that can be managed by a custom resource.
+type Ref struct {
+	// Kind is a kind of the custom resource that manages the object.
+	Kind string
+
+	// Name is a name of the object in Keycloak, for example, role name or authentication flow alias.
+	Name string
+}
+
+// RefsFunc returns references of the dependent object.
+type RefsFunc func(obj client.Object) []Ref
+
+// managed describes a custom resource that manages a Keycloak object.
+type managed struct {
+	kind     string
+	realmRef common.RealmRef
+	name     string
+	ready    bool
+}
+
+// IndexField returns the name of the field index of references to objects managed by the given kind.
+func IndexField(kind string) string {
+	return "dependency." + kind
+}
+
+// IndexKey returns the index key of the Keycloak object with the given name in the realm.
+func IndexKey(realmRef common.RealmRef, name string) string {
+	return fmt.Sprintf("%s/%s/%s", realmKind(realmRef), realmRef.Name, name)
+}
+
+// Setup registers field indexes of the dependent objects and watches of the custom resources of the given kinds.
+// Dependent objects are enqueued when readiness of the referenced custom resource changes.
+func Setup(
+	ctx context.Context,
+	mgr ctrl.Manager,
+	b *builder.Builder,
+	dependent helper.ObjectWithRealmRef,
+	newList func() client.ObjectList,
+	refs RefsFunc,
+	kinds ...string,
+) error {
+	for _, kind := range kinds {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, dependent, IndexField(kind), IndexFunc(refs, kind)); err != nil {
+			return fmt.Errorf("unable to index %s references: %w", kind, err)
+		}
+
+		b.Watches(
+			newObject(kind),
+			EnqueueDependents(mgr.GetClient(), newList),
+			builder.WithPredicates(ReadinessChanged()),
+		)
+	}
+
+	return nil
+}
+
+// IndexFunc returns a field index function of references to objects managed by the given kind.
+func IndexFunc(refs RefsFunc, kind string) client.IndexerFunc {
+	return func(obj client.Object) []string {
+		return indexValues(obj, refs, kind)
+	}
+}
+
+func indexValues(obj client.Object, refs RefsFunc, kind string) []string {
+	o, ok := obj.(common.HasRealmRef)
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+
+	for _, ref := range refs(obj) {
+		if ref.Kind == kind && ref.Name != "" {
+			keys = append(keys, IndexKey(o.GetRealmRef(), ref.Name))
+		}
+	}
+
+	slices.Sort(keys)
+
+	return slices.Compact(keys)
+}
+
+// EnqueueDependents returns an event handler that enqueues objects of the list type
+// that reference the Keycloak object managed by the changed custom resource.
+func EnqueueDependents(k8sClient client.Client, newList func() client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		m, ok := describe(obj)
+		if !ok {
+			return nil
+		}
+
+		list := newList()
+		if err := k8sClient.List(
+			ctx,
+			list,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{IndexField(m.kind): IndexKey(m.realmRef, m.name)},
+		); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Unable to list dependent objects", "kind", m.kind, "name", obj.GetName())
+
+			return nil
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(items))
+
+		for _, item := range items {
+			o, ok := item.(client.Object)
+			if !ok || isSameObject(o, obj) {
+				continue
+			}
+
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()},
+			})
+		}
+
+		return requests
+	})
+}
+
+// ReadinessChanged returns a predicate that passes creation and deletion events
+// and updates that change readiness of the custom resource.
+func ReadinessChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObj, okOld := describe(e.ObjectOld)
+			newObj, okNew := describe(e.ObjectNew)
+
+			return okOld && okNew && oldObj.ready != newObj.ready
+		},
+	}
+}
+
+// NotReady returns custom resources that manage the referenced objects and are not ready yet,
+// in the form of "<Kind> <name>". References to objects that are not managed by custom resources are ignored.
+func NotReady(ctx context.Context, k8sClient client.Client, dependent helper.ObjectWithRealmRef, refs []Ref) ([]string, error) {
+	byKind := make(map[string][]string)
+
+	for _, ref := range refs {
+		if ref.Name != "" {
+			byKind[ref.Kind] = append(byKind[ref.Kind], ref.Name)
+		}
+	}
+
+	var notReady []string
+
+	for _, kind := range Kinds {
+		names, ok := byKind[kind]
+		if !ok {
+			continue
+		}
+
+		objects, err := listObjects(ctx, k8sClient, kind, dependent.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objects {
+			m, ok := describe(obj)
+			if !ok || isSameObject(obj, dependent) {
+				continue
+			}
+
+			if realmKind(m.realmRef) != realmKind(dependent.GetRealmRef()) ||
+				m.realmRef.Name != dependent.GetRealmRef().Name ||
+				!slices.Contains(names, m.name) {
+				continue
+			}
+
+			if !m.ready {
+				notReady = append(notReady, fmt.Sprintf("%s %s", kind, obj.GetName()))
+			}
+		}
+	}
+
+	slices.Sort(notReady)
+
+	return notReady, nil
+}
+
+// Condition returns the WaitingForDependency condition for the list of not ready dependencies.
+func Condition(notReady []string, generation int64) metav1.Condition {
+	if len(notReady) == 0 {
+		return metav1.Condition{
+			Type:               ConditionWaitingForDependency,
+			Status:             metav1.ConditionFalse,
+			Reason:             ReasonDependenciesReady,
+			Message:            "All dependencies are ready",
+			ObservedGeneration: generation,
+		}
+	}
+
+	return metav1.Condition{
+		Type:               ConditionWaitingForDependency,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonDependencyNotReady,
+		Message:            "Waiting for " + strings.Join(notReady, ", "),
+		ObservedGeneration: generation,
+	}
+}
+
+func describe(obj client.Object) (managed, bool) {
+	switch o := obj.(type) {
+	case *keycloakApi.KeycloakRealmRole:
+		return managed{keycloakApi.KeycloakRealmRoleKind, o.Spec.RealmRef, o.Spec.Name, isReady(o.Status.Value)}, true
+	case *keycloakApi.KeycloakRealmGroup:
+		return managed{keycloakApi.KeycloakRealmGroupKind, o.Spec.RealmRef, o.Spec.Name, isReady(o.Status.Value)}, true
+	case *keycloakApi.KeycloakClientScope:
+		return managed{keycloakApi.KeycloakClientScopeKind, o.Spec.RealmRef, o.Spec.Name, isReady(o.Status.Value)}, true
+	case *keycloakApi.KeycloakAuthFlow:
+		return managed{keycloakApi.KeycloakAuthFlowKind, o.Spec.RealmRef, o.Spec.Alias, isReady(o.Status.Value)}, true
+	case *keycloakApi.KeycloakClient:
+		return managed{keycloakApi.KeycloakClientKind, o.Spec.RealmRef, o.Spec.ClientId, isReady(o.Status.Value)}, true
+	case *keycloakApi.KeycloakRealmIdentityProvider:
+		return managed{keycloakApi.KeycloakRealmIdentityProviderKind, o.Spec.RealmRef, o.Spec.Alias, isReady(o.Status.Value)}, true
+	default:
+		return managed{}, false
+	}
+}
+
+func newObject(kind string) client.Object {
+	switch kind {
+	case keycloakApi.KeycloakRealmRoleKind:
+		return &keycloakApi.KeycloakRealmRole{}
+	case keycloakApi.KeycloakRealmGroupKind:
+		return &keycloakApi.KeycloakRealmGroup{}
+	case keycloakApi.KeycloakClientScopeKind:
+		return &keycloakApi.KeycloakClientScope{}
+	case keycloakApi.KeycloakAuthFlowKind:
+		return &keycloakApi.KeycloakAuthFlow{}
+	case keycloakApi.KeycloakClientKind:
+		return &keycloakApi.KeycloakClient{}
+	case keycloakApi.KeycloakRealmIdentityProviderKind:
+		return &keycloakApi.KeycloakRealmIdentityProvider{}
+	default:
+		panic(fmt.Sprintf("unsupported dependency kind %s", kind))
+	}
+}
+
+func newObjectList(kind string) client.ObjectList {
+	switch kind {
+	case keycloakApi.KeycloakRealmRoleKind:
+		return &keycloakApi.KeycloakRealmRoleList{}
+	case keycloakApi.KeycloakRealmGroupKind:
+		return &keycloakApi.KeycloakRealmGroupList{}
+	case keycloakApi.KeycloakClientScopeKind:
+		return &keycloakApi.KeycloakClientScopeList{}
+	case keycloakApi.KeycloakAuthFlowKind:
+		return &keycloakApi.KeycloakAuthFlowList{}
+	case keycloakApi.KeycloakClientKind:
+		return &keycloakApi.KeycloakClientList{}
+	case keycloakApi.KeycloakRealmIdentityProviderKind:
+		return &keycloakApi.KeycloakRealmIdentityProviderList{}
+	default:
+		panic(fmt.Sprintf("unsupported dependency kind %s", kind))
+	}
+}
+
+func listObjects(ctx context.Context, k8sClient client.Client, kind, namespace string) ([]client.Object, error) {
+	list := newObjectList(kind)
+	if err := k8sClient.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list %s resources: %w", kind, err)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract %s resources: %w", kind, err)
+	}
+
+	objects := make([]client.Object, 0, len(items))
+
+	for _, item := range items {
+		if obj, ok := item.(client.Object); ok {
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects, nil
+}
+
+// isSameObject returns true if both objects are the same custom resource.
+// A resource can reference an object it manages itself, for example, a client can reference its own roles.
+func isSameObject(a, b client.Object) bool {
+	return fmt.Sprintf("%T", a) == fmt.Sprintf("%T", b) &&
+		a.GetNamespace() == b.GetNamespace() &&
+		a.GetName() == b.GetName()
+}
+
+func isReady(status string) bool {
+	return status == common.StatusOK
+}
+
+func realmKind(ref common.RealmRef) string {
+	if ref.Kind == "" {
+		return keycloakApi.KeycloakRealmKind
+	}
+
+	return ref.Kind
+}
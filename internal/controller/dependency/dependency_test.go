@@ -0,0 +1,215 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+var testRealmRef = common.RealmRef{Kind: keycloakApi.KeycloakRealmKind, Name: "realm"}
+
+func testRefs(obj client.Object) []Ref {
+	c, ok := obj.(*keycloakApi.KeycloakClient)
+	if !ok {
+		return nil
+	}
+
+	refs := make([]Ref, 0, len(c.Spec.DefaultClientScopes))
+
+	for _, scope := range c.Spec.DefaultClientScopes {
+		refs = append(refs, Ref{Kind: keycloakApi.KeycloakClientScopeKind, Name: scope})
+	}
+
+	return refs
+}
+
+func newTestClient(name string, scopes ...string) *keycloakApi.KeycloakClient {
+	return &keycloakApi.KeycloakClient{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: keycloakApi.KeycloakClientSpec{
+			ClientId:            name,
+			RealmRef:            testRealmRef,
+			DefaultClientScopes: scopes,
+		},
+	}
+}
+
+func newTestScope(name, scopeName, status string) *keycloakApi.KeycloakClientScope {
+	return &keycloakApi.KeycloakClientScope{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: keycloakApi.KeycloakClientScopeSpec{
+			Name:     scopeName,
+			RealmRef: testRealmRef,
+		},
+		Status: keycloakApi.KeycloakClientScopeStatus{Value: status},
+	}
+}
+
+func newTestK8sClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithIndex(
+			&keycloakApi.KeycloakClient{},
+			IndexField(keycloakApi.KeycloakClientScopeKind),
+			IndexFunc(testRefs, keycloakApi.KeycloakClientScopeKind),
+		).
+		Build()
+}
+
+func TestIndexFunc(t *testing.T) {
+	t.Parallel()
+
+	got := IndexFunc(testRefs, keycloakApi.KeycloakClientScopeKind)(newTestClient("client", "profile", "email", "profile"))
+	assert.Equal(t, []string{"KeycloakRealm/realm/email", "KeycloakRealm/realm/profile"}, got)
+
+	got = IndexFunc(testRefs, keycloakApi.KeycloakRealmRoleKind)(newTestClient("client", "profile"))
+	assert.Empty(t, got)
+}
+
+func TestNotReady(t *testing.T) {
+	t.Parallel()
+
+	otherRealmScope := newTestScope("other-realm-scope", "email", "")
+	otherRealmScope.Spec.RealmRef = common.RealmRef{Kind: keycloakApi.KeycloakRealmKind, Name: "other"}
+
+	k8sClient := newTestK8sClient(t,
+		newTestScope("profile-scope", "profile", common.StatusOK),
+		newTestScope("email-scope", "email", "in progress"),
+		newTestScope("roles-scope", "roles", ""),
+		otherRealmScope,
+	)
+
+	tests := []struct {
+		name string
+		refs []Ref
+		want []string
+	}{
+		{
+			name: "not ready dependencies are returned",
+			refs: []Ref{
+				{Kind: keycloakApi.KeycloakClientScopeKind, Name: "profile"},
+				{Kind: keycloakApi.KeycloakClientScopeKind, Name: "email"},
+				{Kind: keycloakApi.KeycloakClientScopeKind, Name: "roles"},
+			},
+			want: []string{"KeycloakClientScope email-scope", "KeycloakClientScope roles-scope"},
+		},
+		{
+			name: "dependencies not managed by custom resources are ignored",
+			refs: []Ref{
+				{Kind: keycloakApi.KeycloakClientScopeKind, Name: "web-origins"},
+				{Kind: keycloakApi.KeycloakRealmRoleKind, Name: "admin"},
+			},
+			want: nil,
+		},
+		{
+			name: "no dependencies",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NotReady(context.Background(), k8sClient, newTestClient("client"), tt.refs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNotReady_SelfReference(t *testing.T) {
+	t.Parallel()
+
+	dependent := newTestClient("client")
+	k8sClient := newTestK8sClient(t, dependent)
+
+	got, err := NotReady(context.Background(), k8sClient, dependent, []Ref{
+		{Kind: keycloakApi.KeycloakClientKind, Name: "client"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestEnqueueDependents(t *testing.T) {
+	t.Parallel()
+
+	k8sClient := newTestK8sClient(t,
+		newTestClient("client-a", "profile"),
+		newTestClient("client-b", "profile", "email"),
+		newTestClient("client-c", "email"),
+	)
+
+	h := EnqueueDependents(k8sClient, func() client.ObjectList { return &keycloakApi.KeycloakClientList{} })
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+
+	defer q.ShutDown()
+
+	h.Update(context.Background(), event.UpdateEvent{
+		ObjectOld: newTestScope("profile-scope", "profile", ""),
+		ObjectNew: newTestScope("profile-scope", "profile", common.StatusOK),
+	}, q)
+
+	var got []types.NamespacedName
+
+	for q.Len() > 0 {
+		item, _ := q.Get()
+		got = append(got, item.NamespacedName)
+		q.Done(item)
+	}
+
+	assert.ElementsMatch(t, []types.NamespacedName{
+		{Namespace: "default", Name: "client-a"},
+		{Namespace: "default", Name: "client-b"},
+	}, got)
+}
+
+func TestReadinessChanged(t *testing.T) {
+	t.Parallel()
+
+	p := ReadinessChanged()
+
+	assert.True(t, p.Update(event.UpdateEvent{
+		ObjectOld: newTestScope("scope", "profile", ""),
+		ObjectNew: newTestScope("scope", "profile", common.StatusOK),
+	}))
+	assert.False(t, p.Update(event.UpdateEvent{
+		ObjectOld: newTestScope("scope", "profile", "error"),
+		ObjectNew: newTestScope("scope", "profile", "another error"),
+	}))
+	assert.True(t, p.Create(event.CreateEvent{Object: newTestScope("scope", "profile", "")}))
+	assert.True(t, p.Delete(event.DeleteEvent{Object: newTestScope("scope", "profile", common.StatusOK)}))
+}
+
+func TestCondition(t *testing.T) {
+	t.Parallel()
+
+	c := Condition([]string{"KeycloakClientScope email-scope"}, 2)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, ReasonDependencyNotReady, c.Reason)
+	assert.Equal(t, "Waiting for KeycloakClientScope email-scope", c.Message)
+	assert.Equal(t, int64(2), c.ObservedGeneration)
+
+	c = Condition(nil, 2)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, ReasonDependenciesReady, c.Reason)
+}
@@ -0,0 +1,143 @@
+// Package events records Kubernetes Events for custom resources.
+// The event recorder is passed to reconcilers and chain handlers through the context,
+// so handlers can record events without changing their constructors.
+package events
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Component is a name of the event source.
+const Component = "keycloak-operator"
+
+const (
+	// ReasonCreated is set when the operator creates an object in Keycloak.
+	ReasonCreated = "Created"
+
+	// ReasonUpdated is set when the operator updates an object in Keycloak.
+	ReasonUpdated = "Updated"
+
+	// ReasonDeleted is set when the operator deletes an object from Keycloak.
+	ReasonDeleted = "Deleted"
+
+	// ReasonSecretRotated is set when the operator rotates a generated secret.
+	ReasonSecretRotated = "SecretRotated"
+
+	// ReasonPaused is set when reconciliation is skipped because of the paused annotation.
+	ReasonPaused = "Paused"
+
+	// ReasonReconciliationSucceeded is set when the resource is reconciled after a failure.
+	ReasonReconciliationSucceeded = "ReconciliationSucceeded"
+
+	// ReasonKeycloakAPIError is set when a Keycloak API request fails.
+	ReasonKeycloakAPIError = "KeycloakAPIError"
+
+	// ReasonConfigurationError is set when the resource configuration is invalid.
+	ReasonConfigurationError = "ConfigurationError"
+
+	// ReasonSecretError is set when a referenced Secret can't be read.
+	ReasonSecretError = "SecretError"
+
+	// ReasonReconciliationFailed is set when reconciliation fails for other reasons.
+	ReasonReconciliationFailed = "ReconciliationFailed"
+)
+
+// operations maps reasons of Keycloak object changes to the operation label of the metrics.
+var operations = map[string]string{
+	ReasonCreated: metrics.OperationCreated,
+	ReasonUpdated: metrics.OperationUpdated,
+	ReasonDeleted: metrics.OperationDeleted,
+}
+
+type recorderKey struct{}
+
+// RecorderProvider provides event recorders, it is implemented by the controller manager.
+type RecorderProvider interface {
+	GetEventRecorderFor(name string) record.EventRecorder
+}
+
+// IntoContext returns a copy of the context with the event recorder.
+func IntoContext(ctx context.Context, recorder record.EventRecorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, recorder)
+}
+
+// FromContext returns the event recorder from the context.
+// If the context has no recorder, events are discarded.
+func FromContext(ctx context.Context) record.EventRecorder {
+	if recorder, ok := ctx.Value(recorderKey{}).(record.EventRecorder); ok {
+		return recorder
+	}
+
+	return discardRecorder{}
+}
+
+// Normal records a Normal event for the object.
+// Events of Keycloak object changes are also counted in the operator metrics.
+func Normal(ctx context.Context, obj runtime.Object, reason, messageFmt string, args ...any) {
+	if operation, ok := operations[reason]; ok {
+		metrics.IncKeycloakObjectOperations(metrics.KindOf(obj), operation)
+	}
+
+	FromContext(ctx).Eventf(obj, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// Warning records a Warning event for the object.
+func Warning(ctx context.Context, obj runtime.Object, reason, messageFmt string, args ...any) {
+	FromContext(ctx).Eventf(obj, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
+
+// Error records a Warning event for the reconciliation error.
+func Error(ctx context.Context, obj runtime.Object, err error) {
+	Warning(ctx, obj, ReasonForError(err), "%s", err.Error())
+}
+
+// ReasonForError returns the event reason for the reconciliation error.
+func ReasonForError(err error) string {
+	apiErr := &keycloakapi.ApiError{}
+	if errors.As(err, &apiErr) || errors.Is(err, keycloakapi.ErrNilResponse) {
+		return ReasonKeycloakAPIError
+	}
+
+	return ReasonReconciliationFailed
+}
+
+// Reconciler passes the event recorder to the next reconciler through the context.
+type Reconciler struct {
+	recorder record.EventRecorder
+	next     reconcile.Reconciler
+}
+
+// NewReconciler returns a reconciler that adds the event recorder to the context of the next reconciler.
+func NewReconciler(provider RecorderProvider, next reconcile.Reconciler) *Reconciler {
+	return &Reconciler{
+		recorder: provider.GetEventRecorderFor(Component),
+		next:     next,
+	}
+}
+
+// Reconcile calls the next reconciler with the event recorder in the context.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.next.Reconcile(IntoContext(ctx, r.recorder), req)
+}
+
+// discardRecorder is an event recorder that discards all events.
+type discardRecorder struct{}
+
+func (discardRecorder) Event(runtime.Object, string, string, string) {}
+
+func (discardRecorder) Eventf(runtime.Object, string, string, string, ...any) {}
+
+func (discardRecorder) AnnotatedEventf(runtime.Object, map[string]string, string, string, string, ...any) {
+}
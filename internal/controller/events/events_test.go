@@ -0,0 +1,103 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+type fakeProvider struct {
+	recorder record.EventRecorder
+}
+
+func (p fakeProvider) GetEventRecorderFor(string) record.EventRecorder {
+	return p.recorder
+}
+
+func TestReasonForError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "keycloak api error",
+			err:  fmt.Errorf("unable to create client: %w", &keycloakapi.ApiError{Code: http.StatusConflict}),
+			want: ReasonKeycloakAPIError,
+		},
+		{
+			name: "nil response",
+			err:  fmt.Errorf("unable to get realm: %w", keycloakapi.ErrNilResponse),
+			want: ReasonKeycloakAPIError,
+		},
+		{
+			name: "other error",
+			err:  errors.New("unable to get secret"),
+			want: ReasonReconciliationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ReasonForError(tt.err))
+		})
+	}
+}
+
+func TestRecordEvents(t *testing.T) {
+	t.Parallel()
+
+	recorder := record.NewFakeRecorder(10)
+	ctx := IntoContext(context.Background(), recorder)
+	role := &keycloakApi.KeycloakRealmRole{ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "default"}}
+
+	Normal(ctx, role, ReasonCreated, "Realm role %s created", "role")
+	Error(ctx, role, errors.New("unable to get realm"))
+
+	require.Len(t, recorder.Events, 2)
+	assert.Equal(t, "Normal Created Realm role role created", <-recorder.Events)
+	assert.Equal(t, "Warning ReconciliationFailed unable to get realm", <-recorder.Events)
+}
+
+func TestFromContext_Discard(t *testing.T) {
+	t.Parallel()
+
+	role := &keycloakApi.KeycloakRealmRole{}
+
+	assert.NotPanics(t, func() {
+		Warning(context.Background(), role, ReasonKeycloakAPIError, "message")
+	})
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	recorder := record.NewFakeRecorder(1)
+
+	var got record.EventRecorder
+
+	next := reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		got = FromContext(ctx)
+
+		return reconcile.Result{}, nil
+	})
+
+	_, err := NewReconciler(fakeProvider{recorder: recorder}, next).Reconcile(context.Background(), reconcile.Request{})
+	require.NoError(t, err)
+	assert.Same(t, recorder, got)
+}
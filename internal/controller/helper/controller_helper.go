@@ -55,6 +55,8 @@ type ControllerHelper interface {
 	CreateKeycloakClientFromRealm(ctx context.Context, realm *keycloakApi.KeycloakRealm) (*keycloakClient.KeycloakClient, error)
 	CreateKeycloakClientFromClusterRealm(ctx context.Context, realm *keycloakAlpha.ClusterKeycloakRealm) (*keycloakClient.KeycloakClient, error)
 	GetRealmNameFromRef(ctx context.Context, object ObjectWithRealmRef) (string, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 type Helper struct {
@@ -64,6 +66,10 @@ type Helper struct {
 	// enableOwnerRef is a flag to enable legacy owner reference to Keycloak and KeycloakRealm for operator objects.
 	// This is needed for backward compatibility with the old version of the operator.
 	enableOwnerRef bool
+	// defaultReconcileMode is an operator-wide reconcile mode used when the resource does not specify one.
+	defaultReconcileMode common.ReconcileMode
+	// defaultDeletionPolicy is an operator-wide deletion policy used when the resource does not specify one.
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
 func MakeHelper(k8sClient client.Client, scheme *runtime.Scheme, operatorNamespace string, options ...func(*Helper)) *Helper {
@@ -88,6 +94,44 @@ func EnableOwnerRef(setOwnerRef bool) func(*Helper) {
 	}
 }
 
+// WithDefaultReconcileMode is an option to set the operator-wide default reconcile mode in Helper.
+func WithDefaultReconcileMode(mode common.ReconcileMode) func(*Helper) {
+	return func(h *Helper) {
+		h.defaultReconcileMode = mode
+	}
+}
+
+// WithDefaultDeletionPolicy is an option to set the operator-wide default deletion policy in Helper.
+func WithDefaultDeletionPolicy(policy common.DeletionPolicy) func(*Helper) {
+	return func(h *Helper) {
+		h.defaultDeletionPolicy = policy
+	}
+}
+
+// DefaultDeletionPolicy returns the operator-wide deletion policy.
+// Resources can override it with the spec.deletionPolicy field.
+func (h *Helper) DefaultDeletionPolicy() common.DeletionPolicy {
+	if h.defaultDeletionPolicy != "" {
+		return h.defaultDeletionPolicy
+	}
+
+	return common.DeletionPolicyDelete
+}
+
+// GetReconcileMode returns the reconcile mode for the object.
+// The mode from the object spec takes precedence over the operator-wide default.
+func (h *Helper) GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode {
+	if mode := object.GetReconcileMode(); mode != "" {
+		return mode
+	}
+
+	if h.defaultReconcileMode != "" {
+		return h.defaultReconcileMode
+	}
+
+	return common.ReconcileModeApply
+}
+
 // SetKeycloakOwnerRef sets owner reference for object.
 //
 //nolint:dupl,cyclop
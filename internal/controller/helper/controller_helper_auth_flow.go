@@ -0,0 +1,62 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+// ErrAuthFlowNotReady is returned when KeycloakAuthFlow resource that creates a bound flow is not ready yet.
+var ErrAuthFlowNotReady = errors.New("authentication flow is not ready")
+
+// CheckAuthFlowsReady checks that KeycloakAuthFlow resources that create flows with the given aliases
+// in the referenced realm are ready. Flows that are not managed by KeycloakAuthFlow resources are ignored.
+// For cluster-scoped realms, namespace should be empty to check resources in all namespaces.
+func CheckAuthFlowsReady(
+	ctx context.Context,
+	k8sClient client.Client,
+	realmRef common.RealmRef,
+	namespace string,
+	aliases []string,
+) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	flows := &keycloakApi.KeycloakAuthFlowList{}
+	if err := k8sClient.List(ctx, flows, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("unable to list KeycloakAuthFlow resources: %w", err)
+	}
+
+	var notReady []string
+
+	for i := range flows.Items {
+		flow := &flows.Items[i]
+
+		ref := flow.Spec.RealmRef
+		if ref.Kind == "" {
+			ref.Kind = keycloakApi.KeycloakRealmKind
+		}
+
+		if ref != realmRef || !slices.Contains(aliases, flow.Spec.Alias) {
+			continue
+		}
+
+		if flow.Status.Value != common.StatusOK {
+			notReady = append(notReady, fmt.Sprintf("%s/%s", flow.Namespace, flow.Name))
+		}
+	}
+
+	if len(notReady) > 0 {
+		return fmt.Errorf("%w: waiting for KeycloakAuthFlow %s", ErrAuthFlowNotReady, strings.Join(notReady, ", "))
+	}
+
+	return nil
+}
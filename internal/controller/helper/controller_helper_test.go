@@ -828,3 +828,49 @@ func TestHelper_SetKeycloakOwnerRef(t *testing.T) {
 		})
 	}
 }
+
+func TestHelper_GetReconcileMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		defaultMode common.ReconcileMode
+		specMode    common.ReconcileMode
+		want        common.ReconcileMode
+	}{
+		{
+			name: "apply by default",
+			want: common.ReconcileModeApply,
+		},
+		{
+			name:        "operator default",
+			defaultMode: common.ReconcileModeObserve,
+			want:        common.ReconcileModeObserve,
+		},
+		{
+			name:        "spec overrides operator default",
+			defaultMode: common.ReconcileModeObserve,
+			specMode:    common.ReconcileModeApply,
+			want:        common.ReconcileModeApply,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := MakeHelper(nil, nil, "default", WithDefaultReconcileMode(tt.defaultMode))
+			kc := &keycloakApi.KeycloakClient{Spec: keycloakApi.KeycloakClientSpec{ReconcileMode: tt.specMode}}
+
+			assert.Equal(t, tt.want, h.GetReconcileMode(kc))
+		})
+	}
+}
+
+func TestHelper_DefaultDeletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, common.DeletionPolicyDelete, MakeHelper(nil, nil, "default").DefaultDeletionPolicy())
+	assert.Equal(t, common.DeletionPolicyRetain,
+		MakeHelper(nil, nil, "default", WithDefaultDeletionPolicy(common.DeletionPolicyRetain)).DefaultDeletionPolicy())
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	"github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/api/v1alpha1"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
@@ -383,6 +384,50 @@ func (_c *MockControllerHelper_CreateKeycloakClientFromRealmRef_Call) RunAndRetu
 	return _c
 }
 
+// DefaultDeletionPolicy provides a mock function for the type MockControllerHelper
+func (_mock *MockControllerHelper) DefaultDeletionPolicy() common.DeletionPolicy {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DefaultDeletionPolicy")
+	}
+
+	var r0 common.DeletionPolicy
+	if returnFunc, ok := ret.Get(0).(func() common.DeletionPolicy); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(common.DeletionPolicy)
+	}
+	return r0
+}
+
+// MockControllerHelper_DefaultDeletionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefaultDeletionPolicy'
+type MockControllerHelper_DefaultDeletionPolicy_Call struct {
+	*mock.Call
+}
+
+// DefaultDeletionPolicy is a helper method to define mock.On call
+func (_e *MockControllerHelper_Expecter) DefaultDeletionPolicy() *MockControllerHelper_DefaultDeletionPolicy_Call {
+	return &MockControllerHelper_DefaultDeletionPolicy_Call{Call: _e.mock.On("DefaultDeletionPolicy")}
+}
+
+func (_c *MockControllerHelper_DefaultDeletionPolicy_Call) Run(run func()) *MockControllerHelper_DefaultDeletionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockControllerHelper_DefaultDeletionPolicy_Call) Return(_a0 common.DeletionPolicy) *MockControllerHelper_DefaultDeletionPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControllerHelper_DefaultDeletionPolicy_Call) RunAndReturn(run func() common.DeletionPolicy) *MockControllerHelper_DefaultDeletionPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRealmNameFromRef provides a mock function for the type MockControllerHelper
 func (_mock *MockControllerHelper) GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error) {
 	ret := _mock.Called(ctx, object)
@@ -449,6 +494,59 @@ func (_c *MockControllerHelper_GetRealmNameFromRef_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetReconcileMode provides a mock function for the type MockControllerHelper
+func (_mock *MockControllerHelper) GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode {
+	ret := _mock.Called(object)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconcileMode")
+	}
+
+	var r0 common.ReconcileMode
+	if returnFunc, ok := ret.Get(0).(func(common.HasReconcileMode) common.ReconcileMode); ok {
+		r0 = returnFunc(object)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.ReconcileMode)
+		}
+	}
+	return r0
+}
+
+// MockControllerHelper_GetReconcileMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconcileMode'
+type MockControllerHelper_GetReconcileMode_Call struct {
+	*mock.Call
+}
+
+// GetReconcileMode is a helper method to define mock.On call
+//   - object common.HasReconcileMode
+func (_e *MockControllerHelper_Expecter) GetReconcileMode(object interface{}) *MockControllerHelper_GetReconcileMode_Call {
+	return &MockControllerHelper_GetReconcileMode_Call{Call: _e.mock.On("GetReconcileMode", object)}
+}
+
+func (_c *MockControllerHelper_GetReconcileMode_Call) Run(run func(object common.HasReconcileMode)) *MockControllerHelper_GetReconcileMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 common.HasReconcileMode
+		if args[0] != nil {
+			arg0 = args[0].(common.HasReconcileMode)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockControllerHelper_GetReconcileMode_Call) Return(reconcileMode common.ReconcileMode) *MockControllerHelper_GetReconcileMode_Call {
+	_c.Call.Return(reconcileMode)
+	return _c
+}
+
+func (_c *MockControllerHelper_GetReconcileMode_Call) RunAndReturn(run func(object common.HasReconcileMode) common.ReconcileMode) *MockControllerHelper_GetReconcileMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetFailureCount provides a mock function for the type MockControllerHelper
 func (_mock *MockControllerHelper) SetFailureCount(fc helper.FailureCountable) time.Duration {
 	ret := _mock.Called(fc)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -15,6 +16,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -84,16 +89,43 @@ func (r *ReconcileKeycloak) SetupWithManager(mgr ctrl.Manager, successReconcileT
 		},
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.Keycloak{}, builder.WithPredicates(pred)).
-		Complete(r)
-	if err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.Keycloak{}, builder.WithPredicates(pred))
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.Keycloak{},
+		func() client.ObjectList { return &keycloakApi.KeycloakList{} },
+		keycloakSecretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup Keycloak secret watches: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.Keycloak{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.Keycloak{}, r)))); err != nil {
 		return fmt.Errorf("failed to setup Keycloak controller: %w", err)
 	}
 
 	return nil
 }
 
+// keycloakSecretRefs returns Secrets and ConfigMaps with credentials and CA certificate of the Keycloak.
+func keycloakSecretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	kc, ok := obj.(*keycloakApi.Keycloak)
+	if !ok {
+		return refs
+	}
+
+	refs.AddSecret(kc.Namespace, kc.Spec.Secret)
+	refs.AddAuth(kc.Namespace, kc.Spec.Auth)
+	refs.AddSourceRef(kc.Namespace, kc.Spec.CACert)
+
+	return refs
+}
+
 func (r *ReconcileKeycloak) updateConnectionStatusToKeycloak(ctx context.Context, instance *keycloakApi.Keycloak) error {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start updating connection status to Keycloak")
@@ -101,19 +133,27 @@ func (r *ReconcileKeycloak) updateConnectionStatusToKeycloak(ctx context.Context
 	err := r.createClient(ctx, instance)
 	if err != nil {
 		log.Error(err, "Unable to connect to Keycloak")
+		events.Warning(ctx, instance, events.ReasonKeycloakAPIError, "Unable to connect to Keycloak: %s", err.Error())
 	}
 
 	connected := err == nil
+	oldStatus := instance.Status.DeepCopy()
+
+	instance.Status.Connected = connected
 
-	if instance.Status.Connected == connected {
+	if connected {
+		status.SetReady(instance, "Connected to Keycloak")
+	} else {
+		status.SetNotReady(instance, events.ReasonKeycloakAPIError, fmt.Sprintf("Unable to connect to Keycloak: %s", err.Error()))
+	}
+
+	if equality.Semantic.DeepEqual(&instance.Status, oldStatus) {
 		log.Info("Connection status hasn't been changed", "status", instance.Status.Connected)
 
 		return nil
 	}
 
-	log.Info("Connection status has been changed", "from", instance.Status.Connected, "to", connected)
-
-	instance.Status.Connected = connected
+	log.Info("Connection status has been changed", "from", oldStatus.Connected, "to", connected)
 
 	err = r.client.Status().Update(ctx, instance)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -32,7 +33,10 @@ func (ch *Chain) Serve(ctx context.Context, flow *keycloakApi.KeycloakAuthFlow,
 	for i := 0; i < len(ch.handlers); i++ {
 		h := ch.handlers[i]
 
-		if err := h.Serve(ctx, flow, realmName); err != nil {
+		err := h.Serve(ctx, flow, realmName)
+		status.SetStep(flow, stepCondition(h), err)
+
+		if err != nil {
 			log.Info("KeycloakAuthFlow chain finished with error")
 
 			return fmt.Errorf("failed to serve handler: %w", err)
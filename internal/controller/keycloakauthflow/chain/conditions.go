@@ -0,0 +1,23 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionAuthFlowSynced indicates whether the authentication flow has been created or updated in Keycloak.
+	ConditionAuthFlowSynced = "AuthFlowSynced"
+
+	// ConditionExecutionsSynced indicates whether the authentication flow executions have been synced to Keycloak.
+	ConditionExecutionsSynced = "ExecutionsSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h AuthFlowHandler) string {
+	switch h.(type) {
+	case *CreateOrUpdateAuthFlow:
+		return ConditionAuthFlowSynced
+	case *SyncAuthFlowExecutions:
+		return ConditionExecutionsSynced
+	default:
+		return ""
+	}
+}
@@ -9,6 +9,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -74,6 +75,7 @@ func (h *CreateOrUpdateAuthFlow) serveTopLevelFlow(ctx context.Context, flow *ke
 	}
 
 	log.Info("Top-level auth flow created")
+	events.Normal(ctx, flow, events.ReasonCreated, "Authentication flow %s created", flow.Spec.Alias)
 
 	return h.validateChildFlows(ctx, flow, realmName)
 }
@@ -110,6 +112,7 @@ func (h *CreateOrUpdateAuthFlow) serveChildFlow(ctx context.Context, flow *keycl
 		existing = findExecByDisplayName(execs, flow.Spec.Alias)
 
 		log.Info("Child auth flow created")
+		events.Normal(ctx, flow, events.ReasonCreated, "Authentication flow %s created", flow.Spec.Alias)
 	}
 
 	if existing != nil && existing.FlowId != nil {
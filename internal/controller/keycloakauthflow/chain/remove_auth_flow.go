@@ -7,6 +7,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
@@ -15,18 +16,19 @@ import (
 // RemoveAuthFlow handles deletion of a KeycloakAuthFlow from Keycloak.
 // It ports the terminator and legacy DeleteAuthFlow + unsetBrowserFlow logic.
 type RemoveAuthFlow struct {
-	kClient   *keycloakapi.KeycloakClient
-	k8sClient client.Client
+	kClient               *keycloakapi.KeycloakClient
+	k8sClient             client.Client
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
-func NewRemoveAuthFlow(kClient *keycloakapi.KeycloakClient, k8sClient client.Client) *RemoveAuthFlow {
-	return &RemoveAuthFlow{kClient: kClient, k8sClient: k8sClient}
+func NewRemoveAuthFlow(kClient *keycloakapi.KeycloakClient, k8sClient client.Client, defaultDeletionPolicy common.DeletionPolicy) *RemoveAuthFlow {
+	return &RemoveAuthFlow{kClient: kClient, k8sClient: k8sClient, defaultDeletionPolicy: defaultDeletionPolicy}
 }
 
 func (h *RemoveAuthFlow) Serve(ctx context.Context, flow *keycloakApi.KeycloakAuthFlow, realmName string) error {
 	log := ctrl.LoggerFrom(ctx).WithValues("realm", realmName, "alias", flow.Spec.Alias)
 
-	if objectmeta.PreserveResourcesOnDeletion(flow) {
+	if objectmeta.PreserveResourcesOnDeletion(flow, h.defaultDeletionPolicy) {
 		log.Info("PreserveResourcesOnDeletion is enabled, skipping deletion")
 
 		return nil
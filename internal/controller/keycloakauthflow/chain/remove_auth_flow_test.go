@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -39,7 +40,7 @@ func TestRemoveAuthFlow_Serve_PreserveOnDeletion(t *testing.T) {
 	}
 	flow.Spec.Alias = testFlowAlias
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.NoError(t, err)
 }
@@ -62,7 +63,7 @@ func TestRemoveAuthFlow_Serve_TopLevel_Success(t *testing.T) {
 	mockFlows.EXPECT().DeleteAuthFlow(context.Background(), testRealmName, testFlowID).
 		Return(nil, nil)
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.NoError(t, err)
 }
@@ -96,7 +97,7 @@ func TestRemoveAuthFlow_Serve_TopLevel_BrowserFlowUnset(t *testing.T) {
 	mockFlows.EXPECT().DeleteAuthFlow(context.Background(), testRealmName, testFlowID).
 		Return(nil, nil)
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.NoError(t, err)
 }
@@ -109,7 +110,7 @@ func TestRemoveAuthFlow_Serve_TopLevel_EmptyStatusID(t *testing.T) {
 	flow.Spec.Alias = testFlowAlias
 	// Status.ID intentionally left empty — deletion should be skipped
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.NoError(t, err) // graceful skip
 }
@@ -132,7 +133,7 @@ func TestRemoveAuthFlow_Serve_ChildFlow_Success(t *testing.T) {
 	mockFlows.EXPECT().DeleteExecution(context.Background(), testRealmName, "child-exec-id").
 		Return(nil, nil)
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.NoError(t, err)
 }
@@ -150,7 +151,7 @@ func TestRemoveAuthFlow_Serve_ChildFlow_ParentNotFound(t *testing.T) {
 	mockFlows.EXPECT().GetFlowExecutions(context.Background(), testRealmName, testParentFlow).
 		Return(nil, nil, &keycloakapi.ApiError{Code: 404})
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.NoError(t, err)
 }
@@ -170,7 +171,7 @@ func TestRemoveAuthFlow_Serve_ChildFlow_NotInParent(t *testing.T) {
 			{DisplayName: ptr.To("other-child"), Id: ptr.To("other-id")},
 		}, nil, nil)
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.NoError(t, err)
 }
@@ -192,7 +193,7 @@ func TestRemoveAuthFlow_Serve_ChildK8sFlowExists(t *testing.T) {
 	flow.Spec.RealmRef.Name = testRealmName
 	flow.Spec.RealmRef.Kind = "KeycloakRealm"
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "cannot delete flow")
@@ -210,7 +211,7 @@ func TestRemoveAuthFlow_Serve_GetFlowExecutionsError(t *testing.T) {
 	mockFlows.EXPECT().GetFlowExecutions(context.Background(), testRealmName, testParentFlow).
 		Return(nil, nil, errors.New("api error"))
 
-	h := NewRemoveAuthFlow(kc, k8sClient)
+	h := NewRemoveAuthFlow(kc, k8sClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), flow, testRealmName)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get parent flow executions")
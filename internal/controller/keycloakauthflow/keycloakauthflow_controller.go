@@ -15,8 +15,12 @@ import (
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakauthflow/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -31,6 +35,8 @@ type Helper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 func NewReconcile(k8sClient client.Client, controllerHelper Helper) *Reconcile {
@@ -42,20 +48,54 @@ func NewReconcile(k8sClient client.Client, controllerHelper Helper) *Reconcile {
 
 // Reconcile reconciles a KeycloakAuthFlow object.
 type Reconcile struct {
-	client client.Client
-	helper Helper
+	client      client.Client
+	helper      Helper
+	adminEvents *adminevents.Watcher
+}
+
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *Reconcile) WithAdminEvents(watcher *adminevents.Watcher) *Reconcile {
+	r.adminEvents = watcher
+
+	return r
 }
 
 func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
-	if err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakAuthFlow{}).
-		Complete(r); err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakAuthFlow{})
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakAuthFlow{},
+		func() client.ObjectList { return &keycloakApi.KeycloakAuthFlowList{} },
+		authFlowAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakAuthFlow admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakAuthFlow{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakAuthFlow{}, r, pause.WithObserveModeSkip(r.helper, common.FinalizerName, legacyFinalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakAuthFlow controller: %w", err)
 	}
 
 	return nil
 }
 
+// authFlowAdminEventKeys returns admin event resource keys of the flow by ID and by alias,
+// as flow executions are addressed by the flow alias.
+func authFlowAdminEventKeys(obj client.Object) []string {
+	if flow, ok := obj.(*keycloakApi.KeycloakAuthFlow); ok {
+		return []string{
+			adminevents.Key(adminevents.ResourceAuthFlows, flow.Status.ID),
+			adminevents.Key(adminevents.ResourceAuthFlows, flow.Spec.Alias),
+		}
+	}
+
+	return nil
+}
+
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakauthflows,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakauthflows/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakauthflows/finalizers,verbs=update
@@ -125,7 +165,7 @@ func (r *Reconcile) initializeReconciliation(ctx context.Context, request reconc
 
 func (r *Reconcile) handleDeletion(ctx context.Context, instance *keycloakApi.KeycloakAuthFlow, kClient *keycloakapi.KeycloakClient, realmName string) (reconcile.Result, error) {
 	if controllerutil.ContainsFinalizer(instance, common.FinalizerName) || controllerutil.ContainsFinalizer(instance, legacyFinalizerName) {
-		if err := chain.NewRemoveAuthFlow(kClient, r.client).Serve(ctx, instance, realmName); err != nil {
+		if err := chain.NewRemoveAuthFlow(kClient, r.client, r.helper.DefaultDeletionPolicy()).Serve(ctx, instance, realmName); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to remove auth flow: %w", err)
 		}
 
@@ -152,6 +192,9 @@ func (r *Reconcile) handleReconciliation(ctx context.Context, instance *keycloak
 	oldStatus := instance.Status
 
 	if err := chain.MakeChain(kClient).Serve(ctx, instance, realmName); err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
 		log.Error(err, "An error has occurred while handling KeycloakAuthFlow")
 
 		resultErr := fmt.Errorf("auth flow chain processing failed: %w", err)
@@ -165,6 +208,7 @@ func (r *Reconcile) handleReconciliation(ctx context.Context, instance *keycloak
 	}
 
 	instance.Status.Value = common.StatusOK
+	status.SetReady(instance, "Reconciliation succeeded")
 
 	if err := r.updateKeycloakAuthFlowStatus(ctx, instance, oldStatus); err != nil {
 		return reconcile.Result{}, err
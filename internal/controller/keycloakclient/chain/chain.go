@@ -16,6 +16,9 @@ import (
 type ClientContext struct {
 	// ClientUUID is the Keycloak client UUID, set by PutClient handler.
 	ClientUUID string
+
+	// ClientSecret is the secret of the confidential client, set by PutClient handler.
+	ClientSecret string
 }
 
 type ClientHandler interface {
@@ -73,13 +76,18 @@ func MakeChain(
 		NewPutClientRole(kClient, k8sClient),
 		NewPutRealmRole(kClient, k8sClient),
 		NewPutClientScope(kClient, k8sClient),
+		NewPutScopeMappings(kClient, k8sClient),
 		NewPutProtocolMappers(kClient, k8sClient),
 		NewServiceAccount(kClient, k8sClient),
+		NewImportAuthorization(kClient, k8sClient),
 		NewProcessScope(kClient, k8sClient),
 		NewProcessResources(kClient, k8sClient),
 		NewProcessPolicy(kClient, k8sClient),
 		NewProcessPermissions(kClient, k8sClient),
+		NewProcessPermissionAggregates(kClient, k8sClient),
+		NewEvaluateAuthorizationTests(kClient, k8sClient),
 		NewPutAdminFineGrainedPermissions(kClient, k8sClient),
+		NewPutConnectionSecret(kClient, k8sClient),
 	)
 
 	return c
@@ -99,5 +99,5 @@ func TestMakeChain(t *testing.T) {
 
 	c := MakeChain(&keycloakapi.KeycloakClient{}, k8sClient)
 
-	require.Len(t, c.handlers, 11)
+	require.Len(t, c.handlers, 16)
 }
@@ -0,0 +1,105 @@
+package chain
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+// Keycloak client attributes of the client authenticators.
+const (
+	useJWKSURLAttribute              = "use.jwks.url"
+	jwksURLAttribute                 = "jwks.url"
+	jwtCertificateAttribute          = "jwt.credential.certificate"
+	tokenEndpointSigningAlgAttribute = "token.endpoint.auth.signing.alg"
+	x509SubjectDNAttribute           = "tls.client.certificate.subjectdn"
+	x509AllowRegexAttribute          = "x509.allow.regex.pattern.comparison"
+)
+
+// applyClientAuthentication sets the attributes with the key material of the client authenticator
+// in the client representation. The certificate is read from the referenced ConfigMap or Secret.
+func (h *PutClient) applyClientAuthentication(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	clientRep *keycloakapi.ClientRepresentation,
+) error {
+	auth := keycloakClient.Spec.ClientAuthentication
+	if auth == nil {
+		return nil
+	}
+
+	if keycloakClient.Spec.Public {
+		return errors.New("client authentication can not be configured for public client")
+	}
+
+	attributes := make(map[string]string)
+	if clientRep.Attributes != nil {
+		maps.Copy(attributes, *clientRep.Attributes)
+	}
+
+	switch auth.Type {
+	case keycloakApi.ClientAuthenticatorJWT:
+		switch {
+		case auth.JWKSURL != "":
+			attributes[useJWKSURLAttribute] = "true"
+			attributes[jwksURLAttribute] = auth.JWKSURL
+		case auth.Certificate != nil:
+			certPEM, err := secretref.GetValueFromSourceRef(ctx, auth.Certificate, keycloakClient.Namespace, h.k8sClient)
+			if err != nil {
+				return fmt.Errorf("unable to get client certificate: %w", err)
+			}
+
+			cert, err := encodeCertificate(certPEM)
+			if err != nil {
+				return err
+			}
+
+			attributes[useJWKSURLAttribute] = "false"
+			attributes[jwtCertificateAttribute] = cert
+		default:
+			return fmt.Errorf("jwksUrl or certificate is required for %s authenticator", auth.Type)
+		}
+	case keycloakApi.ClientAuthenticatorSecretJWT:
+		// The JWT is signed with the client secret, so no key material is required.
+	case keycloakApi.ClientAuthenticatorX509:
+		if auth.SubjectDN == "" {
+			return fmt.Errorf("subjectDn is required for %s authenticator", auth.Type)
+		}
+
+		attributes[x509SubjectDNAttribute] = auth.SubjectDN
+		attributes[x509AllowRegexAttribute] = "true"
+	default:
+		return fmt.Errorf("unsupported client authenticator type %q", auth.Type)
+	}
+
+	if auth.SigningAlgorithm != "" {
+		attributes[tokenEndpointSigningAlgAttribute] = auth.SigningAlgorithm
+	}
+
+	clientRep.Attributes = &attributes
+
+	return nil
+}
+
+// encodeCertificate returns the base64-encoded DER certificate from the PEM-encoded certificate
+// in the format of the Keycloak client certificate attribute.
+func encodeCertificate(certPEM string) (string, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", errors.New("client certificate must be a PEM-encoded certificate")
+	}
+
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return "", fmt.Errorf("unable to parse client certificate: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(block.Bytes), nil
+}
@@ -0,0 +1,197 @@
+package chain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+func TestPutClient_applyClientAuthentication(t *testing.T) {
+	t.Parallel()
+
+	certDER := generateTestCertificate(t)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+
+	certRef := &common.SourceRef{
+		SecretKeyRef: &common.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "client-cert"},
+			Key:                  "tls.crt",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		auth           *keycloakApi.ClientAuthentication
+		public         bool
+		certData       []byte
+		wantErr        require.ErrorAssertionFunc
+		wantAttributes map[string]string
+	}{
+		{
+			name:    "client authentication is not configured",
+			wantErr: require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris": "+",
+			},
+		},
+		{
+			name: "client-jwt with jwks url",
+			auth: &keycloakApi.ClientAuthentication{
+				Type:             keycloakApi.ClientAuthenticatorJWT,
+				JWKSURL:          "https://app.example.com/jwks",
+				SigningAlgorithm: "RS256",
+			},
+			wantErr: require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris":      "+",
+				useJWKSURLAttribute:              "true",
+				jwksURLAttribute:                 "https://app.example.com/jwks",
+				tokenEndpointSigningAlgAttribute: "RS256",
+			},
+		},
+		{
+			name: "client-jwt with certificate",
+			auth: &keycloakApi.ClientAuthentication{
+				Type:        keycloakApi.ClientAuthenticatorJWT,
+				Certificate: certRef,
+			},
+			certData: certPEM,
+			wantErr:  require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris": "+",
+				useJWKSURLAttribute:         "false",
+				jwtCertificateAttribute:     base64.StdEncoding.EncodeToString(certDER),
+			},
+		},
+		{
+			name: "client-jwt with invalid certificate",
+			auth: &keycloakApi.ClientAuthentication{
+				Type:        keycloakApi.ClientAuthenticatorJWT,
+				Certificate: certRef,
+			},
+			certData: []byte("not a certificate"),
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "client certificate must be a PEM-encoded certificate")
+			},
+		},
+		{
+			name: "client-jwt without key material",
+			auth: &keycloakApi.ClientAuthentication{Type: keycloakApi.ClientAuthenticatorJWT},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "jwksUrl or certificate is required")
+			},
+		},
+		{
+			name: "client-secret-jwt",
+			auth: &keycloakApi.ClientAuthentication{
+				Type:             keycloakApi.ClientAuthenticatorSecretJWT,
+				SigningAlgorithm: "HS256",
+			},
+			wantErr: require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris":      "+",
+				tokenEndpointSigningAlgAttribute: "HS256",
+			},
+		},
+		{
+			name: "client-x509",
+			auth: &keycloakApi.ClientAuthentication{
+				Type:      keycloakApi.ClientAuthenticatorX509,
+				SubjectDN: "CN=app(.*)",
+			},
+			wantErr: require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris": "+",
+				x509SubjectDNAttribute:      "CN=app(.*)",
+				x509AllowRegexAttribute:     "true",
+			},
+		},
+		{
+			name:   "public client",
+			auth:   &keycloakApi.ClientAuthentication{Type: keycloakApi.ClientAuthenticatorSecretJWT},
+			public: true,
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "can not be configured for public client")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:             "test-client-id",
+					Public:               tt.public,
+					ClientAuthentication: tt.auth,
+				},
+			}
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "client-cert", Namespace: "default"},
+				Data:       map[string][]byte{"tls.crt": tt.certData},
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(s).WithObjects(kc, secret).Build()
+
+			clientRep := keycloakapi.ClientRepresentation{
+				Attributes: &map[string]string{"post.logout.redirect.uris": "+"},
+			}
+
+			h := NewPutClient(&keycloakapi.KeycloakClient{}, k8sClient, nil)
+
+			err := h.applyClientAuthentication(context.Background(), kc, &clientRep)
+			tt.wantErr(t, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantAttributes, *clientRep.Attributes)
+		})
+	}
+}
+
+func generateTestCertificate(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "app"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return der
+}
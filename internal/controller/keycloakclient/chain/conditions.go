@@ -9,25 +9,36 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 )
 
 const (
 	// ConditionReady indicates the overall readiness of the KeycloakClient.
 	// This is the primary condition that summarizes all chain steps.
-	ConditionReady = "Ready"
+	ConditionReady = status.ConditionReady
 
 	// Individual chain step conditions - one per step
 	ConditionClientSynced                        = "ClientSynced"                        // PutClient
 	ConditionClientRolesSynced                   = "ClientRolesSynced"                   // PutClientRole
 	ConditionRealmRolesSynced                    = "RealmRolesSynced"                    // PutRealmRole
 	ConditionClientScopesSynced                  = "ClientScopesSynced"                  // PutClientScope
+	ConditionScopeMappingsSynced                 = "ScopeMappingsSynced"                 // PutScopeMappings
 	ConditionProtocolMappersSynced               = "ProtocolMappersSynced"               // PutProtocolMappers
 	ConditionServiceAccountSynced                = "ServiceAccountSynced"                // ServiceAccount
+	ConditionAuthorizationImported               = "AuthorizationImported"               // ImportAuthorization
 	ConditionAuthorizationScopesSynced           = "AuthorizationScopesSynced"           // ProcessScope
 	ConditionAuthorizationResourcesSynced        = "AuthorizationResourcesSynced"        // ProcessResources
 	ConditionAuthorizationPoliciesSynced         = "AuthorizationPoliciesSynced"         // ProcessPolicy
 	ConditionAuthorizationPermissionsSynced      = "AuthorizationPermissionsSynced"      // ProcessPermissions
+	ConditionAuthorizationTestsPassed            = "AuthorizationTestsPassed"            // EvaluateAuthorizationTests
 	ConditionAdminFineGrainedPermissionsV1Synced = "AdminFineGrainedPermissionsV1Synced" // PutAdminFineGrainedPermissions
+	ConditionConnectionSecretSynced              = "ConnectionSecretSynced"              // PutConnectionSecret
+	ConditionSecretRotationReady                 = "SecretRotationReady"                 // PutClient
+
+	// ConditionDrifted indicates whether the live Keycloak client differs from the spec.
+	// It is set only in observe reconcile mode.
+	ConditionDrifted = "Drifted"
 
 	// Success reasons - one per step
 	ReasonClientCreated                       = "ClientCreated"
@@ -35,23 +46,36 @@ const (
 	ReasonClientRolesSynced                   = "ClientRolesSynced"
 	ReasonRealmRolesSynced                    = "RealmRolesSynced"
 	ReasonClientScopesSynced                  = "ClientScopesSynced"
+	ReasonScopeMappingsSynced                 = "ScopeMappingsSynced"
 	ReasonProtocolMappersSynced               = "ProtocolMappersSynced"
 	ReasonServiceAccountSynced                = "ServiceAccountSynced"
+	ReasonAuthorizationImported               = "AuthorizationImported"
 	ReasonAuthorizationScopesSynced           = "AuthorizationScopesSynced"
 	ReasonAuthorizationResourcesSynced        = "AuthorizationResourcesSynced"
 	ReasonAuthorizationPoliciesSynced         = "AuthorizationPoliciesSynced"
 	ReasonAuthorizationPermissionsSynced      = "AuthorizationPermissionsSynced"
+	ReasonAuthorizationTestsPassed            = "AuthorizationTestsPassed"
+	ReasonAuthorizationTestsFailed            = "AuthorizationTestsFailed"
 	ReasonAdminFineGrainedPermissionsV1Synced = "AdminFineGrainedPermissionsV1Synced"
-	ReasonReconciliationSucceeded             = "ReconciliationSucceeded"
+	ReasonConnectionSecretSynced              = "ConnectionSecretSynced"
+	ReasonSecretRotationEnabled               = "SecretRotationEnabled"
+	ReasonReconciliationSucceeded             = events.ReasonReconciliationSucceeded
 
-	// Failure reasons - generic
-	ReasonKeycloakAPIError   = "KeycloakAPIError"
-	ReasonConfigurationError = "ConfigurationError"
-	ReasonSecretError        = "SecretError"
+	// Failure reasons - generic, shared with the events recorded for all resources
+	ReasonKeycloakAPIError   = events.ReasonKeycloakAPIError
+	ReasonConfigurationError = events.ReasonConfigurationError
+	ReasonSecretError        = events.ReasonSecretError
 
 	// Skipped reasons (for addOnly strategy or not configured)
 	ReasonSkippedAddOnly = "SkippedAddOnly"
 	ReasonNotConfigured  = "NotConfigured"
+
+	// ReasonSecretRotationExecutorNotConfigured is set when the client secret rotation executor is not enabled in the realm.
+	ReasonSecretRotationExecutorNotConfigured = "SecretRotationExecutorNotConfigured"
+
+	// Drift reasons (for observe reconcile mode)
+	ReasonDriftDetected = "DriftDetected"
+	ReasonNoDrift       = "NoDrift"
 )
 
 // SetCondition is a helper to set a condition on KeycloakClient and update status.
@@ -61,13 +85,13 @@ func SetCondition(
 	k8sClient client.Client,
 	keycloakClient *keycloakApi.KeycloakClient,
 	conditionType string,
-	status metav1.ConditionStatus,
+	conditionStatus metav1.ConditionStatus,
 	reason string,
 	message string,
 ) error {
 	if changed := meta.SetStatusCondition(&keycloakClient.Status.Conditions, metav1.Condition{
 		Type:               conditionType,
-		Status:             status,
+		Status:             conditionStatus,
 		Reason:             reason,
 		Message:            message,
 		ObservedGeneration: keycloakClient.Generation,
@@ -75,9 +99,19 @@ func SetCondition(
 		return nil
 	}
 
+	// The spec contains the values merged from the client template that are not stored in the resource,
+	// so it is restored after the status update.
+	spec := keycloakClient.Spec.DeepCopy()
+
 	if err := k8sClient.Status().Update(ctx, keycloakClient); err != nil {
 		return fmt.Errorf("failed to update condition %s: %w", conditionType, err)
 	}
 
+	keycloakClient.Spec = *spec
+
+	if conditionStatus == metav1.ConditionFalse && conditionType != ConditionReady && conditionType != ConditionDrifted {
+		events.Warning(ctx, keycloakClient, reason, "%s: %s", conditionType, message)
+	}
+
 	return nil
 }
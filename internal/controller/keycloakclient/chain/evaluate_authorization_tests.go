@@ -0,0 +1,166 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/maputil"
+)
+
+// EvaluateAuthorizationTests evaluates the test cases of the client authorization settings
+// and reports the results in the status. Failed test cases don't fail the reconciliation.
+type EvaluateAuthorizationTests struct {
+	kClient   *keycloakapi.KeycloakClient
+	k8sClient client.Client
+}
+
+func NewEvaluateAuthorizationTests(kClient *keycloakapi.KeycloakClient, k8sClient client.Client) *EvaluateAuthorizationTests {
+	return &EvaluateAuthorizationTests{kClient: kClient, k8sClient: k8sClient}
+}
+
+func (h *EvaluateAuthorizationTests) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string, clientCtx *ClientContext) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if keycloakClient.Spec.Authorization == nil || len(keycloakClient.Spec.Authorization.Tests) == 0 {
+		log.Info("Authorization tests are not specified")
+
+		keycloakClient.Status.AuthorizationTests = nil
+		meta.RemoveStatusCondition(&keycloakClient.Status.Conditions, ConditionAuthorizationTestsPassed)
+
+		return nil
+	}
+
+	resourcesList, _, err := h.kClient.Authorization.GetResources(ctx, realmName, clientCtx.ClientUUID)
+	if err != nil {
+		h.setCondition(ctx, keycloakClient, metav1.ConditionFalse, ReasonKeycloakAPIError,
+			fmt.Sprintf("Failed to evaluate authorization tests: %s", err.Error()))
+
+		return fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	existingResources := maputil.SliceToMapSelf(resourcesList, func(r keycloakapi.ResourceRepresentation) (string, bool) {
+		return *r.Name, r.Name != nil
+	})
+
+	tests := keycloakClient.Spec.Authorization.Tests
+	results := make([]keycloakApi.AuthorizationTestResult, 0, len(tests))
+	failed := make([]string, 0, len(tests))
+
+	for i := range tests {
+		result := h.evaluate(ctx, &tests[i], realmName, clientCtx.ClientUUID, existingResources)
+		if !result.Passed {
+			failed = append(failed, result.Name)
+		}
+
+		results = append(results, result)
+	}
+
+	keycloakClient.Status.AuthorizationTests = results
+
+	if len(failed) > 0 {
+		log.Info("Authorization tests failed", "tests", failed)
+
+		h.setCondition(ctx, keycloakClient, metav1.ConditionFalse, ReasonAuthorizationTestsFailed,
+			fmt.Sprintf("%d of %d authorization tests failed: %s", len(failed), len(tests), strings.Join(failed, ", ")))
+
+		return nil
+	}
+
+	h.setCondition(ctx, keycloakClient, metav1.ConditionTrue, ReasonAuthorizationTestsPassed,
+		fmt.Sprintf("%d authorization tests passed", len(tests)))
+
+	return nil
+}
+
+// evaluate evaluates the permissions of the test case identity for the resource and compares the decision with the expected one.
+func (h *EvaluateAuthorizationTests) evaluate(
+	ctx context.Context,
+	test *keycloakApi.AuthorizationTest,
+	realmName, clientUUID string,
+	existingResources map[string]keycloakapi.ResourceRepresentation,
+) keycloakApi.AuthorizationTestResult {
+	result := keycloakApi.AuthorizationTestResult{Name: test.Name}
+
+	resource, ok := existingResources[test.Resource]
+	if !ok || resource.UnderscoreId == nil {
+		result.Message = fmt.Sprintf("resource %s does not exist", test.Resource)
+
+		return result
+	}
+
+	evaluatedResource := keycloakapi.ResourceRepresentation{UnderscoreId: resource.UnderscoreId, Name: resource.Name}
+	if test.Scope != "" {
+		evaluatedResource.Scopes = &[]keycloakapi.ScopeRepresentation{{Name: ptr.To(test.Scope)}}
+	}
+
+	request := keycloakapi.PolicyEvaluationRequest{
+		Resources:    &[]keycloakapi.ResourceRepresentation{evaluatedResource},
+		Context:      &map[string]map[string]string{"attributes": {}},
+		Entitlements: ptr.To(false),
+	}
+
+	if test.User != "" {
+		user, _, err := h.kClient.Users.FindUserByUsername(ctx, realmName, test.User)
+		if err != nil && !keycloakapi.IsNotFound(err) {
+			result.Message = fmt.Sprintf("unable to get user %s: %s", test.User, err.Error())
+
+			return result
+		}
+
+		if user == nil || user.Id == nil {
+			result.Message = fmt.Sprintf("user %s does not exist", test.User)
+
+			return result
+		}
+
+		request.UserId = user.Id
+	}
+
+	if len(test.Roles) > 0 {
+		request.RoleIds = ptr.To(append([]string(nil), test.Roles...))
+	}
+
+	response, _, err := h.kClient.Authorization.EvaluatePolicies(ctx, realmName, clientUUID, request)
+	if err != nil {
+		result.Message = fmt.Sprintf("unable to evaluate permissions: %s", err.Error())
+
+		return result
+	}
+
+	result.Decision = keycloakApi.AuthorizationDecisionDeny
+	if response != nil && response.Status != nil {
+		result.Decision = string(*response.Status)
+	}
+
+	result.Passed = result.Decision == test.Expected
+
+	return result
+}
+
+func (h *EvaluateAuthorizationTests) setCondition(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	conditionStatus metav1.ConditionStatus,
+	reason, message string,
+) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := SetCondition(
+		ctx, h.k8sClient, keycloakClient,
+		ConditionAuthorizationTestsPassed,
+		conditionStatus,
+		reason,
+		message,
+	); err != nil {
+		log.Error(err, "Failed to set condition")
+	}
+}
@@ -0,0 +1,285 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestEvaluateAuthorizationTests_Serve(t *testing.T) {
+	t.Parallel()
+
+	const (
+		realmName  = "realm"
+		clientUUID = "client-uuid"
+	)
+
+	resources := []keycloakapi.ResourceRepresentation{
+		{UnderscoreId: ptr.To("documents-id"), Name: ptr.To("documents")},
+	}
+
+	decision := func(d keycloakapi.DecisionEffect) *keycloakapi.PolicyEvaluationResponse {
+		return &keycloakapi.PolicyEvaluationResponse{Status: ptr.To(d)}
+	}
+
+	tests := []struct {
+		name          string
+		authorization *keycloakApi.Authorization
+		authzClient   func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient
+		usersClient   func(t *testing.T) *keycloakapiMocks.MockUsersClient
+		wantErr       require.ErrorAssertionFunc
+		wantCondition *metav1.Condition
+		wantResults   []keycloakApi.AuthorizationTestResult
+	}{
+		{
+			name:          "tests are not specified",
+			authorization: &keycloakApi.Authorization{Scopes: []string{"read"}},
+			wantErr:       require.NoError,
+		},
+		{
+			name: "all tests passed",
+			authorization: &keycloakApi.Authorization{
+				Tests: []keycloakApi.AuthorizationTest{
+					{
+						Name:     "john can read documents",
+						User:     "john",
+						Resource: "documents",
+						Scope:    "read",
+						Expected: keycloakApi.AuthorizationDecisionPermit,
+					},
+					{
+						Name:     "guests can't read documents",
+						Roles:    []string{"guest"},
+						Resource: "documents",
+						Expected: keycloakApi.AuthorizationDecisionDeny,
+					},
+				},
+			},
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				m := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				m.On("GetResources", mock.Anything, realmName, clientUUID).Return(resources, nil, nil)
+				m.On("EvaluatePolicies", mock.Anything, realmName, clientUUID,
+					mock.MatchedBy(func(r keycloakapi.PolicyEvaluationRequest) bool {
+						return r.UserId != nil && *r.UserId == "john-id" &&
+							r.Resources != nil && len(*r.Resources) == 1 &&
+							*(*r.Resources)[0].UnderscoreId == "documents-id" &&
+							(*r.Resources)[0].Scopes != nil && *(*(*r.Resources)[0].Scopes)[0].Name == "read"
+					})).Return(decision(keycloakapi.DecisionEffectPermit), nil, nil)
+				m.On("EvaluatePolicies", mock.Anything, realmName, clientUUID,
+					mock.MatchedBy(func(r keycloakapi.PolicyEvaluationRequest) bool {
+						return r.UserId == nil && r.RoleIds != nil && (*r.RoleIds)[0] == "guest" &&
+							(*r.Resources)[0].Scopes == nil
+					})).Return(decision(keycloakapi.DecisionEffectDeny), nil, nil)
+
+				return m
+			},
+			usersClient: func(t *testing.T) *keycloakapiMocks.MockUsersClient {
+				m := keycloakapiMocks.NewMockUsersClient(t)
+
+				m.On("FindUserByUsername", mock.Anything, realmName, "john").
+					Return(&keycloakapi.UserRepresentation{Id: ptr.To("john-id")}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantCondition: &metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  ReasonAuthorizationTestsPassed,
+				Message: "2 authorization tests passed",
+			},
+			wantResults: []keycloakApi.AuthorizationTestResult{
+				{Name: "john can read documents", Passed: true, Decision: keycloakApi.AuthorizationDecisionPermit},
+				{Name: "guests can't read documents", Passed: true, Decision: keycloakApi.AuthorizationDecisionDeny},
+			},
+		},
+		{
+			name: "failed tests don't fail reconciliation",
+			authorization: &keycloakApi.Authorization{
+				Tests: []keycloakApi.AuthorizationTest{
+					{
+						Name:     "unexpected decision",
+						Roles:    []string{"guest"},
+						Resource: "documents",
+						Expected: keycloakApi.AuthorizationDecisionPermit,
+					},
+					{
+						Name:     "unknown user",
+						User:     "unknown",
+						Resource: "documents",
+						Expected: keycloakApi.AuthorizationDecisionPermit,
+					},
+					{
+						Name:     "unknown resource",
+						Roles:    []string{"guest"},
+						Resource: "unknown",
+						Expected: keycloakApi.AuthorizationDecisionDeny,
+					},
+				},
+			},
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				m := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				m.On("GetResources", mock.Anything, realmName, clientUUID).Return(resources, nil, nil)
+				m.On("EvaluatePolicies", mock.Anything, realmName, clientUUID, mock.Anything).
+					Return(decision(keycloakapi.DecisionEffectDeny), nil, nil)
+
+				return m
+			},
+			usersClient: func(t *testing.T) *keycloakapiMocks.MockUsersClient {
+				m := keycloakapiMocks.NewMockUsersClient(t)
+
+				m.On("FindUserByUsername", mock.Anything, realmName, "unknown").
+					Return(nil, nil, keycloakapi.ErrNotFound)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantCondition: &metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  ReasonAuthorizationTestsFailed,
+				Message: "3 of 3 authorization tests failed: unexpected decision, unknown user, unknown resource",
+			},
+			wantResults: []keycloakApi.AuthorizationTestResult{
+				{Name: "unexpected decision", Decision: keycloakApi.AuthorizationDecisionDeny},
+				{Name: "unknown user", Message: "user unknown does not exist"},
+				{Name: "unknown resource", Message: "resource unknown does not exist"},
+			},
+		},
+		{
+			name: "evaluation error is reported in test result",
+			authorization: &keycloakApi.Authorization{
+				Tests: []keycloakApi.AuthorizationTest{
+					{
+						Name:     "test",
+						Roles:    []string{"guest"},
+						Resource: "documents",
+						Expected: keycloakApi.AuthorizationDecisionDeny,
+					},
+				},
+			},
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				m := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				m.On("GetResources", mock.Anything, realmName, clientUUID).Return(resources, nil, nil)
+				m.On("EvaluatePolicies", mock.Anything, realmName, clientUUID, mock.Anything).
+					Return(nil, nil, errors.New("evaluation error"))
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantCondition: &metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  ReasonAuthorizationTestsFailed,
+				Message: "1 of 1 authorization tests failed: test",
+			},
+			wantResults: []keycloakApi.AuthorizationTestResult{
+				{Name: "test", Message: "unable to evaluate permissions: evaluation error"},
+			},
+		},
+		{
+			name: "failed to get resources",
+			authorization: &keycloakApi.Authorization{
+				Tests: []keycloakApi.AuthorizationTest{
+					{Name: "test", Roles: []string{"guest"}, Resource: "documents", Expected: keycloakApi.AuthorizationDecisionDeny},
+				},
+			},
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				m := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				m.On("GetResources", mock.Anything, realmName, clientUUID).Return(nil, nil, errors.New("api error"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "failed to get resources")
+			},
+			wantCondition: &metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  ReasonKeycloakAPIError,
+				Message: "Failed to evaluate authorization tests: api error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:      "test-client-id",
+					Authorization: tt.authorization,
+				},
+			}
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(kc).
+				WithStatusSubresource(kc).
+				Build()
+
+			authzClient := keycloakapiMocks.NewMockAuthorizationClient(t)
+			if tt.authzClient != nil {
+				authzClient = tt.authzClient(t)
+			}
+
+			usersClient := keycloakapiMocks.NewMockUsersClient(t)
+			if tt.usersClient != nil {
+				usersClient = tt.usersClient(t)
+			}
+
+			h := NewEvaluateAuthorizationTests(
+				&keycloakapi.KeycloakClient{Authorization: authzClient, Users: usersClient},
+				k8sClient,
+			)
+
+			err := h.Serve(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				kc,
+				realmName,
+				&ClientContext{ClientUUID: clientUUID},
+			)
+			tt.wantErr(t, err)
+
+			if tt.wantResults != nil {
+				assert.Equal(t, tt.wantResults, kc.Status.AuthorizationTests)
+			}
+
+			if tt.wantCondition == nil {
+				assert.Nil(t, meta.FindStatusCondition(kc.Status.Conditions, ConditionAuthorizationTestsPassed))
+
+				return
+			}
+
+			updated := &keycloakApi.KeycloakClient{}
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(kc), updated))
+
+			condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionAuthorizationTestsPassed)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantCondition.Status, condition.Status)
+			assert.Equal(t, tt.wantCondition.Reason, condition.Reason)
+			assert.Equal(t, tt.wantCondition.Message, condition.Message)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+// ImportAuthorization imports the authorization settings exported from Keycloak
+// from the ConfigMap referenced by spec.authorization.fromConfigMap.
+type ImportAuthorization struct {
+	kClient   *keycloakapi.KeycloakClient
+	k8sClient client.Client
+}
+
+func NewImportAuthorization(kClient *keycloakapi.KeycloakClient, k8sClient client.Client) *ImportAuthorization {
+	return &ImportAuthorization{kClient: kClient, k8sClient: k8sClient}
+}
+
+func (h *ImportAuthorization) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string, clientCtx *ClientContext) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !isAuthorizationImported(keycloakClient) {
+		return nil
+	}
+
+	ref := &common.SourceRef{ConfigMapKeyRef: keycloakClient.Spec.Authorization.FromConfigMap}
+
+	data, err := secretref.GetValueFromSourceRef(ctx, ref, keycloakClient.Namespace, h.k8sClient)
+	if err != nil {
+		h.setFailureCondition(ctx, keycloakClient, ReasonConfigurationError, fmt.Sprintf("Failed to import authorization settings: %s", err.Error()))
+
+		return fmt.Errorf("unable to get authorization settings: %w", err)
+	}
+
+	sum := sha256.Sum256([]byte(data))
+	hash := hex.EncodeToString(sum[:])
+
+	if keycloakClient.Status.AuthorizationImportHash == hash {
+		h.setSuccessCondition(ctx, keycloakClient, "Authorization settings imported")
+
+		return nil
+	}
+
+	resourceServer, err := parseResourceServer(data)
+	if err != nil {
+		h.setFailureCondition(ctx, keycloakClient, ReasonConfigurationError, fmt.Sprintf("Failed to import authorization settings: %s", err.Error()))
+
+		return err
+	}
+
+	if _, err = h.kClient.Authorization.ImportResourceServer(ctx, realmName, clientCtx.ClientUUID, *resourceServer); err != nil {
+		h.setFailureCondition(ctx, keycloakClient, ReasonKeycloakAPIError, fmt.Sprintf("Failed to import authorization settings: %s", err.Error()))
+
+		return fmt.Errorf("unable to import authorization settings: %w", err)
+	}
+
+	log.Info("Authorization settings imported", "configMap", keycloakClient.Spec.Authorization.FromConfigMap.Name)
+	events.Normal(ctx, keycloakClient, events.ReasonUpdated,
+		"Authorization settings imported from ConfigMap %s", keycloakClient.Spec.Authorization.FromConfigMap.Name)
+
+	if keycloakClient.Spec.ReconciliationStrategy != keycloakApi.ReconciliationStrategyAddOnly {
+		if err = h.deleteNotExported(ctx, resourceServer, realmName, clientCtx.ClientUUID); err != nil {
+			h.setFailureCondition(ctx, keycloakClient, ReasonKeycloakAPIError, fmt.Sprintf("Failed to import authorization settings: %s", err.Error()))
+
+			return err
+		}
+	}
+
+	keycloakClient.Status.AuthorizationImportHash = hash
+
+	h.setSuccessCondition(ctx, keycloakClient, "Authorization settings imported")
+
+	return nil
+}
+
+func parseResourceServer(data string) (*keycloakapi.ResourceServerRepresentation, error) {
+	resourceServer := &keycloakapi.ResourceServerRepresentation{}
+	if err := json.Unmarshal([]byte(data), resourceServer); err != nil {
+		return nil, fmt.Errorf("unable to parse authorization settings: %w", err)
+	}
+
+	// The exported settings refer to the client they were exported from.
+	resourceServer.Id = nil
+	resourceServer.ClientId = nil
+	resourceServer.Name = nil
+
+	return resourceServer, nil
+}
+
+// deleteNotExported deletes the permissions, policies, resources and scopes that are missing in the export.
+// The import endpoint only creates and updates them.
+func (h *ImportAuthorization) deleteNotExported(
+	ctx context.Context,
+	resourceServer *keycloakapi.ResourceServerRepresentation,
+	realmName, clientUUID string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Keycloak exports permissions together with policies.
+	exportedPolicies := exportedNames(resourceServer.Policies, func(p keycloakapi.PolicyRepresentation) *string { return p.Name })
+
+	permissions, _, err := h.kClient.Authorization.GetPermissions(ctx, realmName, clientUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get permissions: %w", err)
+	}
+
+	for _, p := range permissions {
+		if p.Id == nil || p.Name == nil || exportedPolicies[*p.Name] {
+			continue
+		}
+
+		if _, err = h.kClient.Authorization.DeletePermission(ctx, realmName, clientUUID, *p.Id); err != nil && !keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("failed to delete permission: %w", err)
+		}
+
+		log.Info("Permission deleted", permissionLogKey, *p.Name)
+	}
+
+	policies, _, err := h.kClient.Authorization.GetPolicies(ctx, realmName, clientUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get policies: %w", err)
+	}
+
+	for _, p := range policies {
+		if p.Id == nil || p.Name == nil || exportedPolicies[*p.Name] {
+			continue
+		}
+
+		if _, err = h.kClient.Authorization.DeletePolicy(ctx, realmName, clientUUID, *p.Id); err != nil && !keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("failed to delete policy: %w", err)
+		}
+
+		log.Info("Policy deleted", policyLogKey, *p.Name)
+	}
+
+	exportedResources := exportedNames(resourceServer.Resources, func(r keycloakapi.ResourceRepresentation) *string { return r.Name })
+
+	resources, _, err := h.kClient.Authorization.GetResources(ctx, realmName, clientUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	for _, r := range resources {
+		if r.UnderscoreId == nil || r.Name == nil || exportedResources[*r.Name] {
+			continue
+		}
+
+		if _, err = h.kClient.Authorization.DeleteResource(ctx, realmName, clientUUID, *r.UnderscoreId); err != nil && !keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("failed to delete resource: %w", err)
+		}
+
+		log.Info("Resource deleted", resourceLogKey, *r.Name)
+	}
+
+	exportedScopes := exportedNames(resourceServer.Scopes, func(s keycloakapi.ScopeRepresentation) *string { return s.Name })
+
+	scopes, _, err := h.kClient.Authorization.GetScopes(ctx, realmName, clientUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get scopes: %w", err)
+	}
+
+	for _, s := range scopes {
+		if s.Id == nil || s.Name == nil || exportedScopes[*s.Name] {
+			continue
+		}
+
+		if _, err = h.kClient.Authorization.DeleteScope(ctx, realmName, clientUUID, *s.Id); err != nil && !keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("failed to delete scope: %w", err)
+		}
+
+		log.Info("Scope deleted", scopeLogKey, *s.Name)
+	}
+
+	return nil
+}
+
+func (h *ImportAuthorization) setFailureCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, reason, message string) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := SetCondition(
+		ctx, h.k8sClient, keycloakClient,
+		ConditionAuthorizationImported,
+		metav1.ConditionFalse,
+		reason,
+		message,
+	); err != nil {
+		log.Error(err, "Failed to set failure condition")
+	}
+}
+
+func (h *ImportAuthorization) setSuccessCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := SetCondition(
+		ctx, h.k8sClient, keycloakClient,
+		ConditionAuthorizationImported,
+		metav1.ConditionTrue,
+		ReasonAuthorizationImported,
+		message,
+	); err != nil {
+		log.Error(err, "Failed to set success condition")
+	}
+}
+
+// isAuthorizationImported returns true if the authorization settings are imported from the ConfigMap
+// instead of being defined inline.
+func isAuthorizationImported(keycloakClient *keycloakApi.KeycloakClient) bool {
+	return keycloakClient.Spec.Authorization != nil && keycloakClient.Spec.Authorization.FromConfigMap != nil
+}
+
+func exportedNames[T any](items *[]T, name func(T) *string) map[string]bool {
+	names := make(map[string]bool)
+
+	if items == nil {
+		return names
+	}
+
+	for _, item := range *items {
+		if n := name(item); n != nil {
+			names[*n] = true
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,237 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestImportAuthorization_Serve(t *testing.T) {
+	t.Parallel()
+
+	const (
+		realmName  = "realm"
+		clientUUID = "client-uuid"
+	)
+
+	export := `{
+		"id": "exported-client-uuid",
+		"clientId": "exported-client-uuid",
+		"name": "exported-client",
+		"policyEnforcementMode": "ENFORCING",
+		"decisionStrategy": "AFFIRMATIVE",
+		"scopes": [{"name": "read"}],
+		"resources": [{"name": "documents"}],
+		"policies": [
+			{"name": "regex-policy", "type": "regex", "config": {"targetClaim": "department", "pattern": "^eng$"}},
+			{"name": "documents-permission", "type": "resource", "config": {"resources": "[\"documents\"]"}}
+		]
+	}`
+
+	exportSum := sha256.Sum256([]byte(export))
+	exportHash := hex.EncodeToString(exportSum[:])
+
+	fromConfigMap := &common.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "authz-export"},
+		Key:                  "authz.json",
+	}
+
+	tests := []struct {
+		name                   string
+		authorization          *keycloakApi.Authorization
+		reconciliationStrategy string
+		configMapData          string
+		importHash             string
+		authzClient            func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient
+		wantErr                require.ErrorAssertionFunc
+		wantCondition          metav1.ConditionStatus
+		wantImportHash         string
+	}{
+		{
+			name:          "authorization is not imported",
+			authorization: &keycloakApi.Authorization{Scopes: []string{"read"}},
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				return keycloakapiMocks.NewMockAuthorizationClient(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:          "settings imported and not exported ones deleted",
+			authorization: &keycloakApi.Authorization{FromConfigMap: fromConfigMap},
+			configMapData: export,
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				m := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				m.On("ImportResourceServer", mock.Anything, realmName, clientUUID,
+					mock.MatchedBy(func(rs keycloakapi.ResourceServerRepresentation) bool {
+						return rs.Id == nil && rs.ClientId == nil && rs.Name == nil &&
+							rs.Policies != nil && len(*rs.Policies) == 2 &&
+							rs.DecisionStrategy != nil && *rs.DecisionStrategy == keycloakapi.DecisionStrategy("AFFIRMATIVE")
+					})).Return(nil, nil)
+
+				m.On("GetPermissions", mock.Anything, realmName, clientUUID).
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{Id: ptr.To("documents-permission-id"), Name: ptr.To("documents-permission")},
+						{Id: ptr.To("old-permission-id"), Name: ptr.To("old-permission")},
+					}, nil, nil)
+				m.On("DeletePermission", mock.Anything, realmName, clientUUID, "old-permission-id").Return(nil, nil)
+
+				m.On("GetPolicies", mock.Anything, realmName, clientUUID).
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{Id: ptr.To("regex-policy-id"), Name: ptr.To("regex-policy")},
+						{Id: ptr.To("default-policy-id"), Name: ptr.To("Default Policy")},
+					}, nil, nil)
+				m.On("DeletePolicy", mock.Anything, realmName, clientUUID, "default-policy-id").Return(nil, nil)
+
+				m.On("GetResources", mock.Anything, realmName, clientUUID).
+					Return([]keycloakapi.ResourceRepresentation{
+						{UnderscoreId: ptr.To("documents-id"), Name: ptr.To("documents")},
+						{UnderscoreId: ptr.To("default-resource-id"), Name: ptr.To("Default Resource")},
+					}, nil, nil)
+				m.On("DeleteResource", mock.Anything, realmName, clientUUID, "default-resource-id").Return(nil, nil)
+
+				m.On("GetScopes", mock.Anything, realmName, clientUUID).
+					Return([]keycloakapi.ScopeRepresentation{
+						{Id: ptr.To("read-id"), Name: ptr.To("read")},
+					}, nil, nil)
+
+				return m
+			},
+			wantErr:        require.NoError,
+			wantCondition:  metav1.ConditionTrue,
+			wantImportHash: exportHash,
+		},
+		{
+			name:          "settings not imported again when ConfigMap is unchanged",
+			authorization: &keycloakApi.Authorization{FromConfigMap: fromConfigMap},
+			configMapData: export,
+			importHash:    exportHash,
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				return keycloakapiMocks.NewMockAuthorizationClient(t)
+			},
+			wantErr:        require.NoError,
+			wantCondition:  metav1.ConditionTrue,
+			wantImportHash: exportHash,
+		},
+		{
+			name:                   "addOnly keeps not exported settings",
+			authorization:          &keycloakApi.Authorization{FromConfigMap: fromConfigMap},
+			reconciliationStrategy: keycloakApi.ReconciliationStrategyAddOnly,
+			configMapData:          export,
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				m := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				m.On("ImportResourceServer", mock.Anything, realmName, clientUUID, mock.Anything).Return(nil, nil)
+
+				return m
+			},
+			wantErr:        require.NoError,
+			wantCondition:  metav1.ConditionTrue,
+			wantImportHash: exportHash,
+		},
+		{
+			name:          "invalid export",
+			authorization: &keycloakApi.Authorization{FromConfigMap: fromConfigMap},
+			configMapData: "not a json",
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				return keycloakapiMocks.NewMockAuthorizationClient(t)
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to parse authorization settings")
+			},
+			wantCondition: metav1.ConditionFalse,
+		},
+		{
+			name:          "import failed",
+			authorization: &keycloakApi.Authorization{FromConfigMap: fromConfigMap},
+			configMapData: export,
+			authzClient: func(t *testing.T) *keycloakapiMocks.MockAuthorizationClient {
+				m := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				m.On("ImportResourceServer", mock.Anything, realmName, clientUUID, mock.Anything).
+					Return(nil, errors.New("import error"))
+
+				return m
+			},
+			importHash: "previous-hash",
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to import authorization settings")
+			},
+			wantCondition:  metav1.ConditionFalse,
+			wantImportHash: "previous-hash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:               "test-client-id",
+					Authorization:          tt.authorization,
+					ReconciliationStrategy: tt.reconciliationStrategy,
+				},
+				Status: keycloakApi.KeycloakClientStatus{AuthorizationImportHash: tt.importHash},
+			}
+
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "authz-export", Namespace: "default"},
+				Data:       map[string]string{"authz.json": tt.configMapData},
+			}
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(kc, configMap).
+				WithStatusSubresource(kc).
+				Build()
+
+			h := NewImportAuthorization(&keycloakapi.KeycloakClient{Authorization: tt.authzClient(t)}, k8sClient)
+
+			err := h.Serve(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				kc,
+				realmName,
+				&ClientContext{ClientUUID: clientUUID},
+			)
+			tt.wantErr(t, err)
+
+			if tt.wantCondition == "" {
+				return
+			}
+
+			updated := &keycloakApi.KeycloakClient{}
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(kc), updated))
+
+			condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionAuthorizationImported)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantCondition, condition.Status)
+			assert.Equal(t, tt.wantImportHash, updated.Status.AuthorizationImportHash)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
+)
+
+// ObserveClient compares the KeycloakClient spec with the live Keycloak client without changing it.
+type ObserveClient struct {
+	kClient *keycloakapi.KeycloakClient
+}
+
+func NewObserveClient(kClient *keycloakapi.KeycloakClient) *ObserveClient {
+	return &ObserveClient{kClient: kClient}
+}
+
+// Serve returns the list of differences between the spec and the live Keycloak client.
+// Client secret is not compared, as Keycloak does not return it in the client representation.
+func (h *ObserveClient) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string) ([]string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Start observing Keycloak client")
+
+	authFlowOverrides, err := getAuthFlowOverrides(ctx, h.kClient, keycloakClient, realmName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get auth flows: %w", err)
+	}
+
+	desired := convertSpecToClientRepresentation(&keycloakClient.Spec, "", authFlowOverrides)
+
+	live, _, err := h.kClient.Clients.GetClientByClientID(ctx, realmName, keycloakClient.Spec.ClientId)
+	if err != nil && !keycloakapi.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to get keycloak client: %w", err)
+	}
+
+	if live == nil || live.Id == nil {
+		return []string{fmt.Sprintf("client %s does not exist in Keycloak", keycloakClient.Spec.ClientId)}, nil
+	}
+
+	keycloakClient.Status.ClientID = *live.Id
+
+	diffs, err := drift.Diff(desired, live)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare keycloak client: %w", err)
+	}
+
+	log.Info("End observing Keycloak client", "driftedFields", len(diffs))
+
+	return diffs, nil
+}
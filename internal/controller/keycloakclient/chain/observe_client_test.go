@@ -0,0 +1,110 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestObserveClient_Serve(t *testing.T) {
+	spec := keycloakApi.KeycloakClientSpec{
+		ClientId:     "test-client",
+		Enabled:      true,
+		RedirectUris: []string{"https://a/*", "https://b/*"},
+		Secret:       "secret-ref",
+	}
+
+	tests := []struct {
+		name       string
+		kClient    func(t *testing.T) *keycloakapi.KeycloakClient
+		wantDiffs  []string
+		wantStatus string
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name: "no drift",
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+				clientsMock.On("GetClientByClientID", testifymock.Anything, "test-realm", "test-client").
+					Return(&keycloakapi.ClientRepresentation{
+						Id:           ptr.To("client-uuid"),
+						ClientId:     ptr.To("test-client"),
+						Enabled:      ptr.To(true),
+						RedirectUris: &[]string{"https://b/*", "https://a/*"},
+						Secret:       ptr.To("live-secret"),
+					}, nil, nil)
+
+				return &keycloakapi.KeycloakClient{Clients: clientsMock}
+			},
+			wantStatus: "client-uuid",
+			wantErr:    require.NoError,
+		},
+		{
+			name: "drift detected",
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+				clientsMock.On("GetClientByClientID", testifymock.Anything, "test-realm", "test-client").
+					Return(&keycloakapi.ClientRepresentation{
+						Id:           ptr.To("client-uuid"),
+						ClientId:     ptr.To("test-client"),
+						Enabled:      ptr.To(false),
+						RedirectUris: &[]string{"https://a/*", "https://b/*"},
+					}, nil, nil)
+
+				return &keycloakapi.KeycloakClient{Clients: clientsMock}
+			},
+			wantDiffs:  []string{"enabled: false -> true"},
+			wantStatus: "client-uuid",
+			wantErr:    require.NoError,
+		},
+		{
+			name: "client does not exist",
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+				clientsMock.On("GetClientByClientID", testifymock.Anything, "test-realm", "test-client").
+					Return(nil, nil, keycloakapi.ErrNotFound)
+
+				return &keycloakapi.KeycloakClient{Clients: clientsMock}
+			},
+			wantDiffs: []string{"client test-client does not exist in Keycloak"},
+			wantErr:   require.NoError,
+		},
+		{
+			name: "keycloak api error",
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+				clientsMock.On("GetClientByClientID", testifymock.Anything, "test-realm", "test-client").
+					Return(nil, nil, errors.New("connection refused"))
+
+				return &keycloakapi.KeycloakClient{Clients: clientsMock}
+			},
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kc := &keycloakApi.KeycloakClient{Spec: spec}
+
+			diffs, err := NewObserveClient(tt.kClient(t)).Serve(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				kc,
+				"test-realm",
+			)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantDiffs, diffs)
+			assert.Equal(t, tt.wantStatus, kc.Status.ClientID)
+		})
+	}
+}
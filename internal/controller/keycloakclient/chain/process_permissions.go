@@ -9,7 +9,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	"github.com/epam/edp-keycloak-operator/pkg/maputil"
 )
 
@@ -32,6 +34,11 @@ func (h *ProcessPermissions) Serve(ctx context.Context, keycloakClient *keycloak
 		return nil
 	}
 
+	if isAuthorizationImported(keycloakClient) {
+		log.Info("Authorization settings are imported from ConfigMap")
+		return nil
+	}
+
 	clientUUID := clientCtx.ClientUUID
 
 	permissionsList, _, err := h.kClient.Authorization.GetPermissions(ctx, realmName, clientUUID)
@@ -65,6 +72,22 @@ func (h *ProcessPermissions) Serve(ctx context.Context, keycloakClient *keycloak
 			}
 
 			permissionRepresentation.Id = existingPermission.Id
+
+			upToDate, err := h.isPermissionUpToDate(ctx, realmName, clientUUID, &existingPermission, permissionRepresentation)
+			if err != nil {
+				h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization permissions: %s", err.Error()))
+
+				return err
+			}
+
+			delete(existingPermissions, keycloakClient.Spec.Authorization.Permissions[i].Name)
+
+			if upToDate {
+				log.Info("Permission is up to date, skipping update", permissionLogKey, keycloakClient.Spec.Authorization.Permissions[i].Name)
+
+				continue
+			}
+
 			if _, err = h.kClient.Authorization.UpdatePermission(ctx, realmName, clientUUID, permType, *existingPermission.Id, permissionRepresentation); err != nil {
 				h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization permissions: %s", err.Error()))
 
@@ -72,8 +95,7 @@ func (h *ProcessPermissions) Serve(ctx context.Context, keycloakClient *keycloak
 			}
 
 			log.Info("Permission updated", permissionLogKey, keycloakClient.Spec.Authorization.Permissions[i].Name)
-
-			delete(existingPermissions, keycloakClient.Spec.Authorization.Permissions[i].Name)
+			events.Normal(ctx, keycloakClient, events.ReasonUpdated, "Authorization permission %s updated", keycloakClient.Spec.Authorization.Permissions[i].Name)
 
 			continue
 		}
@@ -85,6 +107,7 @@ func (h *ProcessPermissions) Serve(ctx context.Context, keycloakClient *keycloak
 		}
 
 		log.Info("Permission created", permissionLogKey, keycloakClient.Spec.Authorization.Permissions[i].Name)
+		events.Normal(ctx, keycloakClient, events.ReasonCreated, "Authorization permission %s created", keycloakClient.Spec.Authorization.Permissions[i].Name)
 	}
 
 	if keycloakClient.Spec.ReconciliationStrategy != keycloakApi.ReconciliationStrategyAddOnly {
@@ -100,6 +123,79 @@ func (h *ProcessPermissions) Serve(ctx context.Context, keycloakClient *keycloak
 	return nil
 }
 
+// isPermissionUpToDate returns true if the live permission already matches the desired representation.
+// Keycloak doesn't return resources, scopes and policies of the permission in the permission list,
+// so they are read separately and compared by ID.
+func (h *ProcessPermissions) isPermissionUpToDate(
+	ctx context.Context,
+	realmName, clientUUID string,
+	existing *keycloakapi.AbstractPolicyRepresentation,
+	desired keycloakapi.PolicyRepresentation,
+) (bool, error) {
+	live := keycloakapi.PolicyRepresentation{
+		Id:               existing.Id,
+		Name:             existing.Name,
+		Type:             existing.Type,
+		Description:      existing.Description,
+		DecisionStrategy: existing.DecisionStrategy,
+		Logic:            existing.Logic,
+	}
+
+	resources, _, err := h.kClient.Authorization.GetPolicyResources(ctx, realmName, clientUUID, *existing.Id)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission resources: %w", err)
+	}
+
+	resourceIDs := make([]string, 0, len(resources))
+
+	for _, r := range resources {
+		if r.UnderscoreId != nil {
+			resourceIDs = append(resourceIDs, *r.UnderscoreId)
+		}
+	}
+
+	live.Resources = &resourceIDs
+
+	policies, _, err := h.kClient.Authorization.GetPolicyAssociatedPolicies(ctx, realmName, clientUUID, *existing.Id)
+	if err != nil {
+		return false, fmt.Errorf("failed to get permission policies: %w", err)
+	}
+
+	policyIDs := make([]string, 0, len(policies))
+
+	for _, p := range policies {
+		if p.Id != nil {
+			policyIDs = append(policyIDs, *p.Id)
+		}
+	}
+
+	live.Policies = &policyIDs
+
+	if desired.Scopes != nil {
+		scopes, _, err := h.kClient.Authorization.GetPolicyScopes(ctx, realmName, clientUUID, *existing.Id)
+		if err != nil {
+			return false, fmt.Errorf("failed to get permission scopes: %w", err)
+		}
+
+		scopeIDs := make([]string, 0, len(scopes))
+
+		for _, s := range scopes {
+			if s.Id != nil {
+				scopeIDs = append(scopeIDs, *s.Id)
+			}
+		}
+
+		live.Scopes = &scopeIDs
+	}
+
+	diffs, err := drift.Diff(desired, live)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare permission: %w", err)
+	}
+
+	return len(diffs) == 0, nil
+}
+
 func (h *ProcessPermissions) setFailureCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
 	log := ctrl.LoggerFrom(ctx)
 
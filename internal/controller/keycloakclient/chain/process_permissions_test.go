@@ -103,6 +103,12 @@ func TestProcessPermissions_Serve(t *testing.T) {
 							Name: ptr.To("scope"),
 						},
 					}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyResources", mock.Anything, "master", "clientID", "scope-permission-id").
+					Return([]keycloakapi.ResourceRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyAssociatedPolicies", mock.Anything, "master", "clientID", "scope-permission-id").
+					Return([]keycloakapi.PolicyRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyScopes", mock.Anything, "master", "clientID", "scope-permission-id").
+					Return([]keycloakapi.ScopeRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
 				authzMock.On(
 					"UpdatePermission",
 					mock.Anything,
@@ -200,6 +206,12 @@ func TestProcessPermissions_Serve(t *testing.T) {
 							Name: ptr.To("scope"),
 						},
 					}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyResources", mock.Anything, "master", "clientID", "scope-permission-id").
+					Return([]keycloakapi.ResourceRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyAssociatedPolicies", mock.Anything, "master", "clientID", "scope-permission-id").
+					Return([]keycloakapi.PolicyRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyScopes", mock.Anything, "master", "clientID", "scope-permission-id").
+					Return([]keycloakapi.ScopeRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
 				authzMock.On(
 					"UpdatePermission",
 					mock.Anything,
@@ -226,6 +238,60 @@ func TestProcessPermissions_Serve(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "permission is up to date",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "client",
+					Authorization: &keycloakApi.Authorization{
+						Permissions: []keycloakApi.Permission{
+							{
+								Name:             permissionName,
+								Type:             keycloakApi.PermissionTypeResource,
+								DecisionStrategy: "UNANIMOUS",
+								Logic:            "POSITIVE",
+								Policies:         []string{"policy"},
+								Resources:        []string{"resource"},
+							},
+						},
+					},
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+				authzMock := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				authzMock.On("GetPermissions", mock.Anything, "master", "clientID").
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{
+							Id:               ptr.To(permissionName + "-id"),
+							Name:             ptr.To(permissionName),
+							Type:             ptr.To(keycloakApi.PermissionTypeResource),
+							DecisionStrategy: ptr.To(keycloakapi.DecisionStrategy("UNANIMOUS")),
+							Logic:            ptr.To(keycloakapi.Logic("POSITIVE")),
+						},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetResources", mock.Anything, "master", "clientID").
+					Return([]keycloakapi.ResourceRepresentation{
+						{UnderscoreId: ptr.To("resource-id"), Name: ptr.To("resource")},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicies", mock.Anything, "master", "clientID").
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{Id: ptr.To("policy-id"), Name: ptr.To("policy")},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyResources", mock.Anything, "master", "clientID", permissionName+"-id").
+					Return([]keycloakapi.ResourceRepresentation{
+						{UnderscoreId: ptr.To("resource-id"), Name: ptr.To("resource")},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyAssociatedPolicies", mock.Anything, "master", "clientID", permissionName+"-id").
+					Return([]keycloakapi.PolicyRepresentation{
+						{Id: ptr.To("policy-id"), Name: ptr.To("policy")},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+
+				return &keycloakapi.KeycloakClient{Clients: clientsMock, Authorization: authzMock}
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "failed to delete permission",
 			keycloakClient: &keycloakApi.KeycloakClient{
@@ -335,6 +401,10 @@ func TestProcessPermissions_Serve(t *testing.T) {
 							Name: ptr.To("policy"),
 						},
 					}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyResources", mock.Anything, "master", "clientID", permissionName+"-id").
+					Return([]keycloakapi.ResourceRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
+				authzMock.On("GetPolicyAssociatedPolicies", mock.Anything, "master", "clientID", permissionName+"-id").
+					Return([]keycloakapi.PolicyRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
 				authzMock.On(
 					"UpdatePermission",
 					mock.Anything,
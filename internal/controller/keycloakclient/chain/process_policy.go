@@ -2,15 +2,20 @@ package chain
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
+	"reflect"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	"github.com/epam/edp-keycloak-operator/pkg/maputil"
 )
 
@@ -19,12 +24,22 @@ const policyLogKey = "policy"
 type ProcessPolicy struct {
 	kClient   *keycloakapi.KeycloakClient
 	k8sClient client.Client
+	// afterPermissions is true if the handler syncs only aggregate policies that reference permissions.
+	afterPermissions bool
 }
 
+// NewProcessPolicy returns a handler that syncs authorization policies, except aggregate policies
+// that reference permissions of the client, and deletes policies that are not in the spec.
 func NewProcessPolicy(kClient *keycloakapi.KeycloakClient, k8sClient client.Client) *ProcessPolicy {
 	return &ProcessPolicy{kClient: kClient, k8sClient: k8sClient}
 }
 
+// NewProcessPermissionAggregates returns a handler that syncs aggregate policies that reference permissions
+// of the client. It should be used after ProcessPermissions, so the referenced permissions exist.
+func NewProcessPermissionAggregates(kClient *keycloakapi.KeycloakClient, k8sClient client.Client) *ProcessPolicy {
+	return &ProcessPolicy{kClient: kClient, k8sClient: k8sClient, afterPermissions: true}
+}
+
 // Serve method for processing keycloak client policies.
 func (h *ProcessPolicy) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string, clientCtx *ClientContext) error {
 	log := ctrl.LoggerFrom(ctx)
@@ -34,6 +49,16 @@ func (h *ProcessPolicy) Serve(ctx context.Context, keycloakClient *keycloakApi.K
 		return nil
 	}
 
+	if isAuthorizationImported(keycloakClient) {
+		log.Info("Authorization settings are imported from ConfigMap")
+		return nil
+	}
+
+	dependOnPermissions := policiesDependingOnPermissions(keycloakClient.Spec.Authorization)
+	if h.afterPermissions && len(dependOnPermissions) == 0 {
+		return nil
+	}
+
 	clientUUID := clientCtx.ClientUUID
 
 	policiesList, _, err := h.kClient.Authorization.GetPolicies(ctx, realmName, clientUUID)
@@ -52,66 +77,179 @@ func (h *ProcessPolicy) Serve(ctx context.Context, keycloakClient *keycloakApi.K
 	policiesToDelete := make(map[string]keycloakapi.AbstractPolicyRepresentation, len(existingPolicies))
 	maps.Copy(policiesToDelete, existingPolicies)
 
-	for i := 0; i < len(keycloakClient.Spec.Authorization.Policies); i++ {
-		log.Info("Processing policy", policyLogKey, keycloakClient.Spec.Authorization.Policies[i].Name)
+	for i := range keycloakClient.Spec.Authorization.Policies {
+		policy := &keycloakClient.Spec.Authorization.Policies[i]
 
-		policyBody, err := h.toPolicyBody(ctx, &keycloakClient.Spec.Authorization.Policies[i], realmName, existingPolicies)
-		if err != nil {
+		delete(policiesToDelete, policy.Name)
+
+		if dependOnPermissions[policy.Name] != h.afterPermissions {
+			continue
+		}
+
+		if err = h.putPolicy(ctx, keycloakClient, policy, realmName, clientUUID, existingPolicies); err != nil {
 			h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization policies: %s", err.Error()))
 
-			return fmt.Errorf("failed to convert policy: %w", err)
+			return err
 		}
+	}
 
-		policyName := keycloakClient.Spec.Authorization.Policies[i].Name
-		policyType := keycloakClient.Spec.Authorization.Policies[i].Type
+	if !h.afterPermissions && keycloakClient.Spec.ReconciliationStrategy != keycloakApi.ReconciliationStrategyAddOnly {
+		if err = h.deletePolicies(ctx, policiesToDelete, realmName, clientUUID); err != nil {
+			h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization policies: %s", err.Error()))
 
-		existingPolicy, ok := existingPolicies[policyName]
-		if ok {
-			if existingPolicy.Id == nil {
-				return fmt.Errorf("existing policy %s does not have ID", policyName)
-			}
+			return err
+		}
+	}
 
-			if _, err = h.kClient.Authorization.UpdatePolicy(ctx, realmName, clientUUID, policyType, *existingPolicy.Id, policyBody); err != nil {
-				h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization policies: %s", err.Error()))
+	h.setSuccessCondition(ctx, keycloakClient, "Authorization policies synchronized")
 
-				return fmt.Errorf("failed to update policy: %w", err)
-			}
+	return nil
+}
 
-			log.Info("Policy updated", policyLogKey, policyName)
+// putPolicy creates or updates the policy in Keycloak.
+// The created policy is added to existingPolicies, so aggregate policies can reference it.
+func (h *ProcessPolicy) putPolicy(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	policy *keycloakApi.Policy,
+	realmName, clientUUID string,
+	existingPolicies map[string]keycloakapi.AbstractPolicyRepresentation,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Processing policy", policyLogKey, policy.Name)
 
-			delete(policiesToDelete, policyName)
+	policyBody, err := h.toPolicyBody(ctx, policy, realmName, clientUUID, existingPolicies)
+	if err != nil {
+		return fmt.Errorf("failed to convert policy: %w", err)
+	}
 
-			continue
+	policyType := keycloakPolicyType(policy)
+
+	if existingPolicy, ok := existingPolicies[policy.Name]; ok {
+		if existingPolicy.Id == nil {
+			return fmt.Errorf("existing policy %s does not have ID", policy.Name)
 		}
 
-		createdPolicy, _, err := h.kClient.Authorization.CreatePolicy(ctx, realmName, clientUUID, policyType, policyBody)
+		upToDate, err := h.isPolicyUpToDate(ctx, realmName, clientUUID, policyType, *existingPolicy.Id, policyBody)
 		if err != nil {
-			h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization policies: %s", err.Error()))
+			return err
+		}
+
+		if upToDate {
+			log.Info("Policy is up to date, skipping update", policyLogKey, policy.Name)
+
+			return nil
+		}
+
+		if _, err = h.kClient.Authorization.UpdatePolicy(ctx, realmName, clientUUID, policyType, *existingPolicy.Id, policyBody); err != nil {
+			return fmt.Errorf("failed to update policy: %w", err)
+		}
+
+		log.Info("Policy updated", policyLogKey, policy.Name)
+		events.Normal(ctx, keycloakClient, events.ReasonUpdated, "Authorization policy %s updated", policy.Name)
+
+		return nil
+	}
+
+	createdPolicy, _, err := h.kClient.Authorization.CreatePolicy(ctx, realmName, clientUUID, policyType, policyBody)
+	if err != nil {
+		return fmt.Errorf("failed to create policy: %w", err)
+	}
+
+	log.Info("Policy created", policyLogKey, policy.Name)
+	events.Normal(ctx, keycloakClient, events.ReasonCreated, "Authorization policy %s created", policy.Name)
+
+	if createdPolicy != nil && createdPolicy.Name != nil {
+		existingPolicies[*createdPolicy.Name] = keycloakapi.AbstractPolicyRepresentation{
+			Id:   createdPolicy.Id,
+			Name: createdPolicy.Name,
+		}
+	}
+
+	return nil
+}
+
+// isPolicyUpToDate returns true if the live policy already matches the desired policy body.
+// The live policy is read into the type of the desired body, so only fields managed by the operator are compared.
+// Keycloak doesn't return policies of an aggregate policy in the policy representation, so they are read separately.
+func (h *ProcessPolicy) isPolicyUpToDate(
+	ctx context.Context,
+	realmName, clientUUID, policyType, policyID string,
+	policyBody any,
+) (bool, error) {
+	resp, err := h.kClient.Authorization.GetPolicy(ctx, realmName, clientUUID, policyType, policyID)
+	if err != nil {
+		// The policy type has changed, so the policy can't be read as the desired type.
+		if keycloakapi.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get policy: %w", err)
+	}
+
+	if resp == nil || len(resp.Body) == 0 {
+		return false, nil
+	}
+
+	live := reflect.New(reflect.TypeOf(policyBody).Elem()).Interface()
+	if err = json.Unmarshal(resp.Body, live); err != nil {
+		return false, fmt.Errorf("failed to decode policy: %w", err)
+	}
 
-			return fmt.Errorf("failed to create policy: %w", err)
+	if aggregate, ok := live.(*keycloakapi.AggregatePolicyBody); ok {
+		associated, _, err := h.kClient.Authorization.GetPolicyAssociatedPolicies(ctx, realmName, clientUUID, policyID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get associated policies: %w", err)
 		}
 
-		log.Info("Policy created", policyLogKey, policyName)
+		aggregate.Policies = make([]string, 0, len(associated))
 
-		if createdPolicy != nil && createdPolicy.Name != nil {
-			existingPolicies[*createdPolicy.Name] = keycloakapi.AbstractPolicyRepresentation{
-				Id:   createdPolicy.Id,
-				Name: createdPolicy.Name,
+		for _, p := range associated {
+			if p.Id != nil {
+				aggregate.Policies = append(aggregate.Policies, *p.Id)
 			}
 		}
 	}
 
-	if keycloakClient.Spec.ReconciliationStrategy != keycloakApi.ReconciliationStrategyAddOnly {
-		if err = h.deletePolicies(ctx, policiesToDelete, realmName, clientUUID); err != nil {
-			h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization policies: %s", err.Error()))
+	diffs, err := drift.Diff(policyBody, live)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare policy: %w", err)
+	}
 
-			return err
+	return len(diffs) == 0, nil
+}
+
+// policiesDependingOnPermissions returns names of aggregate policies that reference permissions of the spec,
+// directly or through other aggregate policies. Permissions are synced after policies,
+// so these policies can be synced only after the permissions are created.
+func policiesDependingOnPermissions(authorization *keycloakApi.Authorization) map[string]bool {
+	dependent := make(map[string]bool)
+
+	for i := range authorization.Permissions {
+		dependent[authorization.Permissions[i].Name] = true
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for i := range authorization.Policies {
+			policy := &authorization.Policies[i]
+			if dependent[policy.Name] || policy.Type != keycloakApi.PolicyTypeAggregate || policy.AggregatedPolicy == nil {
+				continue
+			}
+
+			if slices.ContainsFunc(policy.AggregatedPolicy.Policies, func(name string) bool { return dependent[name] }) {
+				dependent[policy.Name] = true
+				changed = true
+			}
 		}
 	}
 
-	h.setSuccessCondition(ctx, keycloakClient, "Authorization policies synchronized")
+	for i := range authorization.Permissions {
+		delete(dependent, authorization.Permissions[i].Name)
+	}
 
-	return nil
+	return dependent
 }
 
 func (h *ProcessPolicy) setFailureCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
@@ -172,12 +310,12 @@ func (h *ProcessPolicy) deletePolicies(ctx context.Context, existingPolicies map
 func (h *ProcessPolicy) toPolicyBody(
 	ctx context.Context,
 	policy *keycloakApi.Policy,
-	realm string,
+	realm, clientUUID string,
 	existingPolicies map[string]keycloakapi.AbstractPolicyRepresentation,
 ) (any, error) {
 	base := keycloakapi.PolicyBodyBase{
 		Name:             policy.Name,
-		Type:             policy.Type,
+		Type:             keycloakPolicyType(policy),
 		Description:      policy.Description,
 		DecisionStrategy: keycloakapi.DecisionStrategy(policy.DecisionStrategy),
 		Logic:            keycloakapi.Logic(policy.Logic),
@@ -185,11 +323,17 @@ func (h *ProcessPolicy) toPolicyBody(
 
 	switch policy.Type {
 	case keycloakApi.PolicyTypeAggregate:
-		return h.toAggregatePolicyBody(policy, existingPolicies, base)
+		return h.toAggregatePolicyBody(ctx, policy, realm, clientUUID, existingPolicies, base)
 	case keycloakApi.PolicyTypeClient:
 		return h.toClientPolicyBody(ctx, policy, realm, base)
+	case keycloakApi.PolicyTypeClientScope:
+		return h.toClientScopePolicyBody(ctx, policy, realm, base)
 	case keycloakApi.PolicyTypeGroup:
 		return h.toGroupPolicyBody(ctx, policy, realm, base)
+	case keycloakApi.PolicyTypeJS:
+		return h.toJSPolicyBody(policy, base)
+	case keycloakApi.PolicyTypeRegex:
+		return h.toRegexPolicyBody(policy, base)
 	case keycloakApi.PolicyTypeRole:
 		return h.toRolePolicyBody(ctx, policy, realm, base)
 	case keycloakApi.PolicyTypeTime:
@@ -202,7 +346,9 @@ func (h *ProcessPolicy) toPolicyBody(
 }
 
 func (h *ProcessPolicy) toAggregatePolicyBody(
+	ctx context.Context,
 	policy *keycloakApi.Policy,
+	realm, clientUUID string,
 	existingPolicies map[string]keycloakapi.AbstractPolicyRepresentation,
 	base keycloakapi.PolicyBodyBase,
 ) (any, error) {
@@ -212,10 +358,27 @@ func (h *ProcessPolicy) toAggregatePolicyBody(
 
 	policies := make([]string, 0, len(policy.AggregatedPolicy.Policies))
 
+	// existingPermissions is loaded only if the aggregated policy references a permission.
+	var existingPermissions map[string]keycloakapi.AbstractPolicyRepresentation
+
 	for _, p := range policy.AggregatedPolicy.Policies {
 		existingPolicy, ok := existingPolicies[p]
 		if !ok {
-			return nil, fmt.Errorf("policy %s does not exist", p)
+			if existingPermissions == nil {
+				permissionsList, _, err := h.kClient.Authorization.GetPermissions(ctx, realm, clientUUID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get permissions: %w", err)
+				}
+
+				existingPermissions = maputil.SliceToMapSelf(permissionsList, func(p keycloakapi.AbstractPolicyRepresentation) (string, bool) {
+					return *p.Name, p.Name != nil
+				})
+			}
+
+			existingPolicy, ok = existingPermissions[p]
+			if !ok {
+				return nil, fmt.Errorf("policy or permission %s does not exist", p)
+			}
 		}
 
 		if existingPolicy.Id == nil {
@@ -260,6 +423,46 @@ func (h *ProcessPolicy) toClientPolicyBody(ctx context.Context, policy *keycloak
 	return &keycloakapi.ClientPolicyBody{PolicyBodyBase: base, Clients: clients}, nil
 }
 
+func (h *ProcessPolicy) toClientScopePolicyBody(
+	ctx context.Context,
+	policy *keycloakApi.Policy,
+	realm string,
+	base keycloakapi.PolicyBodyBase,
+) (any, error) {
+	if policy.ClientScopePolicy == nil {
+		return nil, fmt.Errorf("clientScopePolicy spec is not specified")
+	}
+
+	scopesList, _, err := h.kClient.ClientScopes.GetClientScopes(ctx, realm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client scopes: %w", err)
+	}
+
+	existingScopes := maputil.SliceToMapSelf(scopesList, func(s keycloakapi.ClientScopeRepresentation) (string, bool) {
+		return *s.Name, s.Name != nil
+	})
+
+	clientScopes := make([]keycloakapi.ClientScopeDefinition, 0, len(policy.ClientScopePolicy.ClientScopes))
+
+	for _, cs := range policy.ClientScopePolicy.ClientScopes {
+		existingScope, ok := existingScopes[cs.Name]
+		if !ok {
+			return nil, fmt.Errorf("client scope %s does not exist", cs.Name)
+		}
+
+		if existingScope.Id == nil {
+			return nil, fmt.Errorf("client scope %s does not have ID", cs.Name)
+		}
+
+		clientScopes = append(clientScopes, keycloakapi.ClientScopeDefinition{
+			ID:       *existingScope.Id,
+			Required: cs.Required,
+		})
+	}
+
+	return &keycloakapi.ClientScopePolicyBody{PolicyBodyBase: base, ClientScopes: clientScopes}, nil
+}
+
 func (h *ProcessPolicy) toGroupPolicyBody(ctx context.Context, policy *keycloakApi.Policy, realm string, base keycloakapi.PolicyBodyBase) (any, error) {
 	if policy.GroupPolicy == nil {
 		return nil, fmt.Errorf("group spec is not specified")
@@ -299,6 +502,27 @@ func (h *ProcessPolicy) toGroupPolicyBody(ctx context.Context, policy *keycloakA
 	}, nil
 }
 
+func (h *ProcessPolicy) toJSPolicyBody(policy *keycloakApi.Policy, base keycloakapi.PolicyBodyBase) (any, error) {
+	if policy.JSPolicy == nil {
+		return nil, fmt.Errorf("jsPolicy spec is not specified")
+	}
+
+	return &keycloakapi.JSPolicyBody{PolicyBodyBase: base}, nil
+}
+
+func (h *ProcessPolicy) toRegexPolicyBody(policy *keycloakApi.Policy, base keycloakapi.PolicyBodyBase) (any, error) {
+	if policy.RegexPolicy == nil {
+		return nil, fmt.Errorf("regexPolicy spec is not specified")
+	}
+
+	return &keycloakapi.RegexPolicyBody{
+		PolicyBodyBase:          base,
+		TargetClaim:             policy.RegexPolicy.TargetClaim,
+		Pattern:                 policy.RegexPolicy.Pattern,
+		TargetContextAttributes: policy.RegexPolicy.TargetContextAttributes,
+	}, nil
+}
+
 func (h *ProcessPolicy) toRolePolicyBody(ctx context.Context, policy *keycloakApi.Policy, realm string, base keycloakapi.PolicyBodyBase) (any, error) {
 	if policy.RolePolicy == nil {
 		return nil, fmt.Errorf("role spec is not specified")
@@ -376,3 +600,13 @@ func (h *ProcessPolicy) toUserPolicyBody(ctx context.Context, policy *keycloakAp
 
 	return &keycloakapi.UserPolicyBody{PolicyBodyBase: base, Users: users}, nil
 }
+
+// keycloakPolicyType returns the Keycloak policy provider type of the policy.
+// JavaScript policies deployed to Keycloak have a provider per script named script-{file name}.
+func keycloakPolicyType(policy *keycloakApi.Policy) string {
+	if policy.Type == keycloakApi.PolicyTypeJS && policy.JSPolicy != nil {
+		return "script-" + policy.JSPolicy.Script
+	}
+
+	return policy.Type
+}
@@ -9,11 +9,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -47,6 +49,24 @@ func TestProcessPolicy_Serve(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "authorization is imported from ConfigMap",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "test-client",
+					Authorization: &keycloakApi.Authorization{
+						FromConfigMap: &common.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "authz-export"},
+							Key:                  "authz.json",
+						},
+					},
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				return &keycloakapi.KeycloakClient{Authorization: keycloakapiMocks.NewMockAuthorizationClient(t)}
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "policies processed successfully",
 			keycloakClient: &keycloakApi.KeycloakClient{
@@ -152,8 +172,20 @@ func TestProcessPolicy_Serve(t *testing.T) {
 				authzMock.On("CreatePolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything).
 					Return((*keycloakapi.PolicyRepresentation)(nil), (*keycloakapi.Response)(nil), nil).Times(4)
 
-				authzMock.On("UpdatePolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything, mock.Anything).
-					Return((*keycloakapi.Response)(nil), nil).Times(2)
+				authzMock.On("GetPolicy", mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeUser, "user-policy-id").
+					Return(&keycloakapi.Response{
+						Body: []byte(`{"id":"user-policy-id","name":"user-policy","type":"user",` +
+							`"description":"User policy","logic":"POSITIVE","users":["test-user-id"]}`),
+					}, nil).Once()
+
+				authzMock.On("GetPolicy", mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeRole, "role-policy-id").
+					Return(&keycloakapi.Response{
+						Body: []byte(`{"id":"role-policy-id","name":"role-policy","type":"role",` +
+							`"description":"Role policy","roles":[{"id":"test-role-id","required":false}]}`),
+					}, nil).Once()
+
+				authzMock.On("UpdatePolicy", mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeRole, "role-policy-id", mock.Anything).
+					Return((*keycloakapi.Response)(nil), nil).Once()
 
 				authzMock.On("DeletePolicy", mock.Anything, "master", "test-client-id", "user-policy2-id").
 					Return((*keycloakapi.Response)(nil), nil).Once()
@@ -275,6 +307,9 @@ func TestProcessPolicy_Serve(t *testing.T) {
 				authzMock.On("CreatePolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything).
 					Return((*keycloakapi.PolicyRepresentation)(nil), (*keycloakapi.Response)(nil), nil).Times(4)
 
+				authzMock.On("GetPolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything).
+					Return((*keycloakapi.Response)(nil), nil)
+
 				authzMock.On("UpdatePolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything, mock.Anything).
 					Return((*keycloakapi.Response)(nil), nil).Times(2)
 
@@ -339,6 +374,216 @@ func TestProcessPolicy_Serve(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "regex, client scope and js policies processed successfully",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "test-client",
+					Authorization: &keycloakApi.Authorization{
+						Policies: []keycloakApi.Policy{
+							{
+								Name: "regex-policy",
+								Type: keycloakApi.PolicyTypeRegex,
+								RegexPolicy: &keycloakApi.RegexPolicyData{
+									TargetClaim: "department",
+									Pattern:     "^engineering$",
+								},
+							},
+							{
+								Name: "client-scope-policy",
+								Type: keycloakApi.PolicyTypeClientScope,
+								ClientScopePolicy: &keycloakApi.ClientScopePolicyData{
+									ClientScopes: []keycloakApi.ClientScopeDefinition{
+										{Name: "profile", Required: true},
+									},
+								},
+							},
+							{
+								Name: "js-policy",
+								Type: keycloakApi.PolicyTypeJS,
+								JSPolicy: &keycloakApi.JSPolicyData{
+									Script: "my-policy.js",
+								},
+							},
+						},
+					},
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				authzMock := keycloakapiMocks.NewMockAuthorizationClient(t)
+				clientScopesMock := keycloakapiMocks.NewMockClientScopesClient(t)
+
+				authzMock.On("GetPolicies", mock.Anything, "master", "test-client-id").
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{Id: ptr.To("js-policy-id"), Name: ptr.To("js-policy")},
+					}, (*keycloakapi.Response)(nil), nil)
+
+				clientScopesMock.On("GetClientScopes", mock.Anything, "master").
+					Return([]keycloakapi.ClientScopeRepresentation{
+						{Id: ptr.To("profile-id"), Name: ptr.To("profile")},
+					}, (*keycloakapi.Response)(nil), nil)
+
+				authzMock.On("CreatePolicy", mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeRegex,
+					&keycloakapi.RegexPolicyBody{
+						PolicyBodyBase: keycloakapi.PolicyBodyBase{Name: "regex-policy", Type: keycloakApi.PolicyTypeRegex},
+						TargetClaim:    "department",
+						Pattern:        "^engineering$",
+					}).
+					Return((*keycloakapi.PolicyRepresentation)(nil), (*keycloakapi.Response)(nil), nil).Once()
+
+				authzMock.On("CreatePolicy", mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeClientScope,
+					&keycloakapi.ClientScopePolicyBody{
+						PolicyBodyBase: keycloakapi.PolicyBodyBase{Name: "client-scope-policy", Type: keycloakApi.PolicyTypeClientScope},
+						ClientScopes:   []keycloakapi.ClientScopeDefinition{{ID: "profile-id", Required: true}},
+					}).
+					Return((*keycloakapi.PolicyRepresentation)(nil), (*keycloakapi.Response)(nil), nil).Once()
+
+				authzMock.On("GetPolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything).
+					Return((*keycloakapi.Response)(nil), nil)
+
+				authzMock.On("UpdatePolicy", mock.Anything, "master", "test-client-id", "script-my-policy.js", "js-policy-id",
+					&keycloakapi.JSPolicyBody{
+						PolicyBodyBase: keycloakapi.PolicyBodyBase{Name: "js-policy", Type: "script-my-policy.js"},
+					}).
+					Return((*keycloakapi.Response)(nil), nil).Once()
+
+				return &keycloakapi.KeycloakClient{
+					Authorization: authzMock,
+					ClientScopes:  clientScopesMock,
+				}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "aggregate policy is up to date",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:               "test-client",
+					ReconciliationStrategy: keycloakApi.ReconciliationStrategyAddOnly,
+					Authorization: &keycloakApi.Authorization{
+						Policies: []keycloakApi.Policy{
+							{
+								Name:             "aggregate-policy",
+								Type:             keycloakApi.PolicyTypeAggregate,
+								DecisionStrategy: "UNANIMOUS",
+								Logic:            "POSITIVE",
+								AggregatedPolicy: &keycloakApi.AggregatedPolicyData{
+									Policies: []string{"client-policy", "user-policy"},
+								},
+							},
+						},
+					},
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				authzMock := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				authzMock.On("GetPolicies", mock.Anything, "master", "test-client-id").
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{Id: ptr.To("aggregate-policy-id"), Name: ptr.To("aggregate-policy")},
+						{Id: ptr.To("client-policy-id"), Name: ptr.To("client-policy")},
+						{Id: ptr.To("user-policy-id"), Name: ptr.To("user-policy")},
+					}, (*keycloakapi.Response)(nil), nil)
+
+				authzMock.On("GetPolicy", mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeAggregate, "aggregate-policy-id").
+					Return(&keycloakapi.Response{
+						Body: []byte(`{"id":"aggregate-policy-id","name":"aggregate-policy","type":"aggregate",` +
+							`"decisionStrategy":"UNANIMOUS","logic":"POSITIVE"}`),
+					}, nil).Once()
+
+				authzMock.On("GetPolicyAssociatedPolicies", mock.Anything, "master", "test-client-id", "aggregate-policy-id").
+					Return([]keycloakapi.PolicyRepresentation{
+						{Id: ptr.To("user-policy-id"), Name: ptr.To("user-policy")},
+						{Id: ptr.To("client-policy-id"), Name: ptr.To("client-policy")},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+
+				return &keycloakapi.KeycloakClient{Authorization: authzMock}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "aggregate policy references permission",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "test-client",
+					Authorization: &keycloakApi.Authorization{
+						Policies: []keycloakApi.Policy{
+							{
+								Name: "aggregate-policy",
+								Type: keycloakApi.PolicyTypeAggregate,
+								AggregatedPolicy: &keycloakApi.AggregatedPolicyData{
+									Policies: []string{"role-policy", "resource-permission"},
+								},
+							},
+						},
+					},
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				authzMock := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				authzMock.On("GetPolicies", mock.Anything, "master", "test-client-id").
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{Id: ptr.To("role-policy-id"), Name: ptr.To("role-policy")},
+					}, (*keycloakapi.Response)(nil), nil)
+
+				authzMock.On("GetPermissions", mock.Anything, "master", "test-client-id").
+					Return([]keycloakapi.AbstractPolicyRepresentation{
+						{Id: ptr.To("resource-permission-id"), Name: ptr.To("resource-permission")},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+
+				authzMock.On("CreatePolicy", mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeAggregate,
+					&keycloakapi.AggregatePolicyBody{
+						PolicyBodyBase: keycloakapi.PolicyBodyBase{Name: "aggregate-policy", Type: keycloakApi.PolicyTypeAggregate},
+						Policies:       []string{"role-policy-id", "resource-permission-id"},
+					}).
+					Return((*keycloakapi.PolicyRepresentation)(nil), (*keycloakapi.Response)(nil), nil).Once()
+
+				authzMock.On("DeletePolicy", mock.Anything, "master", "test-client-id", "role-policy-id").
+					Return((*keycloakapi.Response)(nil), nil).Once()
+
+				return &keycloakapi.KeycloakClient{
+					Authorization: authzMock,
+				}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "aggregate policy references missing policy",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "test-client",
+					Authorization: &keycloakApi.Authorization{
+						Policies: []keycloakApi.Policy{
+							{
+								Name: "aggregate-policy",
+								Type: keycloakApi.PolicyTypeAggregate,
+								AggregatedPolicy: &keycloakApi.AggregatedPolicyData{
+									Policies: []string{"missing-policy"},
+								},
+							},
+						},
+					},
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				authzMock := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				authzMock.On("GetPolicies", mock.Anything, "master", "test-client-id").
+					Return([]keycloakapi.AbstractPolicyRepresentation{}, (*keycloakapi.Response)(nil), nil)
+
+				authzMock.On("GetPermissions", mock.Anything, "master", "test-client-id").
+					Return([]keycloakapi.AbstractPolicyRepresentation{}, (*keycloakapi.Response)(nil), nil).Once()
+
+				return &keycloakapi.KeycloakClient{
+					Authorization: authzMock,
+				}
+			},
+			wantErr: func(t require.TestingT, err error, i ...any) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "policy or permission missing-policy does not exist")
+			},
+		},
 		{
 			name: "failed to delete policy",
 			keycloakClient: &keycloakApi.KeycloakClient{
@@ -373,6 +618,9 @@ func TestProcessPolicy_Serve(t *testing.T) {
 						{Id: ptr.To("test-client-id"), ClientId: ptr.To("test-client")},
 					}, (*keycloakapi.Response)(nil), nil)
 
+				authzMock.On("GetPolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything).
+					Return((*keycloakapi.Response)(nil), nil)
+
 				authzMock.On("UpdatePolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything, mock.Anything).
 					Return((*keycloakapi.Response)(nil), nil).Times(1)
 
@@ -422,6 +670,9 @@ func TestProcessPolicy_Serve(t *testing.T) {
 						{Id: ptr.To("test-client-id"), ClientId: ptr.To("test-client")},
 					}, (*keycloakapi.Response)(nil), nil)
 
+				authzMock.On("GetPolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything).
+					Return((*keycloakapi.Response)(nil), nil)
+
 				authzMock.On("UpdatePolicy", mock.Anything, "master", "test-client-id", mock.Anything, mock.Anything, mock.Anything).
 					Return((*keycloakapi.Response)(nil), errors.New("failed to update policy")).Times(1)
 
@@ -577,3 +828,128 @@ func TestProcessPolicy_Serve(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessPolicy_AggregatePolicyReferencesPermission_EmptyResourceServer(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	keycloakClient := &keycloakApi.KeycloakClient{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"},
+		Spec: keycloakApi.KeycloakClientSpec{
+			ClientId: "test-client",
+			Authorization: &keycloakApi.Authorization{
+				Policies: []keycloakApi.Policy{
+					{
+						Name: "aggregate-policy",
+						Type: keycloakApi.PolicyTypeAggregate,
+						AggregatedPolicy: &keycloakApi.AggregatedPolicyData{
+							Policies: []string{"role-policy", "resource-permission"},
+						},
+					},
+					{
+						Name: "role-policy",
+						Type: keycloakApi.PolicyTypeRole,
+						RolePolicy: &keycloakApi.RolePolicyData{
+							Roles: []keycloakApi.RoleDefinition{{Name: "test-role"}},
+						},
+					},
+				},
+				Permissions: []keycloakApi.Permission{
+					{
+						Name:     "resource-permission",
+						Type:     keycloakApi.PermissionTypeResource,
+						Policies: []string{"role-policy"},
+					},
+				},
+			},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(keycloakClient).
+		WithStatusSubresource(keycloakClient).
+		Build()
+
+	// The resource server is empty, policies and permissions are added as they are created.
+	var policies, permissions []keycloakapi.AbstractPolicyRepresentation
+
+	rolesMock := keycloakapiMocks.NewMockRolesClient(t)
+	rolesMock.On("GetRealmRoles", mock.Anything, "master", (*keycloakapi.GetRealmRolesParams)(nil)).
+		Return([]keycloakapi.RoleRepresentation{{Id: ptr.To("test-role-id"), Name: ptr.To("test-role")}}, (*keycloakapi.Response)(nil), nil)
+
+	authzMock := keycloakapiMocks.NewMockAuthorizationClient(t)
+	authzMock.EXPECT().GetPolicies(mock.Anything, "master", "test-client-id").
+		RunAndReturn(func(context.Context, string, string) ([]keycloakapi.AbstractPolicyRepresentation, *keycloakapi.Response, error) {
+			return policies, nil, nil
+		})
+	authzMock.EXPECT().GetPermissions(mock.Anything, "master", "test-client-id").
+		RunAndReturn(func(context.Context, string, string) ([]keycloakapi.AbstractPolicyRepresentation, *keycloakapi.Response, error) {
+			return permissions, nil, nil
+		})
+	authzMock.EXPECT().CreatePolicy(mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeRole, mock.Anything).
+		RunAndReturn(func(context.Context, string, string, string, any) (*keycloakapi.PolicyRepresentation, *keycloakapi.Response, error) {
+			policies = append(policies, keycloakapi.AbstractPolicyRepresentation{Id: ptr.To("role-policy-id"), Name: ptr.To("role-policy")})
+
+			return &keycloakapi.PolicyRepresentation{Id: ptr.To("role-policy-id"), Name: ptr.To("role-policy")}, nil, nil
+		}).Once()
+	authzMock.EXPECT().CreatePermission(mock.Anything, "master", "test-client-id", keycloakApi.PermissionTypeResource,
+		mock.MatchedBy(func(p keycloakapi.PolicyRepresentation) bool {
+			return p.Policies != nil && len(*p.Policies) == 1 && (*p.Policies)[0] == "role-policy-id"
+		})).
+		RunAndReturn(func(context.Context, string, string, string, keycloakapi.PolicyRepresentation) (*keycloakapi.PolicyRepresentation, *keycloakapi.Response, error) {
+			permissions = append(permissions, keycloakapi.AbstractPolicyRepresentation{Id: ptr.To("resource-permission-id"), Name: ptr.To("resource-permission")})
+
+			return nil, nil, nil
+		}).Once()
+	authzMock.EXPECT().CreatePolicy(mock.Anything, "master", "test-client-id", keycloakApi.PolicyTypeAggregate,
+		&keycloakapi.AggregatePolicyBody{
+			PolicyBodyBase: keycloakapi.PolicyBodyBase{Name: "aggregate-policy", Type: keycloakApi.PolicyTypeAggregate},
+			Policies:       []string{"role-policy-id", "resource-permission-id"},
+		}).
+		Return(&keycloakapi.PolicyRepresentation{Id: ptr.To("aggregate-policy-id"), Name: ptr.To("aggregate-policy")}, nil, nil).Once()
+
+	kClient := &keycloakapi.KeycloakClient{Authorization: authzMock, Roles: rolesMock}
+	ctx := ctrl.LoggerInto(context.Background(), logr.Discard())
+	clientCtx := &ClientContext{ClientUUID: "test-client-id"}
+
+	for _, h := range []ClientHandler{
+		NewProcessPolicy(kClient, k8sClient),
+		NewProcessPermissions(kClient, k8sClient),
+		NewProcessPermissionAggregates(kClient, k8sClient),
+	} {
+		require.NoError(t, h.Serve(ctx, keycloakClient, "master", clientCtx))
+	}
+}
+
+func TestPoliciesDependingOnPermissions(t *testing.T) {
+	t.Parallel()
+
+	authorization := &keycloakApi.Authorization{
+		Policies: []keycloakApi.Policy{
+			{
+				Name:             "outer-aggregate",
+				Type:             keycloakApi.PolicyTypeAggregate,
+				AggregatedPolicy: &keycloakApi.AggregatedPolicyData{Policies: []string{"inner-aggregate"}},
+			},
+			{
+				Name:             "inner-aggregate",
+				Type:             keycloakApi.PolicyTypeAggregate,
+				AggregatedPolicy: &keycloakApi.AggregatedPolicyData{Policies: []string{"role-policy", "permission"}},
+			},
+			{
+				Name:             "policy-aggregate",
+				Type:             keycloakApi.PolicyTypeAggregate,
+				AggregatedPolicy: &keycloakApi.AggregatedPolicyData{Policies: []string{"role-policy"}},
+			},
+			{Name: "role-policy", Type: keycloakApi.PolicyTypeRole},
+		},
+		Permissions: []keycloakApi.Permission{{Name: "permission"}},
+	}
+
+	require.Equal(t,
+		map[string]bool{"outer-aggregate": true, "inner-aggregate": true},
+		policiesDependingOnPermissions(authorization),
+	)
+}
@@ -11,7 +11,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	"github.com/epam/edp-keycloak-operator/pkg/maputil"
 )
 
@@ -34,6 +36,11 @@ func (h *ProcessResources) Serve(ctx context.Context, keycloakClient *keycloakAp
 		return nil
 	}
 
+	if isAuthorizationImported(keycloakClient) {
+		log.Info("Authorization settings are imported from ConfigMap")
+		return nil
+	}
+
 	clientUUID := clientCtx.ClientUUID
 
 	resourcesList, _, err := h.kClient.Authorization.GetResources(ctx, realmName, clientUUID)
@@ -66,6 +73,21 @@ func (h *ProcessResources) Serve(ctx context.Context, keycloakClient *keycloakAp
 				return fmt.Errorf("existing resource %s has no ID", keycloakClient.Spec.Authorization.Resources[i].Name)
 			}
 
+			upToDate, err := h.isResourceUpToDate(ctx, realmName, clientUUID, *existingResource.UnderscoreId, resourceRepresentation)
+			if err != nil {
+				h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization resources: %s", err.Error()))
+
+				return err
+			}
+
+			delete(existingResources, keycloakClient.Spec.Authorization.Resources[i].Name)
+
+			if upToDate {
+				log.Info("Resource is up to date, skipping update", resourceLogKey, keycloakClient.Spec.Authorization.Resources[i].Name)
+
+				continue
+			}
+
 			if _, err = h.kClient.Authorization.UpdateResource(ctx, realmName, clientUUID, *existingResource.UnderscoreId, resourceRepresentation); err != nil {
 				h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync authorization resources: %s", err.Error()))
 
@@ -73,8 +95,7 @@ func (h *ProcessResources) Serve(ctx context.Context, keycloakClient *keycloakAp
 			}
 
 			log.Info("Resource updated", resourceLogKey, keycloakClient.Spec.Authorization.Resources[i].Name)
-
-			delete(existingResources, keycloakClient.Spec.Authorization.Resources[i].Name)
+			events.Normal(ctx, keycloakClient, events.ReasonUpdated, "Authorization resource %s updated", keycloakClient.Spec.Authorization.Resources[i].Name)
 
 			continue
 		}
@@ -86,6 +107,7 @@ func (h *ProcessResources) Serve(ctx context.Context, keycloakClient *keycloakAp
 		}
 
 		log.Info("Resource created", resourceLogKey, keycloakClient.Spec.Authorization.Resources[i].Name)
+		events.Normal(ctx, keycloakClient, events.ReasonCreated, "Authorization resource %s created", keycloakClient.Spec.Authorization.Resources[i].Name)
 	}
 
 	if keycloakClient.Spec.ReconciliationStrategy != keycloakApi.ReconciliationStrategyAddOnly {
@@ -101,6 +123,49 @@ func (h *ProcessResources) Serve(ctx context.Context, keycloakClient *keycloakAp
 	return nil
 }
 
+// isResourceUpToDate returns true if the live resource already matches the desired representation.
+// The resource list doesn't contain all fields of the resource, so the resource is read by ID.
+// Scopes are compared by ID.
+func (h *ProcessResources) isResourceUpToDate(
+	ctx context.Context,
+	realmName, clientUUID, resourceID string,
+	desired keycloakapi.ResourceRepresentation,
+) (bool, error) {
+	live, _, err := h.kClient.Authorization.GetResource(ctx, realmName, clientUUID, resourceID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get resource: %w", err)
+	}
+
+	if live == nil {
+		return false, nil
+	}
+
+	desired.Scopes = scopeIDs(desired.Scopes)
+	live.Scopes = scopeIDs(live.Scopes)
+
+	diffs, err := drift.Diff(desired, live)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare resource: %w", err)
+	}
+
+	return len(diffs) == 0, nil
+}
+
+// scopeIDs returns scopes that hold only their IDs.
+func scopeIDs(scopes *[]keycloakapi.ScopeRepresentation) *[]keycloakapi.ScopeRepresentation {
+	if scopes == nil {
+		return nil
+	}
+
+	ids := make([]keycloakapi.ScopeRepresentation, 0, len(*scopes))
+
+	for _, s := range *scopes {
+		ids = append(ids, keycloakapi.ScopeRepresentation{Id: s.Id})
+	}
+
+	return &ids
+}
+
 func (h *ProcessResources) setFailureCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -89,6 +89,11 @@ func TestProcessResources_Serve(t *testing.T) {
 						return r.Name != nil && *r.Name == resourceName
 					})).
 					Return((*keycloakapi.ResourceRepresentation)(nil), (*keycloakapi.Response)(nil), nil)
+				authzMock.On("GetResource", mock.Anything, "master", "clientID", "resource-resource2-id").
+					Return(&keycloakapi.ResourceRepresentation{
+						UnderscoreId: ptr.To("resource-resource2-id"),
+						Name:         ptr.To("resource-2"),
+					}, (*keycloakapi.Response)(nil), nil)
 				authzMock.On("UpdateResource", mock.Anything, "master", "clientID", "resource-resource2-id",
 					mock.MatchedBy(func(r keycloakapi.ResourceRepresentation) bool {
 						return r.Name != nil && *r.Name == "resource-2"
@@ -148,6 +153,11 @@ func TestProcessResources_Serve(t *testing.T) {
 						return r.Name != nil && *r.Name == resourceName
 					})).
 					Return((*keycloakapi.ResourceRepresentation)(nil), (*keycloakapi.Response)(nil), nil)
+				authzMock.On("GetResource", mock.Anything, "master", "clientID", "resource-resource2-id").
+					Return(&keycloakapi.ResourceRepresentation{
+						UnderscoreId: ptr.To("resource-resource2-id"),
+						Name:         ptr.To("resource-2"),
+					}, (*keycloakapi.Response)(nil), nil)
 				authzMock.On("UpdateResource", mock.Anything, "master", "clientID", "resource-resource2-id",
 					mock.MatchedBy(func(r keycloakapi.ResourceRepresentation) bool {
 						return r.Name != nil && *r.Name == "resource-2"
@@ -158,6 +168,50 @@ func TestProcessResources_Serve(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "resource is up to date",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "client",
+					Authorization: &keycloakApi.Authorization{
+						Resources: []keycloakApi.Resource{
+							{
+								Name:        resourceName,
+								DisplayName: "Resource 1",
+								Type:        "resource",
+								URIs:        []string{"https://example.com"},
+								Scopes:      []string{"scope1"},
+							},
+						},
+					},
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+				authzMock := keycloakapiMocks.NewMockAuthorizationClient(t)
+
+				authzMock.On("GetResources", mock.Anything, "master", "clientID").
+					Return([]keycloakapi.ResourceRepresentation{
+						{UnderscoreId: ptr.To(resourceName + "-id"), Name: ptr.To(resourceName)},
+					}, (*keycloakapi.Response)(nil), nil)
+				authzMock.On("GetScopes", mock.Anything, "master", "clientID").
+					Return([]keycloakapi.ScopeRepresentation{
+						{Id: ptr.To("scope1-id"), Name: ptr.To("scope1"), DisplayName: ptr.To("Scope 1")},
+					}, (*keycloakapi.Response)(nil), nil)
+				authzMock.On("GetResource", mock.Anything, "master", "clientID", resourceName+"-id").
+					Return(&keycloakapi.ResourceRepresentation{
+						UnderscoreId: ptr.To(resourceName + "-id"),
+						Name:         ptr.To(resourceName),
+						DisplayName:  ptr.To("Resource 1"),
+						Type:         ptr.To("resource"),
+						Uris:         &[]string{"https://example.com"},
+						Scopes:       &[]keycloakapi.ScopeRepresentation{{Id: ptr.To("scope1-id"), Name: ptr.To("scope1")}},
+					}, (*keycloakapi.Response)(nil), nil)
+
+				return &keycloakapi.KeycloakClient{Clients: clientsMock, Authorization: authzMock}
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "failed to delete resource",
 			keycloakClient: &keycloakApi.KeycloakClient{
@@ -218,6 +272,11 @@ func TestProcessResources_Serve(t *testing.T) {
 					Return([]keycloakapi.ResourceRepresentation{
 						{UnderscoreId: ptr.To(resourceName + "-id"), Name: ptr.To(resourceName)},
 					}, (*keycloakapi.Response)(nil), nil)
+				authzMock.On("GetResource", mock.Anything, "master", "clientID", resourceName+"-id").
+					Return(&keycloakapi.ResourceRepresentation{
+						UnderscoreId: ptr.To(resourceName + "-id"),
+						Name:         ptr.To(resourceName),
+					}, (*keycloakapi.Response)(nil), nil)
 				authzMock.On("UpdateResource", mock.Anything, "master", "clientID", resourceName+"-id",
 					mock.MatchedBy(func(r keycloakapi.ResourceRepresentation) bool {
 						return r.Name != nil && *r.Name == resourceName
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/maputil"
 )
@@ -32,6 +33,11 @@ func (h *ProcessScope) Serve(ctx context.Context, keycloakClient *keycloakApi.Ke
 		return nil
 	}
 
+	if isAuthorizationImported(keycloakClient) {
+		log.Info("Authorization settings are imported from ConfigMap")
+		return nil
+	}
+
 	clientUUID := clientCtx.ClientUUID
 
 	scopesList, _, err := h.kClient.Authorization.GetScopes(ctx, realmName, clientUUID)
@@ -64,6 +70,7 @@ func (h *ProcessScope) Serve(ctx context.Context, keycloakClient *keycloakApi.Ke
 		}
 
 		log.Info("Scope created", scopeLogKey, scope)
+		events.Normal(ctx, keycloakClient, events.ReasonCreated, "Authorization scope %s created", scope)
 
 		delete(existingScopes, scope)
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"maps"
 	"strconv"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,7 +18,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	"github.com/epam/edp-keycloak-operator/pkg/maputil"
 	"github.com/epam/edp-keycloak-operator/pkg/secretref"
 )
@@ -38,14 +42,15 @@ type PutClient struct {
 	kClient   *keycloakapi.KeycloakClient
 	k8sClient client.Client
 	secretRef secretRef
+	now       func() time.Time
 }
 
 func NewPutClient(kClient *keycloakapi.KeycloakClient, k8sClient client.Client, secretRef secretRef) *PutClient {
-	return &PutClient{kClient: kClient, k8sClient: k8sClient, secretRef: secretRef}
+	return &PutClient{kClient: kClient, k8sClient: k8sClient, secretRef: secretRef, now: time.Now}
 }
 
 func (h *PutClient) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string, clientCtx *ClientContext) error {
-	id, err := h.putKeycloakClient(ctx, keycloakClient, realmName)
+	id, err := h.putKeycloakClient(ctx, keycloakClient, realmName, clientCtx)
 	if err != nil {
 		h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync client: %s", err.Error()))
 
@@ -88,20 +93,18 @@ func (h *PutClient) setSuccessCondition(ctx context.Context, keycloakClient *key
 	}
 }
 
-func (h *PutClient) putKeycloakClient(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string) (string, error) {
+func (h *PutClient) putKeycloakClient(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	realmName string,
+	clientCtx *ClientContext,
+) (string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start creation of Keycloak client")
 
-	var (
-		authFlowOverrides map[string]string
-		err               error
-	)
-
-	if keycloakClient.Spec.AuthenticationFlowBindingOverrides != nil {
-		authFlowOverrides, err = h.getAuthFlows(ctx, keycloakClient, realmName)
-		if err != nil {
-			return "", fmt.Errorf("unable to get auth flows: %w", err)
-		}
+	authFlowOverrides, err := getAuthFlowOverrides(ctx, h.kClient, keycloakClient, realmName)
+	if err != nil {
+		return "", fmt.Errorf("unable to get auth flows: %w", err)
 	}
 
 	clientSecret, err := h.getClientSecret(ctx, keycloakClient)
@@ -111,6 +114,14 @@ func (h *PutClient) putKeycloakClient(ctx context.Context, keycloakClient *keycl
 
 	clientRep := convertSpecToClientRepresentation(&keycloakClient.Spec, clientSecret, authFlowOverrides)
 
+	if err = h.applyClientAuthentication(ctx, keycloakClient, &clientRep); err != nil {
+		return "", fmt.Errorf("unable to configure client authentication: %w", err)
+	}
+
+	if err = h.applySAML(ctx, keycloakClient, realmName, &clientRep); err != nil {
+		return "", fmt.Errorf("unable to configure saml client: %w", err)
+	}
+
 	existingClient, _, err := h.kClient.Clients.GetClientByClientID(ctx, realmName, keycloakClient.Spec.ClientId)
 	if err != nil && !keycloakapi.IsNotFound(err) {
 		return "", fmt.Errorf("unable to check client id: %w", err)
@@ -120,19 +131,48 @@ func (h *PutClient) putKeycloakClient(ctx context.Context, keycloakClient *keycl
 		log.Info("Client already exists")
 
 		clientUUID := *existingClient.Id
+
+		if err = h.rotateSecret(ctx, keycloakClient, realmName, clientUUID, &clientRep); err != nil {
+			return "", fmt.Errorf("unable to rotate client secret: %w", err)
+		}
+
+		if clientRep.Secret != nil {
+			clientCtx.ClientSecret = *clientRep.Secret
+		}
+
+		upToDate, err := h.isClientUpToDate(ctx, realmName, clientRep, existingClient)
+		if err != nil {
+			return "", err
+		}
+
+		metrics.RecordWrite(keycloakApi.KeycloakClientKind, !upToDate)
+
+		if upToDate {
+			log.Info("Client is up to date, skipping update")
+
+			return clientUUID, nil
+		}
+
 		if _, updErr := h.kClient.Clients.UpdateClient(ctx, realmName, clientUUID, clientRep); updErr != nil {
 			return "", fmt.Errorf("unable to update keycloak client: %w", updErr)
 		}
 
+		events.Normal(ctx, keycloakClient, events.ReasonUpdated, "Client %s updated", keycloakClient.Spec.ClientId)
+
 		return clientUUID, nil
 	}
 
+	if clientRep.Secret != nil {
+		clientCtx.ClientSecret = *clientRep.Secret
+	}
+
 	resp, err := h.kClient.Clients.CreateClient(ctx, realmName, clientRep)
 	if err != nil {
 		return "", fmt.Errorf("unable to create client: %w", err)
 	}
 
 	log.Info("End put keycloak client")
+	events.Normal(ctx, keycloakClient, events.ReasonCreated, "Client %s created", keycloakClient.Spec.ClientId)
 
 	id := keycloakapi.GetResourceIDFromResponse(resp)
 	if id == "" {
@@ -152,14 +192,53 @@ func (h *PutClient) putKeycloakClient(ctx context.Context, keycloakClient *keycl
 	return id, nil
 }
 
+// isClientUpToDate returns true if the live client already has all fields set in the desired representation.
+// Keycloak doesn't reset fields omitted from the update, so fields not managed by the operator are ignored.
+// The client secret is not returned in the client representation, so it is read separately.
+func (h *PutClient) isClientUpToDate(
+	ctx context.Context,
+	realmName string,
+	desired keycloakapi.ClientRepresentation,
+	live *keycloakapi.ClientRepresentation,
+) (bool, error) {
+	desiredSecret := desired.Secret
+	desired.Secret = nil
+
+	diffs, err := drift.Diff(desired, live)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare keycloak client: %w", err)
+	}
+
+	if len(diffs) > 0 {
+		return false, nil
+	}
+
+	if desiredSecret == nil || *desiredSecret == "" {
+		return true, nil
+	}
+
+	secret, _, err := h.kClient.Clients.GetClientSecret(ctx, realmName, *live.Id)
+	if err != nil {
+		return false, fmt.Errorf("unable to get keycloak client secret: %w", err)
+	}
+
+	return secret != nil && secret.Value != nil && *secret.Value == *desiredSecret, nil
+}
+
 func (h *PutClient) getClientSecret(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient) (string, error) {
-	if keycloakClient.Spec.Public {
+	if !hasClientSecret(&keycloakClient.Spec) {
 		return "", nil
 	}
 
 	return h.getSecret(ctx, keycloakClient)
 }
 
+// hasClientSecret returns true if the client authenticates with a client secret.
+// Public clients and SAML clients don't use client secrets.
+func hasClientSecret(spec *keycloakApi.KeycloakClientSpec) bool {
+	return !spec.Public && (spec.Protocol == nil || *spec.Protocol != keycloakapi.ProtocolSAML)
+}
+
 func (h *PutClient) getSecret(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient) (string, error) {
 	if keycloakClient.Spec.Secret != "" {
 		// We need to set secret in a new format for old clients for backward compatibility.
@@ -215,9 +294,7 @@ func (h *PutClient) generateSecret(ctx context.Context, keycloakClient *keycloak
 		}
 	}
 
-	keycloakClient.Spec.Secret = secretref.GenerateSecretRef(clientSecret.Name, keycloakApi.ClientSecretKey)
-
-	if err := h.k8sClient.Update(ctx, keycloakClient); err != nil {
+	if err := h.updateSecret(ctx, keycloakClient, secretref.GenerateSecretRef(clientSecret.Name, keycloakApi.ClientSecretKey)); err != nil {
 		return "", fmt.Errorf("unable to update client with new secret: %s, err: %w", clientSecret.Name, err)
 	}
 
@@ -226,19 +303,45 @@ func (h *PutClient) generateSecret(ctx context.Context, keycloakClient *keycloak
 
 func (h *PutClient) setSecretRef(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient) error {
 	ref := secretref.GenerateSecretRef(keycloakClient.Spec.Secret, keycloakApi.ClientSecretKey)
-	keycloakClient.Spec.Secret = ref
 
-	if err := h.k8sClient.Update(ctx, keycloakClient); err != nil {
+	if err := h.updateSecret(ctx, keycloakClient, ref); err != nil {
 		return fmt.Errorf("unable to update client with secret ref %s: %w", ref, err)
 	}
 
 	return nil
 }
 
-func (h *PutClient) getAuthFlows(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string) (map[string]string, error) {
+// updateSecret stores the secret reference in the resource.
+// Only the secret is patched, so the values merged from the client template are not stored in the resource.
+func (h *PutClient) updateSecret(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, secret string) error {
+	patch := client.MergeFrom(keycloakClient.DeepCopy())
+
+	keycloakClient.Spec.Secret = secret
+	spec := keycloakClient.Spec.DeepCopy()
+
+	if err := h.k8sClient.Patch(ctx, keycloakClient, patch); err != nil {
+		return err
+	}
+
+	keycloakClient.Spec = *spec
+
+	return nil
+}
+
+// getAuthFlowOverrides returns the ids of the realm authentication flows referenced by the client
+// authentication flow binding overrides. It returns nil if the client doesn't override authentication flows.
+func getAuthFlowOverrides(
+	ctx context.Context,
+	kClient *keycloakapi.KeycloakClient,
+	keycloakClient *keycloakApi.KeycloakClient,
+	realmName string,
+) (map[string]string, error) {
 	clientAuthFlows := keycloakClient.Spec.AuthenticationFlowBindingOverrides
+	if clientAuthFlows == nil {
+		return nil, nil
+	}
 
-	flows, _, err := h.kClient.Realms.GetAuthenticationFlows(ctx, realmName)
+	flows, _, err := kClient.Realms.GetAuthenticationFlows(ctx, realmName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get realm: %w", err)
 	}
@@ -313,6 +416,10 @@ func convertSpecToClientRepresentation(
 		ClientAuthenticatorType:      &spec.ClientAuthenticatorType,
 	}
 
+	if spec.ClientAuthentication != nil {
+		cr.ClientAuthenticatorType = &spec.ClientAuthentication.Type
+	}
+
 	if protocol != "" {
 		cr.Protocol = &protocol
 	}
@@ -344,6 +344,68 @@ func TestPutClient_Serve(t *testing.T) {
 				Reason: ReasonClientUpdated,
 			},
 		},
+		{
+			name: "create saml client without secret",
+			fields: fields{
+				client: func(t *testing.T) client.Client {
+					s := runtime.NewScheme()
+					require.NoError(t, keycloakApi.AddToScheme(s))
+					require.NoError(t, corev1.AddToScheme(s))
+
+					return fake.NewClientBuilder().
+						WithScheme(s).
+						WithStatusSubresource(&keycloakApi.KeycloakClient{}).
+						WithObjects(
+							&keycloakApi.KeycloakClient{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      "test-client",
+									Namespace: "default",
+								},
+								Spec: keycloakApi.KeycloakClientSpec{
+									ClientId: "test-client-id",
+									Protocol: ptr.To(keycloakapi.ProtocolSAML),
+								},
+							},
+						).
+						Build()
+				},
+				secretRef: func(t *testing.T) secretRef {
+					return mocks.NewMockRefClient(t)
+				},
+			},
+			args: args{
+				keycloakClient: client.ObjectKey{
+					Name:      "test-client",
+					Namespace: "default",
+				},
+				kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+					clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+
+					clientsMock.On("GetClientByClientID", testifymock.Anything, "realm", "test-client-id").
+						Return((*keycloakapi.ClientRepresentation)(nil), (*keycloakapi.Response)(nil), keycloakapi.ErrNotFound).
+						Once()
+
+					clientsMock.On("CreateClient", testifymock.Anything, "realm", testifymock.MatchedBy(
+						func(rep keycloakapi.ClientRepresentation) bool {
+							return rep.Secret == nil || *rep.Secret == ""
+						},
+					)).
+						Return(&keycloakapi.Response{
+							HTTPResponse: &http.Response{
+								Header: http.Header{"Location": []string{"http://host/admin/realms/realm/clients/123"}},
+							},
+						}, nil)
+
+					return &keycloakapi.KeycloakClient{Clients: clientsMock}
+				},
+			},
+			wantErr: require.NoError,
+			wantCondition: &metav1.Condition{
+				Type:   ConditionClientSynced,
+				Status: metav1.ConditionTrue,
+				Reason: ReasonClientUpdated,
+			},
+		},
 		{
 			name: "create client with auth flows",
 			fields: fields{
@@ -973,7 +1035,7 @@ func TestPutClient_Serve(t *testing.T) {
 			},
 		},
 		{
-			name: "error when setSecretRef k8s Update fails",
+			name: "error when setSecretRef k8s Patch fails",
 			fields: fields{
 				client: func(t *testing.T) client.Client {
 					s := runtime.NewScheme()
@@ -996,7 +1058,7 @@ func TestPutClient_Serve(t *testing.T) {
 							},
 						).
 						WithInterceptorFuncs(interceptor.Funcs{
-							Update: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.UpdateOption) error {
+							Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
 								return errors.New("update conflict")
 							},
 						}).
@@ -1070,7 +1132,7 @@ func TestPutClient_Serve(t *testing.T) {
 			wantCondition: nil,
 		},
 		{
-			name: "error when k8s Update fails in generateSecret",
+			name: "error when k8s Patch fails in generateSecret",
 			fields: fields{
 				client: func(t *testing.T) client.Client {
 					s := runtime.NewScheme()
@@ -1092,7 +1154,7 @@ func TestPutClient_Serve(t *testing.T) {
 							},
 						).
 						WithInterceptorFuncs(interceptor.Funcs{
-							Update: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.UpdateOption) error {
+							Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
 								return errors.New("update failed")
 							},
 						}).
@@ -1246,6 +1308,17 @@ func TestConvertSpecToClientRepresentation(t *testing.T) {
 				require.Equal(t, "val", (*cr.Attributes)["other"])
 			},
 		},
+		{
+			name: "ClientAuthentication overrides ClientAuthenticatorType",
+			spec: keycloakApi.KeycloakClientSpec{
+				ClientId:                "c",
+				ClientAuthenticatorType: keycloakApi.ClientAuthenticatorSecret,
+				ClientAuthentication:    &keycloakApi.ClientAuthentication{Type: keycloakApi.ClientAuthenticatorX509},
+			},
+			check: func(t *testing.T, cr keycloakapi.ClientRepresentation) {
+				require.Equal(t, keycloakApi.ClientAuthenticatorX509, *cr.ClientAuthenticatorType)
+			},
+		},
 		{
 			name: "with AdminUrl",
 			spec: keycloakApi.KeycloakClientSpec{ClientId: "c", AdminUrl: "https://admin.example.com"},
@@ -1279,3 +1352,98 @@ func TestConvertSpecToClientRepresentation(t *testing.T) {
 		})
 	}
 }
+
+func TestPutClient_Serve_SkipsUpdateOfUpToDateClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      keycloakApi.KeycloakClientSpec
+		secretRef func(t *testing.T) secretRef
+		setupMock func(m *keycloakapiMocks.MockClientsClient)
+	}{
+		{
+			name: "public client up to date",
+			spec: keycloakApi.KeycloakClientSpec{
+				ClientId: "test-client-id",
+				Public:   true,
+			},
+			secretRef: func(t *testing.T) secretRef {
+				return mocks.NewMockRefClient(t)
+			},
+			setupMock: func(_ *keycloakapiMocks.MockClientsClient) {},
+		},
+		{
+			name: "confidential client with the same secret",
+			spec: keycloakApi.KeycloakClientSpec{
+				ClientId: "test-client-id",
+				Secret:   secretref.GenerateSecretRef("client-secret", "secret"),
+			},
+			secretRef: func(t *testing.T) secretRef {
+				m := mocks.NewMockRefClient(t)
+				m.On("GetSecretFromRef", testifymock.Anything, testifymock.Anything, "default").
+					Return("client-secret", nil)
+
+				return m
+			},
+			setupMock: func(m *keycloakapiMocks.MockClientsClient) {
+				m.On("GetClientSecret", testifymock.Anything, "realm", "123").
+					Return(&keycloakapi.CredentialRepresentation{Value: ptr.To("client-secret")}, (*keycloakapi.Response)(nil), nil)
+			},
+		},
+		{
+			name: "confidential client with a different secret is updated",
+			spec: keycloakApi.KeycloakClientSpec{
+				ClientId: "test-client-id",
+				Secret:   secretref.GenerateSecretRef("client-secret", "secret"),
+			},
+			secretRef: func(t *testing.T) secretRef {
+				m := mocks.NewMockRefClient(t)
+				m.On("GetSecretFromRef", testifymock.Anything, testifymock.Anything, "default").
+					Return("new-secret", nil)
+
+				return m
+			},
+			setupMock: func(m *keycloakapiMocks.MockClientsClient) {
+				m.On("GetClientSecret", testifymock.Anything, "realm", "123").
+					Return(&keycloakapi.CredentialRepresentation{Value: ptr.To("old-secret")}, (*keycloakapi.Response)(nil), nil)
+				m.On("UpdateClient", testifymock.Anything, "realm", "123",
+					testifymock.MatchedBy(func(rep keycloakapi.ClientRepresentation) bool {
+						return rep.Secret != nil && *rep.Secret == "new-secret"
+					})).
+					Return((*keycloakapi.Response)(nil), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+
+			cl := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(s).
+				WithStatusSubresource(&keycloakApi.KeycloakClient{}).
+				WithObjects(cl).
+				Build()
+
+			live := convertSpecToClientRepresentation(&tt.spec, "", nil)
+			live.Id = ptr.To("123")
+
+			clientsMock := keycloakapiMocks.NewMockClientsClient(t)
+			clientsMock.On("GetClientByClientID", testifymock.Anything, "realm", "test-client-id").
+				Return(&live, (*keycloakapi.Response)(nil), nil)
+			tt.setupMock(clientsMock)
+
+			el := NewPutClient(&keycloakapi.KeycloakClient{Clients: clientsMock}, k8sClient, tt.secretRef(t))
+			clientCtx := &ClientContext{}
+
+			err := el.Serve(ctrl.LoggerInto(context.Background(), logr.Discard()), cl, "realm", clientCtx)
+			require.NoError(t, err)
+			require.Equal(t, "123", clientCtx.ClientUUID)
+		})
+	}
+}
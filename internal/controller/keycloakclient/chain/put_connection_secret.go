@@ -0,0 +1,198 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+// realmFrontendURLAttribute is the realm attribute with the frontend URL of the realm.
+const realmFrontendURLAttribute = "frontendUrl"
+
+// ConnectionDetails are the OIDC connection details of the client stored in the connection Secret.
+// The fields can be used in the connection Secret templates.
+type ConnectionDetails struct {
+	ClientID         string
+	ClientSecret     string
+	Realm            string
+	IssuerURL        string
+	DiscoveryURL     string
+	AuthorizationURL string
+	TokenURL         string
+	UserinfoURL      string
+	EndSessionURL    string
+	JWKSURL          string
+}
+
+// PutConnectionSecret writes the OIDC connection details of the client to the Secret.
+type PutConnectionSecret struct {
+	kClient   *keycloakapi.KeycloakClient
+	k8sClient client.Client
+}
+
+func NewPutConnectionSecret(kClient *keycloakapi.KeycloakClient, k8sClient client.Client) *PutConnectionSecret {
+	return &PutConnectionSecret{kClient: kClient, k8sClient: k8sClient}
+}
+
+func (h *PutConnectionSecret) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string, clientCtx *ClientContext) error {
+	if keycloakClient.Spec.ConnectionSecret == nil {
+		return nil
+	}
+
+	if err := h.putConnectionSecret(ctx, keycloakClient, realmName, clientCtx.ClientSecret); err != nil {
+		h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync connection secret: %s", err.Error()))
+
+		return fmt.Errorf("unable to put connection secret: %w", err)
+	}
+
+	h.setSuccessCondition(ctx, keycloakClient, "Connection secret synchronized")
+
+	return nil
+}
+
+func (h *PutConnectionSecret) setFailureCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := SetCondition(
+		ctx, h.k8sClient, keycloakClient,
+		ConditionConnectionSecretSynced,
+		metav1.ConditionFalse,
+		ReasonSecretError,
+		message,
+	); err != nil {
+		log.Error(err, "Failed to set failure condition")
+	}
+}
+
+func (h *PutConnectionSecret) setSuccessCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := SetCondition(
+		ctx, h.k8sClient, keycloakClient,
+		ConditionConnectionSecretSynced,
+		metav1.ConditionTrue,
+		ReasonConnectionSecretSynced,
+		message,
+	); err != nil {
+		log.Error(err, "Failed to set success condition")
+	}
+}
+
+func (h *PutConnectionSecret) putConnectionSecret(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	realmName, clientSecret string,
+) error {
+	realm, _, err := h.kClient.Realms.GetRealm(ctx, realmName)
+	if err != nil {
+		return fmt.Errorf("unable to get realm: %w", err)
+	}
+
+	baseURL := h.kClient.AuthURL()
+	if realm.Attributes != nil && (*realm.Attributes)[realmFrontendURLAttribute] != "" {
+		baseURL = (*realm.Attributes)[realmFrontendURLAttribute]
+	}
+
+	details := MakeConnectionDetails(baseURL, realmName, keycloakClient.Spec.ClientId, clientSecret)
+
+	data, err := connectionSecretData(details, keycloakClient.Spec.ConnectionSecret.Template)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      keycloakClient.Spec.ConnectionSecret.Name,
+			Namespace: keycloakClient.Namespace,
+		},
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, h.k8sClient, secret, func() error {
+		if secret.CreationTimestamp.IsZero() {
+			secret.Type = corev1.SecretTypeOpaque
+		}
+
+		secret.Data = data
+
+		return controllerutil.SetControllerReference(keycloakClient, secret, h.k8sClient.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("unable to store connection details in secret %s: %w", secret.Name, err)
+	}
+
+	ctrl.LoggerFrom(ctx).Info("Connection secret synchronized", "secret", secret.Name, "result", result)
+
+	return nil
+}
+
+// MakeConnectionDetails returns the OIDC connection details of the client in the realm.
+// The baseURL is the Keycloak URL or the frontend URL of the realm.
+func MakeConnectionDetails(baseURL, realmName, clientID, clientSecret string) ConnectionDetails {
+	issuer := fmt.Sprintf("%s/realms/%s", strings.TrimSuffix(baseURL, "/"), realmName)
+	oidc := issuer + "/protocol/openid-connect"
+
+	return ConnectionDetails{
+		ClientID:         clientID,
+		ClientSecret:     clientSecret,
+		Realm:            realmName,
+		IssuerURL:        issuer,
+		DiscoveryURL:     issuer + "/.well-known/openid-configuration",
+		AuthorizationURL: oidc + "/auth",
+		TokenURL:         oidc + "/token",
+		UserinfoURL:      oidc + "/userinfo",
+		EndSessionURL:    oidc + "/logout",
+		JWKSURL:          oidc + "/certs",
+	}
+}
+
+// connectionSecretData returns the Secret data with the default keys and the keys rendered from the templates.
+func connectionSecretData(details ConnectionDetails, templates map[string]string) (map[string][]byte, error) {
+	values := map[string]string{
+		"clientId":         details.ClientID,
+		"clientSecret":     details.ClientSecret,
+		"issuerUrl":        details.IssuerURL,
+		"discoveryUrl":     details.DiscoveryURL,
+		"authorizationUrl": details.AuthorizationURL,
+		"tokenUrl":         details.TokenURL,
+		"userinfoUrl":      details.UserinfoURL,
+		"endSessionUrl":    details.EndSessionURL,
+		"jwksUrl":          details.JWKSURL,
+	}
+
+	rendered := make(map[string]string, len(templates))
+
+	for key, text := range templates {
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse template of key %s: %w", key, err)
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, details); err != nil {
+			return nil, fmt.Errorf("unable to render template of key %s: %w", key, err)
+		}
+
+		rendered[key] = buf.String()
+	}
+
+	maps.Copy(values, rendered)
+
+	data := make(map[string][]byte, len(values))
+	for key, value := range values {
+		data[key] = []byte(value)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,161 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestPutConnectionSecret_Serve(t *testing.T) {
+	t.Parallel()
+
+	const secretName = "test-client-connection"
+
+	tests := []struct {
+		name             string
+		connectionSecret *keycloakApi.ConnectionSecret
+		realmClient      func(t *testing.T) keycloakapi.RealmClient
+		wantErr          require.ErrorAssertionFunc
+		wantData         map[string]string
+	}{
+		{
+			name: "connection secret is not configured",
+			realmClient: func(t *testing.T) keycloakapi.RealmClient {
+				return v2mocks.NewMockRealmClient(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:             "default keys with realm frontend url",
+			connectionSecret: &keycloakApi.ConnectionSecret{Name: secretName},
+			realmClient: func(t *testing.T) keycloakapi.RealmClient {
+				m := v2mocks.NewMockRealmClient(t)
+				m.On("GetRealm", mock.Anything, "realm").Return(&keycloakapi.RealmRepresentation{
+					Attributes: &map[string]string{realmFrontendURLAttribute: "https://sso.example.com/"},
+				}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantData: map[string]string{
+				"clientId":         "test-client-id",
+				"clientSecret":     "client-secret",
+				"issuerUrl":        "https://sso.example.com/realms/realm",
+				"discoveryUrl":     "https://sso.example.com/realms/realm/.well-known/openid-configuration",
+				"authorizationUrl": "https://sso.example.com/realms/realm/protocol/openid-connect/auth",
+				"tokenUrl":         "https://sso.example.com/realms/realm/protocol/openid-connect/token",
+				"userinfoUrl":      "https://sso.example.com/realms/realm/protocol/openid-connect/userinfo",
+				"endSessionUrl":    "https://sso.example.com/realms/realm/protocol/openid-connect/logout",
+				"jwksUrl":          "https://sso.example.com/realms/realm/protocol/openid-connect/certs",
+			},
+		},
+		{
+			name: "template keys",
+			connectionSecret: &keycloakApi.ConnectionSecret{
+				Name: secretName,
+				Template: map[string]string{
+					"GF_AUTH_GENERIC_OAUTH_CLIENT_ID": "{{ .ClientID }}",
+					"issuerUrl":                       "{{ .IssuerURL }}/",
+				},
+			},
+			realmClient: func(t *testing.T) keycloakapi.RealmClient {
+				m := v2mocks.NewMockRealmClient(t)
+				m.On("GetRealm", mock.Anything, "realm").Return(&keycloakapi.RealmRepresentation{
+					Attributes: &map[string]string{realmFrontendURLAttribute: "https://sso.example.com"},
+				}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantData: map[string]string{
+				"GF_AUTH_GENERIC_OAUTH_CLIENT_ID": "test-client-id",
+				"issuerUrl":                       "https://sso.example.com/realms/realm/",
+			},
+		},
+		{
+			name: "invalid template",
+			connectionSecret: &keycloakApi.ConnectionSecret{
+				Name:     secretName,
+				Template: map[string]string{"url": "{{ .Unknown }}"},
+			},
+			realmClient: func(t *testing.T) keycloakapi.RealmClient {
+				m := v2mocks.NewMockRealmClient(t)
+				m.On("GetRealm", mock.Anything, "realm").Return(&keycloakapi.RealmRepresentation{}, nil, nil)
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "unable to render template of key url")
+			},
+		},
+		{
+			name:             "failed to get realm",
+			connectionSecret: &keycloakApi.ConnectionSecret{Name: secretName},
+			realmClient: func(t *testing.T) keycloakapi.RealmClient {
+				m := v2mocks.NewMockRealmClient(t)
+				m.On("GetRealm", mock.Anything, "realm").Return(nil, nil, errors.New("realm error"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "unable to get realm")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default", UID: "client-uid"},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:         "test-client-id",
+					ConnectionSecret: tt.connectionSecret,
+				},
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(s).WithObjects(kc).WithStatusSubresource(kc).Build()
+
+			h := NewPutConnectionSecret(&keycloakapi.KeycloakClient{Realms: tt.realmClient(t)}, k8sClient)
+
+			err := h.Serve(context.Background(), kc, "realm", &ClientContext{ClientSecret: "client-secret"})
+			tt.wantErr(t, err)
+
+			secret := &corev1.Secret{}
+			getErr := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: secretName}, secret)
+
+			if tt.wantData == nil {
+				require.Error(t, getErr)
+
+				return
+			}
+
+			require.NoError(t, getErr)
+			assert.True(t, metav1.IsControlledBy(secret, kc))
+
+			for key, value := range tt.wantData {
+				assert.Equal(t, value, string(secret.Data[key]), key)
+			}
+		})
+	}
+}
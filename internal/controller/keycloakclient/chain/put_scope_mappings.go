@@ -0,0 +1,274 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/maputil"
+)
+
+// PutScopeMappings syncs the realm and client roles in the scope of the client.
+type PutScopeMappings struct {
+	kClient   *keycloakapi.KeycloakClient
+	k8sClient client.Client
+}
+
+func NewPutScopeMappings(kClient *keycloakapi.KeycloakClient, k8sClient client.Client) *PutScopeMappings {
+	return &PutScopeMappings{kClient: kClient, k8sClient: k8sClient}
+}
+
+func (h *PutScopeMappings) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string, clientCtx *ClientContext) error {
+	if keycloakClient.Spec.ScopeMappings == nil {
+		return nil
+	}
+
+	if err := h.putScopeMappings(ctx, keycloakClient, realmName, clientCtx.ClientUUID); err != nil {
+		h.setFailureCondition(ctx, keycloakClient, fmt.Sprintf("Failed to sync scope mappings: %s", err.Error()))
+
+		return fmt.Errorf("unable to put scope mappings: %w", err)
+	}
+
+	h.setSuccessCondition(ctx, keycloakClient, "Scope mappings synchronized")
+
+	return nil
+}
+
+func (h *PutScopeMappings) setFailureCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := SetCondition(
+		ctx, h.k8sClient, keycloakClient,
+		ConditionScopeMappingsSynced,
+		metav1.ConditionFalse,
+		ReasonKeycloakAPIError,
+		message,
+	); err != nil {
+		log.Error(err, "Failed to set failure condition")
+	}
+}
+
+func (h *PutScopeMappings) setSuccessCondition(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, message string) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := SetCondition(
+		ctx, h.k8sClient, keycloakClient,
+		ConditionScopeMappingsSynced,
+		metav1.ConditionTrue,
+		ReasonScopeMappingsSynced,
+		message,
+	); err != nil {
+		log.Error(err, "Failed to set success condition")
+	}
+}
+
+func (h *PutScopeMappings) putScopeMappings(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	realmName, clientUUID string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Start put scope mappings")
+
+	addOnly := keycloakClient.GetReconciliationStrategy() == keycloakApi.ReconciliationStrategyAddOnly
+
+	current, _, err := h.kClient.Clients.GetClientScopeMappings(ctx, realmName, clientUUID)
+	if err != nil {
+		return fmt.Errorf("unable to get client scope mappings: %w", err)
+	}
+
+	if current == nil {
+		current = &keycloakapi.MappingsRepresentation{}
+	}
+
+	if err := h.syncRealmScopeMappings(
+		ctx, realmName, clientUUID, current.RealmMappings, keycloakClient.Spec.ScopeMappings.RealmRoles, addOnly,
+	); err != nil {
+		return err
+	}
+
+	desiredClientRoles := make(map[string][]string, len(keycloakClient.Spec.ScopeMappings.ClientRoles))
+	for _, v := range keycloakClient.Spec.ScopeMappings.ClientRoles {
+		desiredClientRoles[v.ClientID] = append(desiredClientRoles[v.ClientID], v.Roles...)
+	}
+
+	currentClientMappings := make(map[string]keycloakapi.ClientMappingsRepresentation)
+	if current.ClientMappings != nil {
+		currentClientMappings = *current.ClientMappings
+	}
+
+	for roleClientID, roleNames := range desiredClientRoles {
+		if err := h.syncClientScopeMappings(
+			ctx, realmName, clientUUID, roleClientID, currentClientMappings[roleClientID], roleNames, addOnly,
+		); err != nil {
+			return err
+		}
+	}
+
+	if addOnly {
+		log.Info("End put scope mappings")
+
+		return nil
+	}
+
+	for roleClientID, mappings := range currentClientMappings {
+		if _, ok := desiredClientRoles[roleClientID]; ok || mappings.Id == nil || mappings.Mappings == nil {
+			continue
+		}
+
+		if _, err := h.kClient.Clients.DeleteClientRoleScopeMappings(ctx, realmName, clientUUID, *mappings.Id, *mappings.Mappings); err != nil {
+			return fmt.Errorf("unable to delete scope mappings of client %s roles: %w", roleClientID, err)
+		}
+	}
+
+	log.Info("End put scope mappings")
+
+	return nil
+}
+
+func (h *PutScopeMappings) syncRealmScopeMappings(
+	ctx context.Context,
+	realmName, clientUUID string,
+	currentRoles *[]keycloakapi.RoleRepresentation,
+	desiredRoleNames []string,
+	addOnly bool,
+) error {
+	var current []keycloakapi.RoleRepresentation
+	if currentRoles != nil {
+		current = *currentRoles
+	}
+
+	currentRoleMap := maputil.SliceToMapSelf(current, func(r keycloakapi.RoleRepresentation) (string, bool) {
+		return *r.Name, r.Name != nil
+	})
+
+	desiredSet := make(map[string]bool, len(desiredRoleNames))
+	for _, name := range desiredRoleNames {
+		desiredSet[name] = true
+	}
+
+	var toAdd []keycloakapi.RoleRepresentation
+
+	for _, roleName := range desiredRoleNames {
+		if _, exists := currentRoleMap[roleName]; exists {
+			continue
+		}
+
+		role, _, err := h.kClient.Roles.GetRealmRole(ctx, realmName, roleName)
+		if err != nil {
+			return fmt.Errorf("unable to get realm role %s: %w", roleName, err)
+		}
+
+		toAdd = append(toAdd, *role)
+	}
+
+	if len(toAdd) > 0 {
+		if _, err := h.kClient.Clients.AddClientRealmScopeMappings(ctx, realmName, clientUUID, toAdd); err != nil {
+			return fmt.Errorf("unable to add realm roles scope mappings: %w", err)
+		}
+	}
+
+	if addOnly {
+		return nil
+	}
+
+	var toRemove []keycloakapi.RoleRepresentation
+
+	for name, role := range currentRoleMap {
+		if !desiredSet[name] {
+			toRemove = append(toRemove, role)
+		}
+	}
+
+	if len(toRemove) > 0 {
+		if _, err := h.kClient.Clients.DeleteClientRealmScopeMappings(ctx, realmName, clientUUID, toRemove); err != nil {
+			return fmt.Errorf("unable to delete realm roles scope mappings: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (h *PutScopeMappings) syncClientScopeMappings(
+	ctx context.Context,
+	realmName, clientUUID, roleClientID string,
+	current keycloakapi.ClientMappingsRepresentation,
+	desiredRoleNames []string,
+	addOnly bool,
+) error {
+	roleClientUUID := ""
+	if current.Id != nil {
+		roleClientUUID = *current.Id
+	} else {
+		roleClient, _, err := h.kClient.Clients.GetClientByClientID(ctx, realmName, roleClientID)
+		if err != nil {
+			return fmt.Errorf("unable to get client %s: %w", roleClientID, err)
+		}
+
+		if roleClient == nil || roleClient.Id == nil {
+			return fmt.Errorf("client %s not found", roleClientID)
+		}
+
+		roleClientUUID = *roleClient.Id
+	}
+
+	var currentRoles []keycloakapi.RoleRepresentation
+	if current.Mappings != nil {
+		currentRoles = *current.Mappings
+	}
+
+	currentRoleMap := maputil.SliceToMapSelf(currentRoles, func(r keycloakapi.RoleRepresentation) (string, bool) {
+		return *r.Name, r.Name != nil
+	})
+
+	desiredSet := make(map[string]bool, len(desiredRoleNames))
+	for _, name := range desiredRoleNames {
+		desiredSet[name] = true
+	}
+
+	var toAdd []keycloakapi.RoleRepresentation
+
+	for _, roleName := range desiredRoleNames {
+		if _, exists := currentRoleMap[roleName]; exists {
+			continue
+		}
+
+		role, _, err := h.kClient.Clients.GetClientRole(ctx, realmName, roleClientUUID, roleName)
+		if err != nil {
+			return fmt.Errorf("unable to get client role %s/%s: %w", roleClientID, roleName, err)
+		}
+
+		toAdd = append(toAdd, *role)
+	}
+
+	if len(toAdd) > 0 {
+		if _, err := h.kClient.Clients.AddClientRoleScopeMappings(ctx, realmName, clientUUID, roleClientUUID, toAdd); err != nil {
+			return fmt.Errorf("unable to add scope mappings of client %s roles: %w", roleClientID, err)
+		}
+	}
+
+	if addOnly {
+		return nil
+	}
+
+	var toRemove []keycloakapi.RoleRepresentation
+
+	for name, role := range currentRoleMap {
+		if !desiredSet[name] {
+			toRemove = append(toRemove, role)
+		}
+	}
+
+	if len(toRemove) > 0 {
+		if _, err := h.kClient.Clients.DeleteClientRoleScopeMappings(ctx, realmName, clientUUID, roleClientUUID, toRemove); err != nil {
+			return fmt.Errorf("unable to delete scope mappings of client %s roles: %w", roleClientID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestPutScopeMappings_Serve(t *testing.T) {
+	t.Parallel()
+
+	const (
+		realmName  = "realm"
+		clientUUID = "client-uuid"
+	)
+
+	currentMappings := &keycloakapi.MappingsRepresentation{
+		RealmMappings: &[]keycloakapi.RoleRepresentation{
+			{Name: ptr.To("viewer")},
+			{Name: ptr.To("legacy")},
+		},
+		ClientMappings: &map[string]keycloakapi.ClientMappingsRepresentation{
+			"backend": {
+				Id:       ptr.To("backend-uuid"),
+				Mappings: &[]keycloakapi.RoleRepresentation{{Name: ptr.To("read")}, {Name: ptr.To("write")}},
+			},
+			"old": {
+				Id:       ptr.To("old-uuid"),
+				Mappings: &[]keycloakapi.RoleRepresentation{{Name: ptr.To("old-role")}},
+			},
+		},
+	}
+
+	scopeMappings := &keycloakApi.ScopeMappings{
+		RealmRoles: []string{"viewer", "editor"},
+		ClientRoles: []keycloakApi.UserClientRole{
+			{ClientID: "backend", Roles: []string{"read"}},
+			{ClientID: "api", Roles: []string{"call"}},
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		scopeMappings          *keycloakApi.ScopeMappings
+		reconciliationStrategy string
+		clientsClient          func(t *testing.T) *keycloakapiMocks.MockClientsClient
+		rolesClient            func(t *testing.T) *keycloakapiMocks.MockRolesClient
+		wantErr                require.ErrorAssertionFunc
+	}{
+		{
+			name: "scope mappings are not configured",
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				return keycloakapiMocks.NewMockClientsClient(t)
+			},
+			rolesClient: func(t *testing.T) *keycloakapiMocks.MockRolesClient {
+				return keycloakapiMocks.NewMockRolesClient(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:          "full sync",
+			scopeMappings: scopeMappings,
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				m := keycloakapiMocks.NewMockClientsClient(t)
+
+				m.On("GetClientScopeMappings", mock.Anything, realmName, clientUUID).Return(currentMappings, nil, nil)
+				m.On("AddClientRealmScopeMappings", mock.Anything, realmName, clientUUID,
+					[]keycloakapi.RoleRepresentation{{Name: ptr.To("editor")}}).Return(nil, nil)
+				m.On("DeleteClientRealmScopeMappings", mock.Anything, realmName, clientUUID,
+					[]keycloakapi.RoleRepresentation{{Name: ptr.To("legacy")}}).Return(nil, nil)
+				m.On("DeleteClientRoleScopeMappings", mock.Anything, realmName, clientUUID, "backend-uuid",
+					[]keycloakapi.RoleRepresentation{{Name: ptr.To("write")}}).Return(nil, nil)
+				m.On("GetClientByClientID", mock.Anything, realmName, "api").
+					Return(&keycloakapi.ClientRepresentation{Id: ptr.To("api-uuid")}, nil, nil)
+				m.On("GetClientRole", mock.Anything, realmName, "api-uuid", "call").
+					Return(&keycloakapi.RoleRepresentation{Name: ptr.To("call")}, nil, nil)
+				m.On("AddClientRoleScopeMappings", mock.Anything, realmName, clientUUID, "api-uuid",
+					[]keycloakapi.RoleRepresentation{{Name: ptr.To("call")}}).Return(nil, nil)
+				m.On("DeleteClientRoleScopeMappings", mock.Anything, realmName, clientUUID, "old-uuid",
+					[]keycloakapi.RoleRepresentation{{Name: ptr.To("old-role")}}).Return(nil, nil)
+
+				return m
+			},
+			rolesClient: func(t *testing.T) *keycloakapiMocks.MockRolesClient {
+				m := keycloakapiMocks.NewMockRolesClient(t)
+
+				m.On("GetRealmRole", mock.Anything, realmName, "editor").
+					Return(&keycloakapi.RoleRepresentation{Name: ptr.To("editor")}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:                   "add only sync",
+			scopeMappings:          scopeMappings,
+			reconciliationStrategy: keycloakApi.ReconciliationStrategyAddOnly,
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				m := keycloakapiMocks.NewMockClientsClient(t)
+
+				m.On("GetClientScopeMappings", mock.Anything, realmName, clientUUID).Return(currentMappings, nil, nil)
+				m.On("AddClientRealmScopeMappings", mock.Anything, realmName, clientUUID,
+					[]keycloakapi.RoleRepresentation{{Name: ptr.To("editor")}}).Return(nil, nil)
+				m.On("GetClientByClientID", mock.Anything, realmName, "api").
+					Return(&keycloakapi.ClientRepresentation{Id: ptr.To("api-uuid")}, nil, nil)
+				m.On("GetClientRole", mock.Anything, realmName, "api-uuid", "call").
+					Return(&keycloakapi.RoleRepresentation{Name: ptr.To("call")}, nil, nil)
+				m.On("AddClientRoleScopeMappings", mock.Anything, realmName, clientUUID, "api-uuid",
+					[]keycloakapi.RoleRepresentation{{Name: ptr.To("call")}}).Return(nil, nil)
+
+				return m
+			},
+			rolesClient: func(t *testing.T) *keycloakapiMocks.MockRolesClient {
+				m := keycloakapiMocks.NewMockRolesClient(t)
+
+				m.On("GetRealmRole", mock.Anything, realmName, "editor").
+					Return(&keycloakapi.RoleRepresentation{Name: ptr.To("editor")}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "client of roles not found",
+			scopeMappings: &keycloakApi.ScopeMappings{
+				ClientRoles: []keycloakApi.UserClientRole{{ClientID: "missing", Roles: []string{"role"}}},
+			},
+			reconciliationStrategy: keycloakApi.ReconciliationStrategyAddOnly,
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				m := keycloakapiMocks.NewMockClientsClient(t)
+
+				m.On("GetClientScopeMappings", mock.Anything, realmName, clientUUID).
+					Return(&keycloakapi.MappingsRepresentation{}, nil, nil)
+				m.On("GetClientByClientID", mock.Anything, realmName, "missing").Return(nil, nil, nil)
+
+				return m
+			},
+			rolesClient: func(t *testing.T) *keycloakapiMocks.MockRolesClient {
+				return keycloakapiMocks.NewMockRolesClient(t)
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "client missing not found")
+			},
+		},
+		{
+			name:          "failed to get scope mappings",
+			scopeMappings: scopeMappings,
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				m := keycloakapiMocks.NewMockClientsClient(t)
+
+				m.On("GetClientScopeMappings", mock.Anything, realmName, clientUUID).
+					Return(nil, nil, errors.New("api error"))
+
+				return m
+			},
+			rolesClient: func(t *testing.T) *keycloakapiMocks.MockRolesClient {
+				return keycloakapiMocks.NewMockRolesClient(t)
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to get client scope mappings")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:               "test-client-id",
+					ScopeMappings:          tt.scopeMappings,
+					ReconciliationStrategy: tt.reconciliationStrategy,
+				},
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(s).WithObjects(kc).WithStatusSubresource(kc).Build()
+
+			h := NewPutScopeMappings(&keycloakapi.KeycloakClient{
+				Clients: tt.clientsClient(t),
+				Roles:   tt.rolesClient(t),
+			}, k8sClient)
+
+			err := h.Serve(context.Background(), kc, realmName, &ClientContext{ClientUUID: clientUUID})
+			tt.wantErr(t, err)
+		})
+	}
+}
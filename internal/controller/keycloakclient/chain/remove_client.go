@@ -6,19 +6,23 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
-func NewRemoveClient(kc *keycloakapi.KeycloakClient) *RemoveClient {
+func NewRemoveClient(kc *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) *RemoveClient {
 	return &RemoveClient{
-		keycloakClient: kc.Clients,
+		keycloakClient:        kc.Clients,
+		defaultDeletionPolicy: defaultDeletionPolicy,
 	}
 }
 
 type RemoveClient struct {
-	keycloakClient keycloakapi.ClientsClient
+	keycloakClient        keycloakapi.ClientsClient
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
 func (h *RemoveClient) Serve(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient, realmName string) error {
@@ -26,7 +30,7 @@ func (h *RemoveClient) Serve(ctx context.Context, keycloakClient *keycloakApi.Ke
 
 	log.Info("Start deleting keycloak client")
 
-	if objectmeta.PreserveResourcesOnDeletion(keycloakClient) {
+	if objectmeta.PreserveResourcesOnDeletion(keycloakClient, h.defaultDeletionPolicy) {
 		log.Info("PreserveResourcesOnDeletion is enabled, skipping deletion.")
 
 		return nil
@@ -49,6 +53,7 @@ func (h *RemoveClient) Serve(ctx context.Context, keycloakClient *keycloakApi.Ke
 	}
 
 	log.Info("Keycloak client has been deleted")
+	events.Normal(ctx, keycloakClient, events.ReasonDeleted, "Client %s deleted", keycloakClient.Spec.ClientId)
 
 	return nil
 }
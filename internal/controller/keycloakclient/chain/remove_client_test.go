@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -47,6 +48,28 @@ func TestRemoveClient_Serve(t *testing.T) {
 			realmName: "test-realm",
 			wantErr:   require.NoError,
 		},
+		{
+			name: "retain deletion policy - skip",
+			keycloakClient: &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-client",
+					Namespace: "default",
+				},
+				Spec: keycloakApi.KeycloakClientSpec{
+					DeletionPolicy: common.DeletionPolicyRetain,
+				},
+				Status: keycloakApi.KeycloakClientStatus{
+					ClientID: "client-uuid",
+				},
+			},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				return &keycloakapi.KeycloakClient{
+					Clients: keycloakapiMocks.NewMockClientsClient(t),
+				}
+			},
+			realmName: "test-realm",
+			wantErr:   require.NoError,
+		},
 		{
 			name: "empty client ID in status - skip",
 			keycloakClient: &keycloakApi.KeycloakClient{
@@ -133,7 +156,7 @@ func TestRemoveClient_Serve(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewRemoveClient(tt.kClient(t))
+			h := NewRemoveClient(tt.kClient(t), common.DeletionPolicyDelete)
 			err := h.Serve(
 				ctrl.LoggerInto(context.Background(), logr.Discard()),
 				tt.keycloakClient,
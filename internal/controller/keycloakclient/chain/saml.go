@@ -0,0 +1,215 @@
+package chain
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+// Keycloak client attributes of the SAML client.
+const (
+	samlNameIDFormatAttribute              = "saml_name_id_format"
+	samlForceNameIDFormatAttribute         = "saml_force_name_id_format"
+	samlForcePostBindingAttribute          = "saml.force.post.binding"
+	samlACSPostURLAttribute                = "saml_assertion_consumer_url_post"
+	samlACSRedirectURLAttribute            = "saml_assertion_consumer_url_redirect"
+	samlSLOPostURLAttribute                = "saml_single_logout_service_url_post"
+	samlSLORedirectURLAttribute            = "saml_single_logout_service_url_redirect"
+	samlIDPInitiatedSSOURLNameAttribute    = "saml_idp_initiated_sso_url_name"
+	samlIDPInitiatedSSORelayStateAttribute = "saml_idp_initiated_sso_relay_state"
+	samlServerSignatureAttribute           = "saml.server.signature"
+	samlAssertionSignatureAttribute        = "saml.assertion.signature"
+	samlSignatureAlgorithmAttribute        = "saml.signature.algorithm"
+	samlClientSignatureAttribute           = "saml.client.signature"
+	samlSigningCertificateAttribute        = "saml.signing.certificate"
+	samlSigningPrivateKeyAttribute         = "saml.signing.private.key"
+	samlEncryptAttribute                   = "saml.encrypt"
+	samlEncryptionCertificateAttribute     = "saml.encryption.certificate"
+	samlEncryptionPrivateKeyAttribute      = "saml.encryption.private.key"
+)
+
+// applySAML sets the attributes of the SAML client in the client representation.
+// If the service provider metadata is referenced, the settings imported from it are used as defaults.
+// Certificates and private keys are read from the referenced kubernetes.io/tls Secrets.
+func (h *PutClient) applySAML(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	realmName string,
+	clientRep *keycloakapi.ClientRepresentation,
+) error {
+	saml := keycloakClient.Spec.SAML
+	if saml == nil {
+		return nil
+	}
+
+	if clientRep.Protocol == nil || *clientRep.Protocol != keycloakapi.ProtocolSAML {
+		return errors.New("saml settings can be configured only for saml client")
+	}
+
+	attributes := make(map[string]string)
+
+	if saml.Metadata != nil {
+		imported, err := h.importSAMLMetadata(ctx, keycloakClient, realmName)
+		if err != nil {
+			return err
+		}
+
+		if imported.Attributes != nil {
+			maps.Copy(attributes, *imported.Attributes)
+		}
+
+		if clientRep.RedirectUris == nil {
+			clientRep.RedirectUris = imported.RedirectUris
+		}
+	}
+
+	if clientRep.Attributes != nil {
+		maps.Copy(attributes, *clientRep.Attributes)
+	}
+
+	setAttributeIfNotEmpty(attributes, samlNameIDFormatAttribute, saml.NameIDFormat)
+	setAttributeIfNotEmpty(attributes, samlACSPostURLAttribute, saml.AssertionConsumerServicePostURL)
+	setAttributeIfNotEmpty(attributes, samlACSRedirectURLAttribute, saml.AssertionConsumerServiceRedirectURL)
+	setAttributeIfNotEmpty(attributes, samlSLOPostURLAttribute, saml.SingleLogoutServicePostURL)
+	setAttributeIfNotEmpty(attributes, samlSLORedirectURLAttribute, saml.SingleLogoutServiceRedirectURL)
+	setAttributeIfNotEmpty(attributes, samlIDPInitiatedSSOURLNameAttribute, saml.IDPInitiatedSSOURLName)
+	setAttributeIfNotEmpty(attributes, samlIDPInitiatedSSORelayStateAttribute, saml.IDPInitiatedSSORelayState)
+
+	attributes[samlForceNameIDFormatAttribute] = strconv.FormatBool(saml.ForceNameIDFormat)
+	attributes[samlForcePostBindingAttribute] = strconv.FormatBool(saml.ForcePostBinding)
+
+	if saml.Signing != nil {
+		attributes[samlServerSignatureAttribute] = strconv.FormatBool(saml.Signing.SignDocuments)
+		attributes[samlAssertionSignatureAttribute] = strconv.FormatBool(saml.Signing.SignAssertions)
+		attributes[samlClientSignatureAttribute] = strconv.FormatBool(saml.Signing.ClientSignatureRequired)
+		setAttributeIfNotEmpty(attributes, samlSignatureAlgorithmAttribute, saml.Signing.Algorithm)
+
+		if err := h.setSAMLKeyPair(
+			ctx, keycloakClient.Namespace, saml.Signing.KeyPair, attributes,
+			samlSigningCertificateAttribute, samlSigningPrivateKeyAttribute,
+		); err != nil {
+			return fmt.Errorf("unable to set signing key pair: %w", err)
+		}
+	}
+
+	if saml.Encryption != nil {
+		attributes[samlEncryptAttribute] = strconv.FormatBool(saml.Encryption.EncryptAssertions)
+
+		if err := h.setSAMLKeyPair(
+			ctx, keycloakClient.Namespace, saml.Encryption.KeyPair, attributes,
+			samlEncryptionCertificateAttribute, samlEncryptionPrivateKeyAttribute,
+		); err != nil {
+			return fmt.Errorf("unable to set encryption key pair: %w", err)
+		}
+	}
+
+	clientRep.Attributes = &attributes
+
+	return nil
+}
+
+func (h *PutClient) importSAMLMetadata(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	realmName string,
+) (*keycloakapi.ClientRepresentation, error) {
+	metadata, err := secretref.GetValueFromSourceRef(ctx, keycloakClient.Spec.SAML.Metadata, keycloakClient.Namespace, h.k8sClient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get saml metadata: %w", err)
+	}
+
+	if metadata == "" {
+		return nil, errors.New("saml metadata is empty")
+	}
+
+	imported, _, err := h.kClient.Clients.ConvertClientDescription(ctx, realmName, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import saml metadata: %w", err)
+	}
+
+	if imported == nil {
+		return &keycloakapi.ClientRepresentation{}, nil
+	}
+
+	return imported, nil
+}
+
+// setSAMLKeyPair sets the certificate and the private key from the kubernetes.io/tls Secret
+// in the format of the Keycloak client attributes.
+func (h *PutClient) setSAMLKeyPair(
+	ctx context.Context,
+	namespace string,
+	keyPair *keycloakApi.SAMLKeyPair,
+	attributes map[string]string,
+	certificateAttribute, privateKeyAttribute string,
+) error {
+	if keyPair == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := h.k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: keyPair.SecretName}, secret); err != nil {
+		return fmt.Errorf("unable to get secret %s: %w", keyPair.SecretName, err)
+	}
+
+	certPEM, ok := secret.Data[corev1.TLSCertKey]
+	if !ok {
+		return fmt.Errorf("secret %s does not contain key %s", keyPair.SecretName, corev1.TLSCertKey)
+	}
+
+	cert, err := encodeCertificate(string(certPEM))
+	if err != nil {
+		return err
+	}
+
+	attributes[certificateAttribute] = cert
+
+	keyPEM, ok := secret.Data[corev1.TLSPrivateKeyKey]
+	if !ok {
+		return nil
+	}
+
+	key, err := encodePrivateKey(string(keyPEM))
+	if err != nil {
+		return err
+	}
+
+	attributes[privateKeyAttribute] = key
+
+	return nil
+}
+
+// encodePrivateKey returns the base64-encoded DER private key from the PEM-encoded PKCS #1 or PKCS #8 private key
+// in the format of the Keycloak client private key attribute.
+func encodePrivateKey(keyPEM string) (string, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return "", errors.New("private key must be PEM-encoded")
+	}
+
+	if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+		if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return "", errors.New("private key must be a PKCS #1 or PKCS #8 private key")
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(block.Bytes), nil
+}
+
+func setAttributeIfNotEmpty(attributes map[string]string, name, value string) {
+	if value != "" {
+		attributes[name] = value
+	}
+}
@@ -0,0 +1,275 @@
+package chain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestPutClient_applySAML(t *testing.T) {
+	t.Parallel()
+
+	const realmName = "realm"
+
+	certDER := generateTestCertificate(t)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+
+	metadataRef := &common.SourceRef{
+		ConfigMapKeyRef: &common.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "sp-metadata"},
+			Key:                  "metadata.xml",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		saml           *keycloakApi.SAMLSettings
+		protocol       *string
+		redirectUris   *[]string
+		clientsClient  func(t *testing.T) *keycloakapiMocks.MockClientsClient
+		wantErr        require.ErrorAssertionFunc
+		wantAttributes map[string]string
+		wantRedirects  *[]string
+	}{
+		{
+			name:     "saml is not configured",
+			protocol: ptr.To(keycloakapi.ProtocolOpenIDConnect),
+			wantErr:  require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris": "+",
+			},
+		},
+		{
+			name: "saml settings with key pairs",
+			saml: &keycloakApi.SAMLSettings{
+				NameIDFormat:                    "email",
+				ForceNameIDFormat:               true,
+				AssertionConsumerServicePostURL: "https://app.example.com/saml/acs",
+				IDPInitiatedSSOURLName:          "app",
+				Signing: &keycloakApi.SAMLSigning{
+					SignDocuments: true,
+					Algorithm:     "RSA_SHA256",
+					KeyPair:       &keycloakApi.SAMLKeyPair{SecretName: "saml-signing"},
+				},
+				Encryption: &keycloakApi.SAMLEncryption{
+					EncryptAssertions: true,
+					KeyPair:           &keycloakApi.SAMLKeyPair{SecretName: "saml-encryption"},
+				},
+			},
+			wantErr: require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris":         "+",
+				samlNameIDFormatAttribute:           "email",
+				samlForceNameIDFormatAttribute:      "true",
+				samlForcePostBindingAttribute:       "false",
+				samlACSPostURLAttribute:             "https://app.example.com/saml/acs",
+				samlIDPInitiatedSSOURLNameAttribute: "app",
+				samlServerSignatureAttribute:        "true",
+				samlAssertionSignatureAttribute:     "false",
+				samlClientSignatureAttribute:        "false",
+				samlSignatureAlgorithmAttribute:     "RSA_SHA256",
+				samlSigningCertificateAttribute:     base64.StdEncoding.EncodeToString(certDER),
+				samlSigningPrivateKeyAttribute:      base64.StdEncoding.EncodeToString(keyDER),
+				samlEncryptAttribute:                "true",
+				samlEncryptionCertificateAttribute:  base64.StdEncoding.EncodeToString(certDER),
+			},
+		},
+		{
+			name: "settings from metadata are overridden by spec",
+			saml: &keycloakApi.SAMLSettings{
+				Metadata:                        metadataRef,
+				AssertionConsumerServicePostURL: "https://app.example.com/saml/acs",
+			},
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				m := keycloakapiMocks.NewMockClientsClient(t)
+
+				m.On("ConvertClientDescription", mock.Anything, realmName, "<EntityDescriptor/>").
+					Return(&keycloakapi.ClientRepresentation{
+						RedirectUris: &[]string{"https://app.example.com/*"},
+						Attributes: &map[string]string{
+							samlACSPostURLAttribute:      "https://old.example.com/saml/acs",
+							samlClientSignatureAttribute: "true",
+						},
+					}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris":    "+",
+				samlACSPostURLAttribute:        "https://app.example.com/saml/acs",
+				samlClientSignatureAttribute:   "true",
+				samlForceNameIDFormatAttribute: "false",
+				samlForcePostBindingAttribute:  "false",
+			},
+			wantRedirects: &[]string{"https://app.example.com/*"},
+		},
+		{
+			name:         "redirect uris from spec take precedence over metadata",
+			saml:         &keycloakApi.SAMLSettings{Metadata: metadataRef},
+			redirectUris: &[]string{"https://spec.example.com/*"},
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				m := keycloakapiMocks.NewMockClientsClient(t)
+
+				m.On("ConvertClientDescription", mock.Anything, realmName, "<EntityDescriptor/>").
+					Return(&keycloakapi.ClientRepresentation{
+						RedirectUris: &[]string{"https://app.example.com/*"},
+					}, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantAttributes: map[string]string{
+				"post.logout.redirect.uris":    "+",
+				samlForceNameIDFormatAttribute: "false",
+				samlForcePostBindingAttribute:  "false",
+			},
+			wantRedirects: &[]string{"https://spec.example.com/*"},
+		},
+		{
+			name: "failed to import metadata",
+			saml: &keycloakApi.SAMLSettings{Metadata: metadataRef},
+			clientsClient: func(t *testing.T) *keycloakapiMocks.MockClientsClient {
+				m := keycloakapiMocks.NewMockClientsClient(t)
+
+				m.On("ConvertClientDescription", mock.Anything, realmName, "<EntityDescriptor/>").
+					Return(nil, nil, errors.New("invalid metadata"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to import saml metadata")
+			},
+		},
+		{
+			name: "key pair secret not found",
+			saml: &keycloakApi.SAMLSettings{
+				Signing: &keycloakApi.SAMLSigning{
+					KeyPair: &keycloakApi.SAMLKeyPair{SecretName: "missing"},
+				},
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to set signing key pair")
+			},
+		},
+		{
+			name:     "saml settings for openid-connect client",
+			saml:     &keycloakApi.SAMLSettings{},
+			protocol: ptr.To(keycloakapi.ProtocolOpenIDConnect),
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "can be configured only for saml client")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "test-client-id",
+					SAML:     tt.saml,
+				},
+			}
+
+			signingSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "saml-signing", Namespace: "default"},
+				Type:       corev1.SecretTypeTLS,
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       certPEM,
+					corev1.TLSPrivateKeyKey: keyPEM,
+				},
+			}
+
+			encryptionSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "saml-encryption", Namespace: "default"},
+				Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+			}
+
+			metadata := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "sp-metadata", Namespace: "default"},
+				Data:       map[string]string{"metadata.xml": "<EntityDescriptor/>"},
+			}
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(kc, signingSecret, encryptionSecret, metadata).
+				Build()
+
+			protocol := tt.protocol
+			if protocol == nil {
+				protocol = ptr.To(keycloakapi.ProtocolSAML)
+			}
+
+			clientRep := keycloakapi.ClientRepresentation{
+				Protocol:     protocol,
+				RedirectUris: tt.redirectUris,
+				Attributes:   &map[string]string{"post.logout.redirect.uris": "+"},
+			}
+
+			clientsClient := keycloakapiMocks.NewMockClientsClient(t)
+			if tt.clientsClient != nil {
+				clientsClient = tt.clientsClient(t)
+			}
+
+			h := NewPutClient(&keycloakapi.KeycloakClient{Clients: clientsClient}, k8sClient, nil)
+
+			err := h.applySAML(context.Background(), kc, realmName, &clientRep)
+			tt.wantErr(t, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantAttributes, *clientRep.Attributes)
+
+			if tt.wantRedirects != nil {
+				assert.Equal(t, tt.wantRedirects, clientRep.RedirectUris)
+			}
+		})
+	}
+}
+
+func TestEncodePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := encodePrivateKey("not a key")
+	require.ErrorContains(t, err, "must be PEM-encoded")
+
+	_, err = encodePrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("invalid")})))
+	require.ErrorContains(t, err, "PKCS #1 or PKCS #8")
+}
@@ -0,0 +1,197 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+// secretRotationExecutor is the client policy executor that keeps the rotated client secret valid in Keycloak.
+const secretRotationExecutor = "secret-rotation"
+
+// rotateSecret regenerates the secret of the existing Keycloak client when the rotation interval
+// has passed since the last rotation and stores the new secret in the Secret owned by the KeycloakClient.
+// The previous secret is kept in the Secret and in Keycloak until the grace period ends.
+// Keycloak keeps accepting the previous secret only if the client secret rotation executor is enabled
+// in the realm client policies, so the secret is not rotated without it.
+// It sets the current secret in the client representation.
+func (h *PutClient) rotateSecret(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	realmName, clientUUID string,
+	clientRep *keycloakapi.ClientRepresentation,
+) error {
+	rotation := keycloakClient.Spec.SecretRotation
+	if rotation == nil || !hasClientSecret(&keycloakClient.Spec) {
+		return nil
+	}
+
+	secretName, secretKey, ok := secretref.ParseSecretRef(keycloakClient.Spec.Secret)
+	if !ok {
+		return fmt.Errorf("unable to parse client secret reference %q", keycloakClient.Spec.Secret)
+	}
+
+	secret := &corev1.Secret{}
+	if err := h.k8sClient.Get(ctx, types.NamespacedName{Namespace: keycloakClient.Namespace, Name: secretName}, secret); err != nil {
+		return fmt.Errorf("unable to get client secret %s: %w", secretName, err)
+	}
+
+	if !metav1.IsControlledBy(secret, keycloakClient) {
+		return fmt.Errorf("secret rotation is supported only for secrets generated by the operator, secret %s is not owned by the client", secretName)
+	}
+
+	policy, err := h.getSecretRotationPolicy(ctx, realmName)
+	if err != nil {
+		return err
+	}
+
+	if policy == "" {
+		h.setSecretRotationCondition(ctx, keycloakClient, metav1.ConditionFalse, ReasonSecretRotationExecutorNotConfigured,
+			fmt.Sprintf("Client secret is not rotated, enable the %s executor in the realm client policies", secretRotationExecutor))
+
+		clientRep.Secret = ptr.To(string(secret.Data[secretKey]))
+
+		return nil
+	}
+
+	h.setSecretRotationCondition(ctx, keycloakClient, metav1.ConditionTrue, ReasonSecretRotationEnabled,
+		fmt.Sprintf("Client secret rotation is enabled by the client policy %s", policy))
+
+	now := h.now()
+
+	rotatedAt := secret.CreationTimestamp.Time
+	if keycloakClient.Status.SecretRotatedAt != nil {
+		rotatedAt = keycloakClient.Status.SecretRotatedAt.Time
+	}
+
+	interval := time.Duration(rotation.Interval) * time.Second
+	gracePeriod := time.Duration(rotation.GracePeriod) * time.Second
+
+	if !now.Before(rotatedAt.Add(interval)) {
+		credential, _, err := h.kClient.Clients.RegenerateClientSecret(ctx, realmName, clientUUID)
+		if err != nil {
+			return fmt.Errorf("unable to regenerate keycloak client secret: %w", err)
+		}
+
+		if credential == nil || credential.Value == nil || *credential.Value == "" {
+			return fmt.Errorf("keycloak returned an empty client secret")
+		}
+
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+
+		secret.Data[keycloakApi.ClientPreviousSecretKey] = secret.Data[secretKey]
+		secret.Data[secretKey] = []byte(*credential.Value)
+
+		if err := h.k8sClient.Update(ctx, secret); err != nil {
+			return fmt.Errorf("unable to update client secret %s: %w", secretName, err)
+		}
+
+		rotatedAt = now
+
+		ctrl.LoggerFrom(ctx).Info("Client secret rotated", "secret", secretName)
+		events.Normal(ctx, keycloakClient, events.ReasonSecretRotated, "Client %s secret rotated", keycloakClient.Spec.ClientId)
+	} else if _, ok := secret.Data[keycloakApi.ClientPreviousSecretKey]; ok && !now.Before(rotatedAt.Add(gracePeriod)) {
+		if _, err := h.kClient.Clients.InvalidateRotatedClientSecret(ctx, realmName, clientUUID); err != nil && !keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("unable to invalidate rotated keycloak client secret: %w", err)
+		}
+
+		delete(secret.Data, keycloakApi.ClientPreviousSecretKey)
+
+		if err := h.k8sClient.Update(ctx, secret); err != nil {
+			return fmt.Errorf("unable to remove previous secret from %s: %w", secretName, err)
+		}
+	}
+
+	keycloakClient.Status.SecretRotatedAt = &metav1.Time{Time: rotatedAt}
+	clientRep.Secret = ptr.To(string(secret.Data[secretKey]))
+
+	return nil
+}
+
+// getSecretRotationPolicy returns the name of the enabled realm client policy
+// with a profile that contains the client secret rotation executor, or an empty string if there is none.
+func (h *PutClient) getSecretRotationPolicy(ctx context.Context, realmName string) (string, error) {
+	profiles, _, err := h.kClient.ClientPolicies.GetClientProfiles(ctx, realmName,
+		&keycloakapi.GetClientProfilesParams{IncludeGlobalProfiles: ptr.To(true)})
+	if err != nil {
+		return "", fmt.Errorf("unable to get realm client profiles: %w", err)
+	}
+
+	rotationProfiles := make(map[string]bool)
+
+	if profiles != nil {
+		for _, list := range []*[]keycloakapi.ClientProfileRepresentation{profiles.Profiles, profiles.GlobalProfiles} {
+			if list == nil {
+				continue
+			}
+
+			for _, profile := range *list {
+				if profile.Name != nil && profile.Executors != nil && slices.ContainsFunc(*profile.Executors,
+					func(e keycloakapi.ClientPolicyExecutorRepresentation) bool {
+						return ptr.Deref(e.Executor, "") == secretRotationExecutor
+					}) {
+					rotationProfiles[*profile.Name] = true
+				}
+			}
+		}
+	}
+
+	if len(rotationProfiles) == 0 {
+		return "", nil
+	}
+
+	policies, _, err := h.kClient.ClientPolicies.GetClientPolicies(ctx, realmName,
+		&keycloakapi.GetClientPoliciesParams{IncludeGlobalPolicies: ptr.To(true)})
+	if err != nil {
+		return "", fmt.Errorf("unable to get realm client policies: %w", err)
+	}
+
+	if policies == nil {
+		return "", nil
+	}
+
+	for _, list := range []*[]keycloakapi.ClientPolicyRepresentation{policies.Policies, policies.GlobalPolicies} {
+		if list == nil {
+			continue
+		}
+
+		for _, policy := range *list {
+			if policy.Name == nil || !ptr.Deref(policy.Enabled, false) || policy.Profiles == nil {
+				continue
+			}
+
+			for _, profile := range *policy.Profiles {
+				if rotationProfiles[profile] {
+					return *policy.Name, nil
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func (h *PutClient) setSecretRotationCondition(
+	ctx context.Context,
+	keycloakClient *keycloakApi.KeycloakClient,
+	status metav1.ConditionStatus,
+	reason, message string,
+) {
+	if err := SetCondition(ctx, h.k8sClient, keycloakClient, ConditionSecretRotationReady, status, reason, message); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to set secret rotation condition")
+	}
+}
@@ -0,0 +1,247 @@
+package chain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapiMocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+func TestPutClient_rotateSecret(t *testing.T) {
+	t.Parallel()
+
+	const (
+		secretName  = "keycloak-client-test-client-secret"
+		interval    = 3600
+		gracePeriod = 600
+	)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rotationProfiles := &keycloakapi.ClientProfilesRepresentation{
+		Profiles: &[]keycloakapi.ClientProfileRepresentation{
+			{
+				Name:      ptr.To("rotation-profile"),
+				Executors: &[]keycloakapi.ClientPolicyExecutorRepresentation{{Executor: ptr.To("secret-rotation")}},
+			},
+		},
+	}
+	rotationPolicies := &keycloakapi.ClientPoliciesRepresentation{
+		Policies: &[]keycloakapi.ClientPolicyRepresentation{
+			{Name: ptr.To("rotation-policy"), Enabled: ptr.To(true), Profiles: &[]string{"rotation-profile"}},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		rotatedAt       time.Time
+		data            map[string][]byte
+		owned           bool
+		profiles        *keycloakapi.ClientProfilesRepresentation
+		policies        *keycloakapi.ClientPoliciesRepresentation
+		wantErr         require.ErrorAssertionFunc
+		wantSecret      string
+		wantPrevious    string
+		wantRotated     bool
+		wantInvalidated bool
+		wantCondition   metav1.ConditionStatus
+	}{
+		{
+			name:          "rotation is not due",
+			rotatedAt:     now.Add(-time.Minute),
+			data:          map[string][]byte{keycloakApi.ClientSecretKey: []byte("current")},
+			owned:         true,
+			profiles:      rotationProfiles,
+			policies:      rotationPolicies,
+			wantErr:       require.NoError,
+			wantSecret:    "current",
+			wantCondition: metav1.ConditionTrue,
+		},
+		{
+			name:          "rotation is due",
+			rotatedAt:     now.Add(-2 * time.Hour),
+			data:          map[string][]byte{keycloakApi.ClientSecretKey: []byte("current")},
+			owned:         true,
+			profiles:      rotationProfiles,
+			policies:      rotationPolicies,
+			wantErr:       require.NoError,
+			wantPrevious:  "current",
+			wantRotated:   true,
+			wantCondition: metav1.ConditionTrue,
+		},
+		{
+			name:      "rotation is due but executor is disabled",
+			rotatedAt: now.Add(-2 * time.Hour),
+			data:      map[string][]byte{keycloakApi.ClientSecretKey: []byte("current")},
+			owned:     true,
+			profiles:  rotationProfiles,
+			policies: &keycloakapi.ClientPoliciesRepresentation{
+				Policies: &[]keycloakapi.ClientPolicyRepresentation{
+					{Name: ptr.To("rotation-policy"), Enabled: ptr.To(false), Profiles: &[]string{"rotation-profile"}},
+				},
+			},
+			wantErr:       require.NoError,
+			wantSecret:    "current",
+			wantCondition: metav1.ConditionFalse,
+		},
+		{
+			name:          "rotation is due but executor is not configured",
+			rotatedAt:     now.Add(-2 * time.Hour),
+			data:          map[string][]byte{keycloakApi.ClientSecretKey: []byte("current")},
+			owned:         true,
+			profiles:      &keycloakapi.ClientProfilesRepresentation{},
+			wantErr:       require.NoError,
+			wantSecret:    "current",
+			wantCondition: metav1.ConditionFalse,
+		},
+		{
+			name:      "previous secret is valid during grace period",
+			rotatedAt: now.Add(-time.Minute),
+			data: map[string][]byte{
+				keycloakApi.ClientSecretKey:         []byte("current"),
+				keycloakApi.ClientPreviousSecretKey: []byte("previous"),
+			},
+			owned:         true,
+			profiles:      rotationProfiles,
+			policies:      rotationPolicies,
+			wantErr:       require.NoError,
+			wantSecret:    "current",
+			wantPrevious:  "previous",
+			wantCondition: metav1.ConditionTrue,
+		},
+		{
+			name:      "previous secret is removed after grace period",
+			rotatedAt: now.Add(-20 * time.Minute),
+			data: map[string][]byte{
+				keycloakApi.ClientSecretKey:         []byte("current"),
+				keycloakApi.ClientPreviousSecretKey: []byte("previous"),
+			},
+			owned:           true,
+			profiles:        rotationProfiles,
+			policies:        rotationPolicies,
+			wantErr:         require.NoError,
+			wantSecret:      "current",
+			wantInvalidated: true,
+			wantCondition:   metav1.ConditionTrue,
+		},
+		{
+			name:      "secret is not owned by the client",
+			rotatedAt: now.Add(-2 * time.Hour),
+			data:      map[string][]byte{keycloakApi.ClientSecretKey: []byte("current")},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "secret rotation is supported only for secrets generated by the operator")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(s))
+			require.NoError(t, corev1.AddToScheme(s))
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default", UID: "client-uid"},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: "test-client-id",
+					Secret:   secretref.GenerateSecretRef(secretName, keycloakApi.ClientSecretKey),
+					SecretRotation: &keycloakApi.SecretRotation{
+						Interval:    interval,
+						GracePeriod: gracePeriod,
+					},
+				},
+				Status: keycloakApi.KeycloakClientStatus{
+					SecretRotatedAt: &metav1.Time{Time: tt.rotatedAt},
+				},
+			}
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "default"},
+				Data:       tt.data,
+			}
+
+			if tt.owned {
+				require.NoError(t, controllerutil.SetControllerReference(kc, secret, s))
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(s).WithObjects(kc, secret).WithStatusSubresource(kc).Build()
+
+			clientRep := keycloakapi.ClientRepresentation{Secret: ptr.To("current")}
+
+			clientsClient := keycloakapiMocks.NewMockClientsClient(t)
+			if tt.wantRotated {
+				clientsClient.On("RegenerateClientSecret", mock.Anything, "realm", "client-uuid").
+					Return(&keycloakapi.CredentialRepresentation{Value: ptr.To("regenerated")}, nil, nil)
+			}
+
+			if tt.wantInvalidated {
+				clientsClient.On("InvalidateRotatedClientSecret", mock.Anything, "realm", "client-uuid").Return(nil, nil)
+			}
+
+			policiesClient := keycloakapiMocks.NewMockClientPoliciesClient(t)
+			if tt.profiles != nil {
+				policiesClient.On("GetClientProfiles", mock.Anything, "realm", mock.Anything).Return(tt.profiles, nil, nil)
+			}
+
+			if tt.policies != nil {
+				policiesClient.On("GetClientPolicies", mock.Anything, "realm", mock.Anything).Return(tt.policies, nil, nil)
+			}
+
+			h := NewPutClient(&keycloakapi.KeycloakClient{Clients: clientsClient, ClientPolicies: policiesClient}, k8sClient, nil)
+			h.now = func() time.Time { return now }
+
+			err := h.rotateSecret(context.Background(), kc, "realm", "client-uuid", &clientRep)
+			tt.wantErr(t, err)
+
+			if err != nil {
+				return
+			}
+
+			updated := &corev1.Secret{}
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(secret), updated))
+
+			condition := meta.FindStatusCondition(kc.Status.Conditions, ConditionSecretRotationReady)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantCondition, condition.Status)
+
+			current := string(updated.Data[keycloakApi.ClientSecretKey])
+			assert.Equal(t, current, ptr.Deref(clientRep.Secret, ""))
+			assert.Nil(t, clientRep.Attributes)
+
+			if tt.wantRotated {
+				assert.Equal(t, "regenerated", current)
+				assert.True(t, now.Equal(kc.Status.SecretRotatedAt.Time))
+			} else {
+				assert.Equal(t, tt.wantSecret, current)
+				assert.True(t, tt.rotatedAt.Equal(kc.Status.SecretRotatedAt.Time))
+			}
+
+			if tt.wantPrevious == "" {
+				assert.NotContains(t, updated.Data, keycloakApi.ClientPreviousSecretKey)
+
+				return
+			}
+
+			assert.Equal(t, tt.wantPrevious, string(updated.Data[keycloakApi.ClientPreviousSecretKey]))
+		})
+	}
+}
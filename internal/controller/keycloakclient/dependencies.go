@@ -0,0 +1,125 @@
+package keycloakclient
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/dependency"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+// clientDependencies returns references to Keycloak objects the client depends on.
+func clientDependencies(obj client.Object) []dependency.Ref {
+	keycloakClient, ok := obj.(*keycloakApi.KeycloakClient)
+	if !ok {
+		return nil
+	}
+
+	spec := &keycloakClient.Spec
+
+	var refs []dependency.Ref
+
+	for _, scope := range spec.DefaultClientScopes {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakClientScopeKind, Name: scope})
+	}
+
+	for _, scope := range spec.OptionalClientScopes {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakClientScopeKind, Name: scope})
+	}
+
+	if spec.RealmRoles != nil {
+		for _, role := range *spec.RealmRoles {
+			refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmRoleKind, Name: role.Composite})
+		}
+	}
+
+	if sa := spec.ServiceAccount; sa != nil && sa.Enabled {
+		for _, role := range sa.RealmRoles {
+			refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmRoleKind, Name: role})
+		}
+
+		for _, group := range sa.Groups {
+			refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmGroupKind, Name: group})
+		}
+
+		for _, clientRole := range sa.ClientRoles {
+			refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakClientKind, Name: clientRole.ClientID})
+		}
+	}
+
+	if mappings := spec.ScopeMappings; mappings != nil {
+		for _, role := range mappings.RealmRoles {
+			refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmRoleKind, Name: role})
+		}
+
+		for _, clientRole := range mappings.ClientRoles {
+			refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakClientKind, Name: clientRole.ClientID})
+		}
+	}
+
+	if overrides := spec.AuthenticationFlowBindingOverrides; overrides != nil {
+		refs = append(refs,
+			dependency.Ref{Kind: keycloakApi.KeycloakAuthFlowKind, Name: overrides.Browser},
+			dependency.Ref{Kind: keycloakApi.KeycloakAuthFlowKind, Name: overrides.DirectGrant},
+		)
+	}
+
+	return refs
+}
+
+// clientSecretRefs returns the Secrets and ConfigMaps with the client secret, certificates, keys, SAML metadata
+// and authorization settings.
+func clientSecretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	keycloakClient, ok := obj.(*keycloakApi.KeycloakClient)
+	if !ok {
+		return refs
+	}
+
+	if keycloakClient.Spec.ClientAuthentication != nil {
+		refs.AddSourceRef(keycloakClient.Namespace, keycloakClient.Spec.ClientAuthentication.Certificate)
+	}
+
+	if saml := keycloakClient.Spec.SAML; saml != nil {
+		refs.AddSourceRef(keycloakClient.Namespace, saml.Metadata)
+
+		if saml.Signing != nil && saml.Signing.KeyPair != nil {
+			refs.AddSecret(keycloakClient.Namespace, saml.Signing.KeyPair.SecretName)
+		}
+
+		if saml.Encryption != nil && saml.Encryption.KeyPair != nil {
+			refs.AddSecret(keycloakClient.Namespace, saml.Encryption.KeyPair.SecretName)
+		}
+	}
+
+	if authorization := keycloakClient.Spec.Authorization; authorization != nil && authorization.FromConfigMap != nil {
+		refs.AddConfigMap(keycloakClient.Namespace, authorization.FromConfigMap.Name)
+	}
+
+	if keycloakClient.Spec.Secret == "" {
+		return refs
+	}
+
+	// Old clients keep only the secret name, it is converted to the reference during reconciliation.
+	if !secretref.HasSecretRef(keycloakClient.Spec.Secret) {
+		refs.AddSecret(keycloakClient.Namespace, keycloakClient.Spec.Secret)
+
+		return refs
+	}
+
+	refs.AddSecretRefValue(keycloakClient.Namespace, keycloakClient.Spec.Secret)
+
+	return refs
+}
+
+// clientAdminEventKeys returns the admin event resource key of the client.
+func clientAdminEventKeys(obj client.Object) []string {
+	if keycloakClient, ok := obj.(*keycloakApi.KeycloakClient); ok {
+		return []string{adminevents.Key(adminevents.ResourceClients, keycloakClient.Status.ClientID)}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package keycloakclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/dependency"
+)
+
+func TestClientDependencies(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  client.Object
+		want []dependency.Ref
+	}{
+		{
+			name: "client scopes and service account roles",
+			obj: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					DefaultClientScopes:  []string{"profile"},
+					OptionalClientScopes: []string{"email"},
+					ServiceAccount: &keycloakApi.ServiceAccount{
+						Enabled:     true,
+						RealmRoles:  []string{"admin"},
+						Groups:      []string{"team"},
+						ClientRoles: []keycloakApi.UserClientRole{{ClientID: "backend", Roles: []string{"viewer"}}},
+					},
+				},
+			},
+			want: []dependency.Ref{
+				{Kind: keycloakApi.KeycloakClientScopeKind, Name: "profile"},
+				{Kind: keycloakApi.KeycloakClientScopeKind, Name: "email"},
+				{Kind: keycloakApi.KeycloakRealmRoleKind, Name: "admin"},
+				{Kind: keycloakApi.KeycloakRealmGroupKind, Name: "team"},
+				{Kind: keycloakApi.KeycloakClientKind, Name: "backend"},
+			},
+		},
+		{
+			name: "scope mappings",
+			obj: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{
+					ScopeMappings: &keycloakApi.ScopeMappings{
+						RealmRoles:  []string{"developer"},
+						ClientRoles: []keycloakApi.UserClientRole{{ClientID: "api", Roles: []string{"reader"}}},
+					},
+				},
+			},
+			want: []dependency.Ref{
+				{Kind: keycloakApi.KeycloakRealmRoleKind, Name: "developer"},
+				{Kind: keycloakApi.KeycloakClientKind, Name: "api"},
+			},
+		},
+		{
+			name: "not a client",
+			obj:  &keycloakApi.KeycloakRealmRole{},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clientDependencies(tt.obj))
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,13 +15,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/dependency"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakclient/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 type Helper interface {
@@ -27,6 +40,8 @@ type Helper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 const keyCloakClientOperatorFinalizerName = "keycloak.client.operator.finalizer.name"
@@ -43,19 +58,98 @@ type ReconcileKeycloakClient struct {
 	client                  client.Client
 	helper                  Helper
 	successReconcileTimeout time.Duration
+	clusterTemplates        bool
+	adminEvents             *adminevents.Watcher
 }
 
-func (r *ReconcileKeycloakClient) SetupWithManager(mgr ctrl.Manager, successReconcileTimeout time.Duration) error {
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *ReconcileKeycloakClient) WithAdminEvents(watcher *adminevents.Watcher) *ReconcileKeycloakClient {
+	r.adminEvents = watcher
+
+	return r
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// ClusterKeycloakClientTemplate can be referenced only if clusterTemplates is true,
+// that is when the operator watches all namespaces and has access to cluster-scoped resources.
+func (r *ReconcileKeycloakClient) SetupWithManager(mgr ctrl.Manager, successReconcileTimeout time.Duration, clusterTemplates bool) error {
 	r.successReconcileTimeout = successReconcileTimeout
+	r.clusterTemplates = clusterTemplates
 
 	pred := predicate.Funcs{
 		UpdateFunc: helper.IsFailuresUpdated,
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&keycloakApi.KeycloakClient{},
+		templateRefIndexField,
+		indexTemplateRef,
+	); err != nil {
+		return fmt.Errorf("unable to index KeycloakClient template references: %w", err)
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&keycloakApi.KeycloakClient{}, builder.WithPredicates(pred)).
-		Complete(r)
-	if err != nil {
+		Owns(&corev1.Secret{}).
+		Watches(
+			&keycloakApi.KeycloakClientTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.clientsForTemplate),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+
+	if clusterTemplates {
+		b.Watches(
+			&keycloakAlpha.ClusterKeycloakClientTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.clientsForTemplate),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+	}
+
+	if err := r.setupRedirectSourceWatches(mgr, b); err != nil {
+		return err
+	}
+
+	if err := dependency.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakClient{},
+		func() client.ObjectList { return &keycloakApi.KeycloakClientList{} },
+		clientDependencies,
+		keycloakApi.KeycloakClientScopeKind,
+		keycloakApi.KeycloakRealmRoleKind,
+		keycloakApi.KeycloakRealmGroupKind,
+		keycloakApi.KeycloakClientKind,
+		keycloakApi.KeycloakAuthFlowKind,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakClient dependencies: %w", err)
+	}
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakClient{},
+		func() client.ObjectList { return &keycloakApi.KeycloakClientList{} },
+		clientSecretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakClient secret watches: %w", err)
+	}
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakClient{},
+		func() client.ObjectList { return &keycloakApi.KeycloakClientList{} },
+		clientAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakClient admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakClient{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakClient{}, r)))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakClient controller: %w", err)
 	}
 
@@ -65,6 +159,11 @@ func (r *ReconcileKeycloakClient) SetupWithManager(mgr ctrl.Manager, successReco
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclients,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclients/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclients/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclienttemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=v1.edp.epam.com,resources=clusterkeycloakclienttemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,namespace=placeholder,resources=ingresses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,namespace=placeholder,resources=httproutes,verbs=get;list;watch
 
 // Reconcile is a loop for reconciling KeycloakClient object.
 func (r *ReconcileKeycloakClient) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, resultErr error) {
@@ -126,8 +225,12 @@ func (r *ReconcileKeycloakClient) initializeReconciliation(ctx context.Context,
 }
 
 func (r *ReconcileKeycloakClient) handleDeletion(ctx context.Context, instance *keycloakApi.KeycloakClient, kClient *keycloakapi.KeycloakClient, realmName string) (reconcile.Result, error) {
+	metrics.DeleteDriftedFields(keycloakApi.KeycloakClientKind, instance.Namespace, instance.Name)
+
 	if controllerutil.ContainsFinalizer(instance, keyCloakClientOperatorFinalizerName) {
-		if err := chain.NewRemoveClient(kClient).Serve(ctx, instance, realmName); err != nil {
+		if r.helper.GetReconcileMode(instance) == common.ReconcileModeObserve {
+			ctrl.LoggerFrom(ctx).Info("Reconcile mode is observe, skipping keycloak client deletion")
+		} else if err := chain.NewRemoveClient(kClient, r.helper.DefaultDeletionPolicy()).Serve(ctx, instance, realmName); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to remove keycloak client: %w", err)
 		}
 
@@ -150,35 +253,49 @@ func (r *ReconcileKeycloakClient) handleReconciliation(ctx context.Context, inst
 		}
 	}
 
+	if err := r.applyTemplate(ctx, instance); err != nil {
+		return r.handleSpecError(ctx, instance, fmt.Errorf("unable to apply client template: %w", err))
+	}
+
+	if err := r.applyRedirectFrom(ctx, instance); err != nil {
+		return r.handleSpecError(ctx, instance, fmt.Errorf("unable to derive redirect URIs: %w", err))
+	}
+
+	if r.helper.GetReconcileMode(instance) == common.ReconcileModeObserve {
+		return r.handleObservation(ctx, instance, kClient, realmName)
+	}
+
+	meta.RemoveStatusCondition(&instance.Status.Conditions, chain.ConditionDrifted)
+	metrics.DeleteDriftedFields(keycloakApi.KeycloakClientKind, instance.Namespace, instance.Name)
+
+	notReady, err := dependency.NotReady(ctx, r.client, instance, clientDependencies(instance))
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check keycloak client dependencies: %w", err)
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, dependency.Condition(notReady, instance.Generation))
+
+	if len(notReady) > 0 {
+		return r.waitForDependencies(ctx, instance, notReady)
+	}
+
 	var resultErr error
 
 	if err := chain.MakeChain(kClient, r.client).Serve(ctx, instance, realmName); err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
 		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
 			return ctrl.Result{RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod}, nil
 		}
 
 		log.Error(err, "an error has occurred while handling keycloak client", "name", instance.Name)
 
-		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
-			Type:               chain.ConditionReady,
-			Status:             metav1.ConditionFalse,
-			Reason:             chain.ReasonKeycloakAPIError,
-			Message:            fmt.Sprintf("Reconciliation failed: %s", err.Error()),
-			ObservedGeneration: instance.Generation,
-		})
-
 		instance.Status.Value = err.Error()
 		resultErr = fmt.Errorf("keycloak client chain processing failed: %w", err)
 	} else {
-		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
-			Type:               chain.ConditionReady,
-			Status:             metav1.ConditionTrue,
-			Reason:             chain.ReasonReconciliationSucceeded,
-			Message:            "KeycloakClient reconciliation completed successfully",
-			ObservedGeneration: instance.Generation,
-		})
-
 		helper.SetSuccessStatus(instance)
+		status.SetReady(instance, "KeycloakClient reconciliation completed successfully")
 	}
 
 	if err := r.client.Status().Update(ctx, instance); err != nil {
@@ -191,3 +308,84 @@ func (r *ReconcileKeycloakClient) handleReconciliation(ctx context.Context, inst
 
 	return reconcile.Result{RequeueAfter: r.successReconcileTimeout}, nil
 }
+
+// handleSpecError sets the status of the client whose template or redirect sources can't be resolved.
+// The client is enqueued by the template and redirect source watches as soon as the referenced object is created.
+func (r *ReconcileKeycloakClient) handleSpecError(ctx context.Context, instance *keycloakApi.KeycloakClient, err error) (reconcile.Result, error) {
+	events.Error(ctx, instance, err)
+	status.SetFailed(instance, err)
+
+	instance.Status.Value = err.Error()
+
+	if statusErr := r.client.Status().Update(ctx, instance); statusErr != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to update status: %w", statusErr)
+	}
+
+	return reconcile.Result{RequeueAfter: r.helper.SetFailureCount(instance)}, err
+}
+
+// waitForDependencies sets the status of the client that waits for not ready dependencies.
+// The client is enqueued by the dependency watches as soon as the dependencies become ready.
+func (r *ReconcileKeycloakClient) waitForDependencies(ctx context.Context, instance *keycloakApi.KeycloakClient, notReady []string) (reconcile.Result, error) {
+	ctrl.LoggerFrom(ctx).Info("Waiting for dependencies", "dependencies", notReady)
+
+	message := fmt.Sprintf("Waiting for %s", strings.Join(notReady, ", "))
+
+	status.SetNotReady(instance, dependency.ReasonDependencyNotReady, message)
+
+	instance.Status.Value = message
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to update status: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.successReconcileTimeout}, nil
+}
+
+// handleObservation reports the drift between the spec and Keycloak without applying any changes.
+func (r *ReconcileKeycloakClient) handleObservation(ctx context.Context, instance *keycloakApi.KeycloakClient, kClient *keycloakapi.KeycloakClient, realmName string) (reconcile.Result, error) {
+	diffs, err := chain.NewObserveClient(kClient).Serve(ctx, instance, realmName)
+	if err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
+		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
+			return ctrl.Result{RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod}, nil
+		}
+
+		instance.Status.Value = err.Error()
+
+		if statusErr := r.client.Status().Update(ctx, instance); statusErr != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to update status: %w", statusErr)
+		}
+
+		return reconcile.Result{RequeueAfter: r.helper.SetFailureCount(instance)}, fmt.Errorf("keycloak client drift detection failed: %w", err)
+	}
+
+	metrics.SetDriftedFields(keycloakApi.KeycloakClientKind, instance.Namespace, instance.Name, len(diffs))
+
+	driftCondition := metav1.Condition{
+		Type:               chain.ConditionDrifted,
+		Status:             metav1.ConditionFalse,
+		Reason:             chain.ReasonNoDrift,
+		Message:            "Keycloak client matches the spec",
+		ObservedGeneration: instance.Generation,
+	}
+
+	if len(diffs) > 0 {
+		driftCondition.Status = metav1.ConditionTrue
+		driftCondition.Reason = chain.ReasonDriftDetected
+		driftCondition.Message = drift.FormatMessage(diffs)
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, driftCondition)
+
+	helper.SetSuccessStatus(instance)
+	status.SetReady(instance, "KeycloakClient observed, changes are not applied in observe mode")
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to update status: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.successReconcileTimeout}, nil
+}
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -838,6 +839,32 @@ var _ = Describe("KeycloakClient controller", Ordered, func() {
 								Users: []string{"test-policy-user"},
 							},
 						},
+						{
+							Name:             "regex-policy",
+							Description:      "Regex policy",
+							Type:             keycloakApi.PolicyTypeRegex,
+							DecisionStrategy: keycloakApi.PolicyDecisionStrategyUnanimous,
+							Logic:            keycloakApi.PolicyLogicPositive,
+							RegexPolicy: &keycloakApi.RegexPolicyData{
+								TargetClaim: "department",
+								Pattern:     "^engineering$",
+							},
+						},
+						{
+							Name:             "client-scope-policy",
+							Description:      "Client scope policy",
+							Type:             keycloakApi.PolicyTypeClientScope,
+							DecisionStrategy: keycloakApi.PolicyDecisionStrategyUnanimous,
+							Logic:            keycloakApi.PolicyLogicPositive,
+							ClientScopePolicy: &keycloakApi.ClientScopePolicyData{
+								ClientScopes: []keycloakApi.ClientScopeDefinition{
+									{
+										Name:     "profile",
+										Required: true,
+									},
+								},
+							},
+						},
 						{
 							Name:             "aggregate-policy",
 							Type:             keycloakApi.PolicyTypeAggregate,
@@ -1026,6 +1053,128 @@ var _ = Describe("KeycloakClient controller", Ordered, func() {
 		Expect((*kcClient.Attributes)["access.token.lifespan"]).Should(Equal("300"))
 	})
 
+	It("Should create KeycloakClient from template", func() {
+		By("Creating a KeycloakClientTemplate")
+		template := &keycloakApi.KeycloakClientTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-keycloak-client-template",
+				Namespace: ns,
+			},
+			Spec: keycloakApi.KeycloakClientTemplateSpec{
+				Attributes: map[string]string{"pkce.code.challenge.method": "S256"},
+				AdvancedSettings: &keycloakApi.KeycloakClientAdvancedSettings{
+					AccessTokenLifespan: ptr.To(600),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, template)).Should(Succeed())
+
+		By("Creating a KeycloakClient that references the template")
+		keycloakClient := &keycloakApi.KeycloakClient{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-keycloak-client-from-template",
+				Namespace: ns,
+			},
+			Spec: keycloakApi.KeycloakClientSpec{
+				ClientId: "test-keycloak-client-from-template",
+				RealmRef: common.RealmRef{
+					Name: KeycloakRealmCR,
+					Kind: keycloakApi.KeycloakRealmKind,
+				},
+				TemplateRef: &keycloakApi.ClientTemplateRef{Name: template.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, keycloakClient)).Should(Succeed())
+
+		Eventually(func(g Gomega) {
+			createdKeycloakClient := &keycloakApi.KeycloakClient{}
+			g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: keycloakClient.Name, Namespace: ns}, createdKeycloakClient)).Should(Succeed())
+			g.Expect(createdKeycloakClient.Status.Value).Should(Equal(common.StatusOK))
+			g.Expect(createdKeycloakClient.Status.TemplateGeneration).Should(Equal(int64(1)))
+			g.Expect(createdKeycloakClient.Spec.Attributes).ShouldNot(HaveKey("pkce.code.challenge.method"))
+		}, timeout, interval).Should(Succeed())
+
+		kcClient, _, err := keycloakAdmin.Clients.GetClientByClientID(ctx, KeycloakRealmCR, keycloakClient.Spec.ClientId)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(kcClient.Attributes).ShouldNot(BeNil())
+		Expect((*kcClient.Attributes)["pkce.code.challenge.method"]).Should(Equal("S256"))
+		Expect((*kcClient.Attributes)["access.token.lifespan"]).Should(Equal("600"))
+
+		By("Updating the template")
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: template.Name, Namespace: ns}, template)).Should(Succeed())
+		template.Spec.AdvancedSettings.AccessTokenLifespan = ptr.To(900)
+		Expect(k8sClient.Update(ctx, template)).Should(Succeed())
+
+		Eventually(func(g Gomega) {
+			updatedKeycloakClient := &keycloakApi.KeycloakClient{}
+			g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: keycloakClient.Name, Namespace: ns}, updatedKeycloakClient)).Should(Succeed())
+			g.Expect(updatedKeycloakClient.Status.TemplateGeneration).Should(Equal(int64(2)))
+
+			kcClient, _, err := keycloakAdmin.Clients.GetClientByClientID(ctx, KeycloakRealmCR, keycloakClient.Spec.ClientId)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect((*kcClient.Attributes)["access.token.lifespan"]).Should(Equal("900"))
+		}, timeout, interval).Should(Succeed())
+	})
+
+	It("Should derive redirect URIs of KeycloakClient from Ingress", func() {
+		By("Creating an Ingress")
+		ingress := &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-keycloak-client-ingress",
+				Namespace: ns,
+			},
+			Spec: networkingv1.IngressSpec{
+				TLS:   []networkingv1.IngressTLS{{Hosts: []string{"pr-1.preview.example.com"}}},
+				Rules: []networkingv1.IngressRule{{Host: "pr-1.preview.example.com"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ingress)).Should(Succeed())
+
+		By("Creating a KeycloakClient that references the Ingress")
+		keycloakClient := &keycloakApi.KeycloakClient{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-keycloak-client-redirect-from",
+				Namespace: ns,
+			},
+			Spec: keycloakApi.KeycloakClientSpec{
+				ClientId: "test-keycloak-client-redirect-from",
+				RealmRef: common.RealmRef{
+					Name: KeycloakRealmCR,
+					Kind: keycloakApi.KeycloakRealmKind,
+				},
+				RedirectFrom: []keycloakApi.RedirectSource{
+					{Kind: keycloakApi.RedirectSourceIngress, Name: ingress.Name, PathSuffix: "/oauth2/callback"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, keycloakClient)).Should(Succeed())
+
+		Eventually(func(g Gomega) {
+			createdKeycloakClient := &keycloakApi.KeycloakClient{}
+			g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: keycloakClient.Name, Namespace: ns}, createdKeycloakClient)).Should(Succeed())
+			g.Expect(createdKeycloakClient.Status.Value).Should(Equal(common.StatusOK))
+			g.Expect(createdKeycloakClient.Spec.RedirectUris).Should(BeEmpty())
+		}, timeout, interval).Should(Succeed())
+
+		kcClient, _, err := keycloakAdmin.Clients.GetClientByClientID(ctx, KeycloakRealmCR, keycloakClient.Spec.ClientId)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*kcClient.RootUrl).Should(Equal("https://pr-1.preview.example.com"))
+		Expect(*kcClient.RedirectUris).Should(ConsistOf("https://pr-1.preview.example.com/oauth2/callback"))
+		Expect(*kcClient.WebOrigins).Should(ConsistOf("https://pr-1.preview.example.com"))
+
+		By("Updating the Ingress host")
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ingress.Name, Namespace: ns}, ingress)).Should(Succeed())
+		ingress.Spec.TLS[0].Hosts = []string{"pr-2.preview.example.com"}
+		ingress.Spec.Rules[0].Host = "pr-2.preview.example.com"
+		Expect(k8sClient.Update(ctx, ingress)).Should(Succeed())
+
+		Eventually(func(g Gomega) {
+			kcClient, _, err := keycloakAdmin.Clients.GetClientByClientID(ctx, KeycloakRealmCR, keycloakClient.Spec.ClientId)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(*kcClient.RedirectUris).Should(ConsistOf("https://pr-2.preview.example.com/oauth2/callback"))
+		}, timeout, interval).Should(Succeed())
+	})
+
 	It("Should successfully delete KeycloakClient if ErrKeycloakRealmNotFound occurs", func() {
 		By("By creating a KeycloakRealm")
 		testRealm := &keycloakApi.KeycloakRealm{
@@ -0,0 +1,358 @@
+package keycloakclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+const (
+	// redirectFromIndexField is the field index of KeycloakClient resources by the referenced redirect sources.
+	redirectFromIndexField = "spec.redirectFrom"
+
+	// defaultRedirectPathSuffix is the path suffix template used if the redirect source doesn't specify it.
+	defaultRedirectPathSuffix = "{{ .Path }}/*"
+)
+
+// httpRouteGVK is the Gateway API HTTPRoute kind.
+// HTTPRoute objects are handled as unstructured, so the operator doesn't depend on the Gateway API module.
+var httpRouteGVK = schema.GroupVersionKind{
+	Group:   "gateway.networking.k8s.io",
+	Version: "v1",
+	Kind:    keycloakApi.RedirectSourceHTTPRoute,
+}
+
+// redirectURL is a URL of a routing rule of the redirect source.
+type redirectURL struct {
+	Scheme string
+	Host   string
+	Path   string
+}
+
+// origin returns the origin of the URL.
+func (u redirectURL) origin() string {
+	return u.Scheme + "://" + u.Host
+}
+
+// redirectPathData is the data of the path suffix template.
+type redirectPathData struct {
+	Host string
+	Path string
+}
+
+// redirectSourceIndexKey returns the index key of the redirect source with the given kind and name.
+func redirectSourceIndexKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// indexRedirectFrom indexes KeycloakClient resources by the referenced redirect sources.
+func indexRedirectFrom(obj client.Object) []string {
+	keycloakClient, ok := obj.(*keycloakApi.KeycloakClient)
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(keycloakClient.Spec.RedirectFrom))
+
+	for _, source := range keycloakClient.Spec.RedirectFrom {
+		keys = append(keys, redirectSourceIndexKey(source.Kind, source.Name))
+	}
+
+	return keys
+}
+
+// newHTTPRoute returns an empty unstructured HTTPRoute.
+func newHTTPRoute() *unstructured.Unstructured {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(httpRouteGVK)
+
+	return route
+}
+
+// setupRedirectSourceWatches registers the field index of the redirect sources and watches of Ingress and HTTPRoute objects.
+// HTTPRoute objects are watched only if the Gateway API is installed in the cluster.
+func (r *ReconcileKeycloakClient) setupRedirectSourceWatches(mgr ctrl.Manager, b *builder.Builder) error {
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&keycloakApi.KeycloakClient{},
+		redirectFromIndexField,
+		indexRedirectFrom,
+	); err != nil {
+		return fmt.Errorf("unable to index KeycloakClient redirect sources: %w", err)
+	}
+
+	b.Watches(
+		&networkingv1.Ingress{},
+		handler.EnqueueRequestsFromMapFunc(r.clientsForRedirectSource),
+		builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+	)
+
+	if _, err := mgr.GetRESTMapper().RESTMapping(httpRouteGVK.GroupKind(), httpRouteGVK.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			mgr.GetLogger().Info("Gateway API is not installed, HTTPRoute redirect sources are not watched")
+
+			return nil
+		}
+
+		return fmt.Errorf("unable to get %s API mapping: %w", httpRouteGVK.Kind, err)
+	}
+
+	b.Watches(
+		newHTTPRoute(),
+		handler.EnqueueRequestsFromMapFunc(r.clientsForRedirectSource),
+		builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+	)
+
+	return nil
+}
+
+// clientsForRedirectSource returns reconcile requests for the clients that reference the changed Ingress or HTTPRoute.
+func (r *ReconcileKeycloakClient) clientsForRedirectSource(ctx context.Context, obj client.Object) []reconcile.Request {
+	var kind string
+
+	switch o := obj.(type) {
+	case *networkingv1.Ingress:
+		kind = keycloakApi.RedirectSourceIngress
+	case *unstructured.Unstructured:
+		kind = o.GetKind()
+	default:
+		return nil
+	}
+
+	clients := &keycloakApi.KeycloakClientList{}
+	if err := r.client.List(
+		ctx,
+		clients,
+		client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{redirectFromIndexField: redirectSourceIndexKey(kind, obj.GetName())},
+	); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Unable to list clients of the redirect source", "kind", kind, "name", obj.GetName())
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(clients.Items))
+
+	for i := range clients.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: clients.Items[i].Namespace, Name: clients.Items[i].Name},
+		})
+	}
+
+	return requests
+}
+
+// applyRedirectFrom adds the URLs of the Ingress and HTTPRoute objects referenced by the client
+// to the redirect URIs and web origins of the client spec. The first URL is used as the web url if it is not set.
+// The derived values are used only during the reconciliation and are not stored in the resource.
+func (r *ReconcileKeycloakClient) applyRedirectFrom(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient) error {
+	if len(keycloakClient.Spec.RedirectFrom) == 0 {
+		return nil
+	}
+
+	var redirectUris, webOrigins []string
+
+	for _, source := range keycloakClient.Spec.RedirectFrom {
+		urls, err := r.redirectSourceURLs(ctx, keycloakClient.Namespace, source)
+		if err != nil {
+			return err
+		}
+
+		pathSuffix := source.PathSuffix
+		if pathSuffix == "" {
+			pathSuffix = defaultRedirectPathSuffix
+		}
+
+		tmpl, err := template.New(source.Name).Parse(pathSuffix)
+		if err != nil {
+			return fmt.Errorf("unable to parse path suffix of %s %s: %w", source.Kind, source.Name, err)
+		}
+
+		for _, u := range urls {
+			var suffix bytes.Buffer
+			if err := tmpl.Execute(&suffix, redirectPathData{Host: u.Host, Path: u.Path}); err != nil {
+				return fmt.Errorf("unable to render path suffix of %s %s: %w", source.Kind, source.Name, err)
+			}
+
+			redirectUris = appendMissing(redirectUris, u.origin()+suffix.String())
+			webOrigins = appendMissing(webOrigins, u.origin())
+		}
+	}
+
+	if keycloakClient.Spec.WebUrl == "" && len(webOrigins) > 0 {
+		keycloakClient.Spec.WebUrl = webOrigins[0]
+	}
+
+	keycloakClient.Spec.RedirectUris = appendMissing(slices.Clone(keycloakClient.Spec.RedirectUris), redirectUris...)
+	keycloakClient.Spec.WebOrigins = appendMissing(slices.Clone(keycloakClient.Spec.WebOrigins), webOrigins...)
+
+	ctrl.LoggerFrom(ctx).Info("Redirect URIs derived from routes", "redirectUris", redirectUris)
+
+	return nil
+}
+
+// redirectSourceURLs returns the URLs of the routing rules of the Ingress or HTTPRoute.
+func (r *ReconcileKeycloakClient) redirectSourceURLs(ctx context.Context, namespace string, source keycloakApi.RedirectSource) ([]redirectURL, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: source.Name}
+
+	switch source.Kind {
+	case keycloakApi.RedirectSourceIngress:
+		ingress := &networkingv1.Ingress{}
+		if err := r.client.Get(ctx, key, ingress); err != nil {
+			return nil, fmt.Errorf("unable to get %s %s: %w", source.Kind, source.Name, err)
+		}
+
+		return ingressURLs(ingress), nil
+	case keycloakApi.RedirectSourceHTTPRoute:
+		route := newHTTPRoute()
+		if err := r.client.Get(ctx, key, route); err != nil {
+			return nil, fmt.Errorf("unable to get %s %s: %w", source.Kind, source.Name, err)
+		}
+
+		urls, err := httpRouteURLs(route)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s %s: %w", source.Kind, source.Name, err)
+		}
+
+		return urls, nil
+	default:
+		return nil, fmt.Errorf("unsupported redirect source kind %s", source.Kind)
+	}
+}
+
+// ingressURLs returns the URLs of the Ingress rules.
+// Hosts listed in the TLS section use the https scheme, other hosts use http.
+// Rules without a host and wildcard hosts are skipped.
+func ingressURLs(ingress *networkingv1.Ingress) []redirectURL {
+	tlsHosts := make(map[string]bool)
+
+	for _, tls := range ingress.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	var urls []redirectURL
+
+	for _, rule := range ingress.Spec.Rules {
+		if !isRedirectHost(rule.Host) {
+			continue
+		}
+
+		scheme := "http"
+		if tlsHosts[rule.Host] {
+			scheme = "https"
+		}
+
+		var paths []string
+
+		if rule.HTTP != nil {
+			for _, p := range rule.HTTP.Paths {
+				paths = append(paths, p.Path)
+			}
+		}
+
+		urls = appendRedirectURLs(urls, scheme, rule.Host, paths)
+	}
+
+	return urls
+}
+
+// httpRouteURLs returns the URLs of the HTTPRoute hostnames and path matches.
+// HTTPRoute doesn't hold the TLS settings of the gateway listener, so the https scheme is always used.
+func httpRouteURLs(route *unstructured.Unstructured) ([]redirectURL, error) {
+	hostnames, _, err := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get hostnames: %w", err)
+	}
+
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rules: %w", err)
+	}
+
+	var paths []string
+
+	for _, rule := range rules {
+		ruleMap, ok := rule.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		matches, _, err := unstructured.NestedSlice(ruleMap, "matches")
+		if err != nil {
+			return nil, fmt.Errorf("unable to get rule matches: %w", err)
+		}
+
+		for _, match := range matches {
+			matchMap, ok := match.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if path, _, _ := unstructured.NestedString(matchMap, "path", "value"); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	var urls []redirectURL
+
+	for _, host := range hostnames {
+		if isRedirectHost(host) {
+			urls = appendRedirectURLs(urls, "https", host, paths)
+		}
+	}
+
+	return urls, nil
+}
+
+// appendRedirectURLs appends the URLs of the host with the given paths to the list.
+// Trailing slashes are trimmed from the paths, the root path is used if there are no paths.
+func appendRedirectURLs(urls []redirectURL, scheme, host string, paths []string) []redirectURL {
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+
+	for _, p := range paths {
+		u := redirectURL{Scheme: scheme, Host: host, Path: strings.TrimRight(p, "/")}
+		if !slices.Contains(urls, u) {
+			urls = append(urls, u)
+		}
+	}
+
+	return urls
+}
+
+// isRedirectHost checks if the host can be used in the redirect URI.
+func isRedirectHost(host string) bool {
+	return host != "" && !strings.HasPrefix(host, "*")
+}
+
+// appendMissing appends the values that are not in the list yet.
+func appendMissing(list []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(list, v) {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
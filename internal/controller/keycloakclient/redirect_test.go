@@ -0,0 +1,222 @@
+package keycloakclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+func newTestIngress() *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{{Hosts: []string{"app.example.com"}}},
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "app.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{Path: "/"}, {Path: "/api/"}},
+						},
+					},
+				},
+				{Host: "pr-1.preview.example.com"},
+				{Host: "*.example.com"},
+				{},
+			},
+		},
+	}
+}
+
+func newTestHTTPRoute() *unstructured.Unstructured {
+	route := newHTTPRoute()
+	route.SetNamespace("default")
+	route.SetName("app")
+	route.Object["spec"] = map[string]any{
+		"hostnames": []any{"route.example.com"},
+		"rules": []any{
+			map[string]any{
+				"matches": []any{
+					map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/web"}},
+				},
+			},
+		},
+	}
+
+	return route
+}
+
+func TestIngressURLs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []redirectURL{
+		{Scheme: "https", Host: "app.example.com", Path: ""},
+		{Scheme: "https", Host: "app.example.com", Path: "/api"},
+		{Scheme: "http", Host: "pr-1.preview.example.com", Path: ""},
+	}, ingressURLs(newTestIngress()))
+}
+
+func TestHTTPRouteURLs(t *testing.T) {
+	t.Parallel()
+
+	urls, err := httpRouteURLs(newTestHTTPRoute())
+	require.NoError(t, err)
+	assert.Equal(t, []redirectURL{{Scheme: "https", Host: "route.example.com", Path: "/web"}}, urls)
+
+	route := newHTTPRoute()
+	route.Object["spec"] = map[string]any{"hostnames": []any{"route.example.com", "*.example.com"}}
+
+	urls, err = httpRouteURLs(route)
+	require.NoError(t, err)
+	assert.Equal(t, []redirectURL{{Scheme: "https", Host: "route.example.com", Path: ""}}, urls)
+}
+
+func TestReconcileKeycloakClient_applyRedirectFrom(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, keycloakApi.AddToScheme(s))
+
+	tests := []struct {
+		name             string
+		spec             keycloakApi.KeycloakClientSpec
+		wantErr          require.ErrorAssertionFunc
+		wantWebUrl       string
+		wantRedirectUris []string
+		wantWebOrigins   []string
+	}{
+		{
+			name:       "redirect sources are not referenced",
+			spec:       keycloakApi.KeycloakClientSpec{WebUrl: "https://static.example.com"},
+			wantErr:    require.NoError,
+			wantWebUrl: "https://static.example.com",
+		},
+		{
+			name: "ingress with default path suffix",
+			spec: keycloakApi.KeycloakClientSpec{
+				RedirectFrom: []keycloakApi.RedirectSource{{Kind: keycloakApi.RedirectSourceIngress, Name: "app"}},
+			},
+			wantErr:    require.NoError,
+			wantWebUrl: "https://app.example.com",
+			wantRedirectUris: []string{
+				"https://app.example.com/*",
+				"https://app.example.com/api/*",
+				"http://pr-1.preview.example.com/*",
+			},
+			wantWebOrigins: []string{"https://app.example.com", "http://pr-1.preview.example.com"},
+		},
+		{
+			name: "http route merged with static values",
+			spec: keycloakApi.KeycloakClientSpec{
+				WebUrl:       "https://static.example.com",
+				RedirectUris: []string{"https://static.example.com/*"},
+				WebOrigins:   []string{"https://static.example.com"},
+				RedirectFrom: []keycloakApi.RedirectSource{
+					{Kind: keycloakApi.RedirectSourceHTTPRoute, Name: "app", PathSuffix: "{{ .Path }}/oauth2/callback"},
+				},
+			},
+			wantErr:          require.NoError,
+			wantWebUrl:       "https://static.example.com",
+			wantRedirectUris: []string{"https://static.example.com/*", "https://route.example.com/web/oauth2/callback"},
+			wantWebOrigins:   []string{"https://static.example.com", "https://route.example.com"},
+		},
+		{
+			name: "ingress not found",
+			spec: keycloakApi.KeycloakClientSpec{
+				RedirectFrom: []keycloakApi.RedirectSource{{Kind: keycloakApi.RedirectSourceIngress, Name: "missing"}},
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to get Ingress missing")
+			},
+		},
+		{
+			name: "invalid path suffix",
+			spec: keycloakApi.KeycloakClientSpec{
+				RedirectFrom: []keycloakApi.RedirectSource{
+					{Kind: keycloakApi.RedirectSourceIngress, Name: "app", PathSuffix: "{{ .Missing }}"},
+				},
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to render path suffix of Ingress app")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+
+			r := NewReconcileKeycloakClient(
+				fake.NewClientBuilder().WithScheme(s).WithObjects(newTestIngress(), newTestHTTPRoute()).Build(),
+				nil,
+			)
+
+			err := r.applyRedirectFrom(ctrl.LoggerInto(context.Background(), logr.Discard()), kc)
+			tt.wantErr(t, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantWebUrl, kc.Spec.WebUrl)
+			assert.Equal(t, tt.wantRedirectUris, kc.Spec.RedirectUris)
+			assert.Equal(t, tt.wantWebOrigins, kc.Spec.WebOrigins)
+		})
+	}
+}
+
+func TestReconcileKeycloakClient_clientsForRedirectSource(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, keycloakApi.AddToScheme(s))
+
+	newClient := func(namespace, name string, sources ...keycloakApi.RedirectSource) *keycloakApi.KeycloakClient {
+		return &keycloakApi.KeycloakClient{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       keycloakApi.KeycloakClientSpec{ClientId: name, RedirectFrom: sources},
+		}
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			newClient("default", "ingress", keycloakApi.RedirectSource{Kind: keycloakApi.RedirectSourceIngress, Name: "app"}),
+			newClient("default", "route", keycloakApi.RedirectSource{Kind: keycloakApi.RedirectSourceHTTPRoute, Name: "app"}),
+			newClient("other", "other-namespace", keycloakApi.RedirectSource{Kind: keycloakApi.RedirectSourceIngress, Name: "app"}),
+			newClient("default", "without-sources"),
+		).
+		WithIndex(&keycloakApi.KeycloakClient{}, redirectFromIndexField, indexRedirectFrom).
+		Build()
+
+	r := NewReconcileKeycloakClient(k8sClient, nil)
+
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ingress"}},
+	}, r.clientsForRedirectSource(context.Background(), newTestIngress()))
+
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "route"}},
+	}, r.clientsForRedirectSource(context.Background(), newTestHTTPRoute()))
+}
@@ -0,0 +1,226 @@
+package keycloakclient
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+)
+
+// templateRefIndexField is the field index of KeycloakClient resources by the referenced template.
+const templateRefIndexField = "spec.templateRef"
+
+// templateIndexKey returns the index key of the template with the given kind and name.
+func templateIndexKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// templateRefKind returns the kind of the template referenced by the client.
+func templateRefKind(ref *keycloakApi.ClientTemplateRef) string {
+	if ref.Kind == "" {
+		return keycloakApi.KeycloakClientTemplateKind
+	}
+
+	return ref.Kind
+}
+
+// indexTemplateRef indexes KeycloakClient resources by the referenced template.
+func indexTemplateRef(obj client.Object) []string {
+	keycloakClient, ok := obj.(*keycloakApi.KeycloakClient)
+	if !ok || keycloakClient.Spec.TemplateRef == nil {
+		return nil
+	}
+
+	return []string{templateIndexKey(templateRefKind(keycloakClient.Spec.TemplateRef), keycloakClient.Spec.TemplateRef.Name)}
+}
+
+// clientsForTemplate returns reconcile requests for the clients that reference the changed template.
+// Clients reference KeycloakClientTemplate in their own namespace and ClusterKeycloakClientTemplate from any namespace.
+func (r *ReconcileKeycloakClient) clientsForTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	var kind string
+
+	opts := []client.ListOption{}
+
+	switch obj.(type) {
+	case *keycloakApi.KeycloakClientTemplate:
+		kind = keycloakApi.KeycloakClientTemplateKind
+
+		opts = append(opts, client.InNamespace(obj.GetNamespace()))
+	case *keycloakAlpha.ClusterKeycloakClientTemplate:
+		kind = keycloakAlpha.ClusterKeycloakClientTemplateKind
+	default:
+		return nil
+	}
+
+	opts = append(opts, client.MatchingFields{templateRefIndexField: templateIndexKey(kind, obj.GetName())})
+
+	clients := &keycloakApi.KeycloakClientList{}
+	if err := r.client.List(ctx, clients, opts...); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Unable to list clients of the template", "kind", kind, "name", obj.GetName())
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(clients.Items))
+
+	for i := range clients.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: clients.Items[i].Namespace, Name: clients.Items[i].Name},
+		})
+	}
+
+	return requests
+}
+
+// applyTemplate merges the template referenced by the client into the client spec
+// and stores the generation of the template in the status.
+// The merged spec is used only during the reconciliation and is not stored in the resource.
+func (r *ReconcileKeycloakClient) applyTemplate(ctx context.Context, keycloakClient *keycloakApi.KeycloakClient) error {
+	ref := keycloakClient.Spec.TemplateRef
+	if ref == nil {
+		keycloakClient.Status.TemplateGeneration = 0
+
+		return nil
+	}
+
+	var (
+		spec       *keycloakApi.KeycloakClientTemplateSpec
+		generation int64
+	)
+
+	switch kind := templateRefKind(ref); kind {
+	case keycloakApi.KeycloakClientTemplateKind:
+		template := &keycloakApi.KeycloakClientTemplate{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: keycloakClient.Namespace, Name: ref.Name}, template); err != nil {
+			return fmt.Errorf("unable to get %s %s: %w", kind, ref.Name, err)
+		}
+
+		spec, generation = &template.Spec, template.Generation
+	case keycloakAlpha.ClusterKeycloakClientTemplateKind:
+		if !r.clusterTemplates {
+			return fmt.Errorf("%s can be used only if the operator watches all namespaces", kind)
+		}
+
+		template := &keycloakAlpha.ClusterKeycloakClientTemplate{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: ref.Name}, template); err != nil {
+			return fmt.Errorf("unable to get %s %s: %w", kind, ref.Name, err)
+		}
+
+		spec, generation = &template.Spec, template.Generation
+	default:
+		return fmt.Errorf("unsupported template kind %s", kind)
+	}
+
+	mergeTemplate(&keycloakClient.Spec, spec)
+
+	keycloakClient.Status.TemplateGeneration = generation
+
+	ctrl.LoggerFrom(ctx).Info("Client template applied", "template", ref.Name, "generation", generation)
+
+	return nil
+}
+
+// mergeTemplate merges the template into the client spec.
+// Attributes and protocol mappers are merged by key and name, other fields of the template
+// are used only if they are not set in the client. Values of the client take precedence.
+func mergeTemplate(spec *keycloakApi.KeycloakClientSpec, template *keycloakApi.KeycloakClientTemplateSpec) {
+	if spec.Protocol == nil && template.Protocol != nil {
+		spec.Protocol = template.Protocol
+	}
+
+	// Flags of the client can't be distinguished from unset ones if they have the default value,
+	// so the template value is used only if the client flag has the default value.
+	if spec.StandardFlowEnabled && template.StandardFlowEnabled != nil {
+		spec.StandardFlowEnabled = *template.StandardFlowEnabled
+	}
+
+	if !spec.DirectAccess && template.DirectAccess != nil {
+		spec.DirectAccess = *template.DirectAccess
+	}
+
+	if !spec.ImplicitFlowEnabled && template.ImplicitFlowEnabled != nil {
+		spec.ImplicitFlowEnabled = *template.ImplicitFlowEnabled
+	}
+
+	if len(template.Attributes) > 0 {
+		attributes := maps.Clone(template.Attributes)
+		maps.Copy(attributes, spec.Attributes)
+		spec.Attributes = attributes
+	}
+
+	if len(spec.DefaultClientScopes) == 0 {
+		spec.DefaultClientScopes = template.DefaultClientScopes
+	}
+
+	if len(spec.OptionalClientScopes) == 0 {
+		spec.OptionalClientScopes = template.OptionalClientScopes
+	}
+
+	if len(spec.WebOrigins) == 0 {
+		spec.WebOrigins = template.WebOrigins
+	}
+
+	if len(template.ProtocolMappers) > 0 {
+		spec.ProtocolMappers = mergeProtocolMappers(template.ProtocolMappers, spec.ProtocolMappers)
+	}
+
+	if template.AuthenticationFlowBindingOverrides != nil {
+		overrides := *template.AuthenticationFlowBindingOverrides
+
+		if spec.AuthenticationFlowBindingOverrides != nil {
+			if spec.AuthenticationFlowBindingOverrides.Browser != "" {
+				overrides.Browser = spec.AuthenticationFlowBindingOverrides.Browser
+			}
+
+			if spec.AuthenticationFlowBindingOverrides.DirectGrant != "" {
+				overrides.DirectGrant = spec.AuthenticationFlowBindingOverrides.DirectGrant
+			}
+		}
+
+		spec.AuthenticationFlowBindingOverrides = &overrides
+	}
+
+	if template.AdvancedSettings != nil {
+		settings := *template.AdvancedSettings
+
+		if spec.AdvancedSettings != nil && spec.AdvancedSettings.AccessTokenLifespan != nil {
+			settings.AccessTokenLifespan = spec.AdvancedSettings.AccessTokenLifespan
+		}
+
+		spec.AdvancedSettings = &settings
+	}
+}
+
+// mergeProtocolMappers returns the protocol mappers of the template and the client.
+// Client mappers replace template mappers with the same name.
+func mergeProtocolMappers(templateMappers []keycloakApi.ProtocolMapper, clientMappers *[]keycloakApi.ProtocolMapper) *[]keycloakApi.ProtocolMapper {
+	if clientMappers == nil {
+		mappers := append([]keycloakApi.ProtocolMapper(nil), templateMappers...)
+
+		return &mappers
+	}
+
+	names := make(map[string]bool, len(*clientMappers))
+	for _, m := range *clientMappers {
+		names[m.Name] = true
+	}
+
+	mappers := make([]keycloakApi.ProtocolMapper, 0, len(templateMappers)+len(*clientMappers))
+
+	for _, m := range templateMappers {
+		if !names[m.Name] {
+			mappers = append(mappers, m)
+		}
+	}
+
+	mappers = append(mappers, *clientMappers...)
+
+	return &mappers
+}
@@ -0,0 +1,297 @@
+package keycloakclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	keycloakAlpha "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+)
+
+func TestMergeTemplate(t *testing.T) {
+	t.Parallel()
+
+	template := &keycloakApi.KeycloakClientTemplateSpec{
+		Protocol: ptr.To("openid-connect"),
+		Attributes: map[string]string{
+			"pkce.code.challenge.method": "S256",
+			"post.logout.redirect.uris":  "https://template.example.com/*",
+		},
+		DefaultClientScopes:  []string{"profile", "email"},
+		OptionalClientScopes: []string{"offline_access"},
+		WebOrigins:           []string{"+"},
+		ProtocolMappers: []keycloakApi.ProtocolMapper{
+			{Name: "groups", ProtocolMapper: "oidc-group-membership-mapper"},
+			{Name: "audience", ProtocolMapper: "oidc-audience-mapper"},
+		},
+		AuthenticationFlowBindingOverrides: &keycloakApi.AuthenticationFlowBindingOverrides{
+			Browser:     "template-browser",
+			DirectGrant: "template-direct-grant",
+		},
+		AdvancedSettings: &keycloakApi.KeycloakClientAdvancedSettings{AccessTokenLifespan: ptr.To(300)},
+	}
+
+	tests := []struct {
+		name string
+		spec keycloakApi.KeycloakClientSpec
+		want keycloakApi.KeycloakClientSpec
+	}{
+		{
+			name: "empty client spec",
+			spec: keycloakApi.KeycloakClientSpec{ClientId: "app"},
+			want: keycloakApi.KeycloakClientSpec{
+				ClientId:             "app",
+				Protocol:             ptr.To("openid-connect"),
+				Attributes:           template.Attributes,
+				DefaultClientScopes:  []string{"profile", "email"},
+				OptionalClientScopes: []string{"offline_access"},
+				WebOrigins:           []string{"+"},
+				ProtocolMappers:      &template.ProtocolMappers,
+				AuthenticationFlowBindingOverrides: &keycloakApi.AuthenticationFlowBindingOverrides{
+					Browser:     "template-browser",
+					DirectGrant: "template-direct-grant",
+				},
+				AdvancedSettings: &keycloakApi.KeycloakClientAdvancedSettings{AccessTokenLifespan: ptr.To(300)},
+			},
+		},
+		{
+			name: "client values take precedence",
+			spec: keycloakApi.KeycloakClientSpec{
+				ClientId:            "app",
+				Protocol:            ptr.To("saml"),
+				Attributes:          map[string]string{"post.logout.redirect.uris": "+"},
+				DefaultClientScopes: []string{"roles"},
+				WebOrigins:          []string{"https://app.example.com"},
+				ProtocolMappers: &[]keycloakApi.ProtocolMapper{
+					{Name: "groups", ProtocolMapper: "oidc-usermodel-attribute-mapper"},
+				},
+				AuthenticationFlowBindingOverrides: &keycloakApi.AuthenticationFlowBindingOverrides{Browser: "app-browser"},
+				AdvancedSettings:                   &keycloakApi.KeycloakClientAdvancedSettings{AccessTokenLifespan: ptr.To(60)},
+			},
+			want: keycloakApi.KeycloakClientSpec{
+				ClientId: "app",
+				Protocol: ptr.To("saml"),
+				Attributes: map[string]string{
+					"pkce.code.challenge.method": "S256",
+					"post.logout.redirect.uris":  "+",
+				},
+				DefaultClientScopes:  []string{"roles"},
+				OptionalClientScopes: []string{"offline_access"},
+				WebOrigins:           []string{"https://app.example.com"},
+				ProtocolMappers: &[]keycloakApi.ProtocolMapper{
+					{Name: "audience", ProtocolMapper: "oidc-audience-mapper"},
+					{Name: "groups", ProtocolMapper: "oidc-usermodel-attribute-mapper"},
+				},
+				AuthenticationFlowBindingOverrides: &keycloakApi.AuthenticationFlowBindingOverrides{
+					Browser:     "app-browser",
+					DirectGrant: "template-direct-grant",
+				},
+				AdvancedSettings: &keycloakApi.KeycloakClientAdvancedSettings{AccessTokenLifespan: ptr.To(60)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := tt.spec
+			mergeTemplate(&spec, template)
+
+			assert.Equal(t, tt.want, spec)
+		})
+	}
+}
+
+func TestMergeTemplate_Flags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     keycloakApi.KeycloakClientSpec
+		template keycloakApi.KeycloakClientTemplateSpec
+		want     keycloakApi.KeycloakClientSpec
+	}{
+		{
+			name: "flags are not set in template",
+			spec: keycloakApi.KeycloakClientSpec{StandardFlowEnabled: true, DirectAccess: true},
+			want: keycloakApi.KeycloakClientSpec{StandardFlowEnabled: true, DirectAccess: true},
+		},
+		{
+			name: "template flags are used for default client flags",
+			spec: keycloakApi.KeycloakClientSpec{StandardFlowEnabled: true},
+			template: keycloakApi.KeycloakClientTemplateSpec{
+				StandardFlowEnabled: ptr.To(false),
+				DirectAccess:        ptr.To(true),
+				ImplicitFlowEnabled: ptr.To(true),
+			},
+			want: keycloakApi.KeycloakClientSpec{DirectAccess: true, ImplicitFlowEnabled: true},
+		},
+		{
+			name: "client flags take precedence",
+			spec: keycloakApi.KeycloakClientSpec{DirectAccess: true, ImplicitFlowEnabled: true},
+			template: keycloakApi.KeycloakClientTemplateSpec{
+				StandardFlowEnabled: ptr.To(true),
+				DirectAccess:        ptr.To(false),
+				ImplicitFlowEnabled: ptr.To(false),
+			},
+			want: keycloakApi.KeycloakClientSpec{DirectAccess: true, ImplicitFlowEnabled: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := tt.spec
+			mergeTemplate(&spec, &tt.template)
+
+			assert.Equal(t, tt.want, spec)
+		})
+	}
+}
+
+func TestReconcileKeycloakClient_applyTemplate(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(s))
+	require.NoError(t, keycloakAlpha.AddToScheme(s))
+
+	namespacedTemplate := &keycloakApi.KeycloakClientTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Generation: 3},
+		Spec:       keycloakApi.KeycloakClientTemplateSpec{DefaultClientScopes: []string{"profile"}},
+	}
+
+	clusterTemplate := &keycloakAlpha.ClusterKeycloakClientTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Generation: 5},
+		Spec:       keycloakApi.KeycloakClientTemplateSpec{DefaultClientScopes: []string{"email"}},
+	}
+
+	tests := []struct {
+		name             string
+		templateRef      *keycloakApi.ClientTemplateRef
+		clusterTemplates bool
+		wantErr          require.ErrorAssertionFunc
+		wantScopes       []string
+		wantGeneration   int64
+	}{
+		{
+			name:    "template is not referenced",
+			wantErr: require.NoError,
+		},
+		{
+			name:           "namespaced template",
+			templateRef:    &keycloakApi.ClientTemplateRef{Name: "web"},
+			wantErr:        require.NoError,
+			wantScopes:     []string{"profile"},
+			wantGeneration: 3,
+		},
+		{
+			name:             "cluster template",
+			templateRef:      &keycloakApi.ClientTemplateRef{Kind: keycloakAlpha.ClusterKeycloakClientTemplateKind, Name: "web"},
+			clusterTemplates: true,
+			wantErr:          require.NoError,
+			wantScopes:       []string{"email"},
+			wantGeneration:   5,
+		},
+		{
+			name:        "cluster templates are disabled",
+			templateRef: &keycloakApi.ClientTemplateRef{Kind: keycloakAlpha.ClusterKeycloakClientTemplateKind, Name: "web"},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "can be used only if the operator watches all namespaces")
+			},
+		},
+		{
+			name:        "template not found",
+			templateRef: &keycloakApi.ClientTemplateRef{Name: "missing"},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to get KeycloakClientTemplate missing")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kc := &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+				Spec:       keycloakApi.KeycloakClientSpec{ClientId: "app", TemplateRef: tt.templateRef},
+				Status:     keycloakApi.KeycloakClientStatus{TemplateGeneration: 1},
+			}
+
+			r := NewReconcileKeycloakClient(
+				fake.NewClientBuilder().WithScheme(s).WithObjects(namespacedTemplate, clusterTemplate).Build(),
+				nil,
+			)
+			r.clusterTemplates = tt.clusterTemplates
+
+			err := r.applyTemplate(ctrl.LoggerInto(context.Background(), logr.Discard()), kc)
+			tt.wantErr(t, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantScopes, kc.Spec.DefaultClientScopes)
+			assert.Equal(t, tt.wantGeneration, kc.Status.TemplateGeneration)
+		})
+	}
+}
+
+func TestReconcileKeycloakClient_clientsForTemplate(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(s))
+	require.NoError(t, keycloakAlpha.AddToScheme(s))
+
+	newClient := func(namespace, name string, ref *keycloakApi.ClientTemplateRef) *keycloakApi.KeycloakClient {
+		return &keycloakApi.KeycloakClient{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       keycloakApi.KeycloakClientSpec{ClientId: name, TemplateRef: ref},
+		}
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			newClient("default", "namespaced", &keycloakApi.ClientTemplateRef{Name: "web"}),
+			newClient("other", "other-namespace", &keycloakApi.ClientTemplateRef{Name: "web"}),
+			newClient("other", "cluster", &keycloakApi.ClientTemplateRef{
+				Kind: keycloakAlpha.ClusterKeycloakClientTemplateKind,
+				Name: "web",
+			}),
+			newClient("default", "without-template", nil),
+		).
+		WithIndex(&keycloakApi.KeycloakClient{}, templateRefIndexField, indexTemplateRef).
+		Build()
+
+	r := NewReconcileKeycloakClient(k8sClient, nil)
+
+	requests := r.clientsForTemplate(context.Background(), &keycloakApi.KeycloakClientTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+	})
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "namespaced"}},
+	}, requests)
+
+	requests = r.clientsForTemplate(context.Background(), &keycloakAlpha.ClusterKeycloakClientTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "web"},
+	})
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "other", Name: "cluster"}},
+	}, requests)
+}
@@ -0,0 +1,464 @@
+package keycloakclientinitialaccesstoken
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
+)
+
+const (
+	successRequeueTime = time.Minute * 10
+
+	// tokenSpecAnnotation holds a hash of the token settings the token in the Secret was issued with.
+	// Keycloak doesn't return web origins of the token, so changes of the settings are detected by the hash.
+	tokenSpecAnnotation = "edp.epam.com/initial-access-token-spec"
+)
+
+type Helper interface {
+	SetFailureCount(fc helper.FailureCountable) time.Duration
+	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
+	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
+	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
+}
+
+// ReconcileKeycloakClientInitialAccessToken reconciles a KeycloakClientInitialAccessToken object.
+type ReconcileKeycloakClientInitialAccessToken struct {
+	client client.Client
+	helper Helper
+	now    func() time.Time
+}
+
+func NewReconcileKeycloakClientInitialAccessToken(
+	k8sClient client.Client,
+	controllerHelper Helper,
+) *ReconcileKeycloakClientInitialAccessToken {
+	return &ReconcileKeycloakClientInitialAccessToken{
+		client: k8sClient,
+		helper: controllerHelper,
+		now:    time.Now,
+	}
+}
+
+func (r *ReconcileKeycloakClientInitialAccessToken) SetupWithManager(mgr ctrl.Manager) error {
+	pred := predicate.Or(predicate.GenerationChangedPredicate{}, pause.AnnotationsChanged())
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakClientInitialAccessToken{}, builder.WithPredicates(pred)).
+		Owns(&corev1.Secret{}).
+		Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakClientInitialAccessToken{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakClientInitialAccessToken{}, r, pause.WithObserveModeSkip(r.helper, common.FinalizerName)))))
+	if err != nil {
+		return fmt.Errorf("failed to setup KeycloakClientInitialAccessToken controller: %w", err)
+	}
+
+	return nil
+}
+
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclientinitialaccesstokens,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclientinitialaccesstokens/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclientinitialaccesstokens/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile keeps a valid initial access token in the Secret.
+func (r *ReconcileKeycloakClientInitialAccessToken) Reconcile(
+	ctx context.Context,
+	request reconcile.Request,
+) (result reconcile.Result, resultErr error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling KeycloakClientInitialAccessToken")
+
+	token := &keycloakApi.KeycloakClientInitialAccessToken{}
+	if err := r.client.Get(ctx, request.NamespacedName, token); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("unable to get KeycloakClientInitialAccessToken: %w", err)
+	}
+
+	if token.GetDeletionTimestamp() != nil {
+		if err := r.handleDeletion(ctx, token); err != nil {
+			if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
+				return ctrl.Result{RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod}, nil
+			}
+
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	if controllerutil.AddFinalizer(token, common.FinalizerName) {
+		if err := r.client.Update(ctx, token); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer to KeycloakClientInitialAccessToken: %w", err)
+		}
+	}
+
+	oldStatus := token.Status.DeepCopy()
+
+	requeueAfter, err := r.tryReconcile(ctx, token)
+	if err != nil {
+		events.Error(ctx, token, err)
+		status.SetFailed(token, err)
+
+		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
+			return ctrl.Result{RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod}, nil
+		}
+
+		log.Error(err, "An error has occurred while handling KeycloakClientInitialAccessToken")
+
+		token.Status.Value = err.Error()
+		result.RequeueAfter = r.helper.SetFailureCount(token)
+	} else {
+		helper.SetSuccessStatus(token)
+		status.SetReady(token, "Reconciliation succeeded")
+
+		result.RequeueAfter = requeueAfter
+	}
+
+	if !equality.Semantic.DeepEqual(&token.Status, oldStatus) {
+		if err := r.client.Status().Update(ctx, token); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to update KeycloakClientInitialAccessToken status: %w", err)
+		}
+	}
+
+	log.Info("Reconciling done")
+
+	return result, nil
+}
+
+// tryReconcile issues a new token if the current one is missing, expired, exhausted or outdated.
+// It returns the duration until the next check.
+func (r *ReconcileKeycloakClientInitialAccessToken) tryReconcile(
+	ctx context.Context,
+	token *keycloakApi.KeycloakClientInitialAccessToken,
+) (time.Duration, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if err := r.helper.SetRealmOwnerRef(ctx, token); err != nil {
+		return 0, fmt.Errorf("unable to set realm owner ref: %w", err)
+	}
+
+	kClient, err := r.helper.CreateKeycloakClientFromRealmRef(ctx, token)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create keycloak client from realm ref: %w", err)
+	}
+
+	realmName, err := r.helper.GetRealmNameFromRef(ctx, token)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get realm name from ref: %w", err)
+	}
+
+	current, err := r.getCurrentToken(ctx, kClient, realmName, token.Status.TokenID)
+	if err != nil {
+		return 0, err
+	}
+
+	secret, err := r.getSecret(ctx, token)
+	if err != nil {
+		return 0, err
+	}
+
+	now := r.now()
+	specHash := hashTokenSpec(&token.Spec)
+
+	if reason := renewalReason(token, current, secret, specHash, now); reason != "" {
+		log.Info("Issuing new initial access token", "reason", reason)
+
+		current, err = r.issueToken(ctx, kClient, realmName, token, current, specHash)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	setTokenStatus(token, current)
+
+	if token.Status.ExpiresAt != nil {
+		if untilExpiration := token.Status.ExpiresAt.Sub(now); untilExpiration < successRequeueTime {
+			return max(untilExpiration, time.Second), nil
+		}
+	}
+
+	return successRequeueTime, nil
+}
+
+func (r *ReconcileKeycloakClientInitialAccessToken) getCurrentToken(
+	ctx context.Context,
+	kClient *keycloakapi.KeycloakClient,
+	realmName, tokenID string,
+) (*keycloakapi.ClientInitialAccessPresentation, error) {
+	if tokenID == "" {
+		return nil, nil
+	}
+
+	tokens, _, err := kClient.ClientInitialAccess.GetInitialAccessTokens(ctx, realmName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get initial access tokens: %w", err)
+	}
+
+	for i := range tokens {
+		if ptr.Deref(tokens[i].Id, "") == tokenID {
+			return &tokens[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *ReconcileKeycloakClientInitialAccessToken) getSecret(
+	ctx context.Context,
+	token *keycloakApi.KeycloakClientInitialAccessToken,
+) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: token.Namespace, Name: token.GetSecretName()}, secret)
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("unable to get token secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+// renewalReason returns a reason to issue a new token, or an empty string if the current token is valid.
+func renewalReason(
+	token *keycloakApi.KeycloakClientInitialAccessToken,
+	current *keycloakapi.ClientInitialAccessPresentation,
+	secret *corev1.Secret,
+	specHash string,
+	now time.Time,
+) string {
+	switch {
+	case current == nil:
+		return "token not found"
+	case ptr.Deref(current.RemainingCount, 0) <= 0:
+		return "token is exhausted"
+	case isExpired(current, now):
+		return "token is expired"
+	case secret == nil || len(secret.Data[keycloakApi.ClientInitialAccessTokenSecretKey]) == 0:
+		return "token secret not found"
+	case secret.Annotations[tokenSpecAnnotation] != specHash:
+		return "token settings changed"
+	case token.Status.SecretName != token.GetSecretName():
+		return "token secret name changed"
+	default:
+		return ""
+	}
+}
+
+func (r *ReconcileKeycloakClientInitialAccessToken) issueToken(
+	ctx context.Context,
+	kClient *keycloakapi.KeycloakClient,
+	realmName string,
+	token *keycloakApi.KeycloakClientInitialAccessToken,
+	current *keycloakapi.ClientInitialAccessPresentation,
+	specHash string,
+) (*keycloakapi.ClientInitialAccessPresentation, error) {
+	if current != nil {
+		if err := deleteToken(ctx, kClient, realmName, ptr.Deref(current.Id, "")); err != nil {
+			return nil, err
+		}
+	}
+
+	req := keycloakapi.ClientInitialAccessCreatePresentation{
+		Count:      ptr.To(token.Spec.Count),
+		Expiration: ptr.To(token.Spec.Expiration),
+	}
+
+	if len(token.Spec.WebOrigins) > 0 {
+		req.WebOrigins = ptr.To(token.Spec.WebOrigins)
+	}
+
+	created, _, err := kClient.ClientInitialAccess.CreateInitialAccessToken(ctx, realmName, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create initial access token: %w", err)
+	}
+
+	if ptr.Deref(created.Token, "") == "" {
+		return nil, errors.New("keycloak returned empty initial access token")
+	}
+
+	if err := r.storeToken(ctx, token, *created.Token, specHash); err != nil {
+		return nil, err
+	}
+
+	if oldSecretName := token.Status.SecretName; oldSecretName != "" && oldSecretName != token.GetSecretName() {
+		if err := r.deleteSecret(ctx, token.Namespace, oldSecretName); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keycloak doesn't return the remaining count on creation.
+	if created.RemainingCount == nil {
+		created.RemainingCount = created.Count
+	}
+
+	return created, nil
+}
+
+func (r *ReconcileKeycloakClientInitialAccessToken) storeToken(
+	ctx context.Context,
+	token *keycloakApi.KeycloakClientInitialAccessToken,
+	value, specHash string,
+) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      token.GetSecretName(),
+			Namespace: token.Namespace,
+		},
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.client, secret, func() error {
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+
+		secret.Annotations[tokenSpecAnnotation] = specHash
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			keycloakApi.ClientInitialAccessTokenSecretKey: []byte(value),
+		}
+
+		return controllerutil.SetControllerReference(token, secret, r.client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("unable to store initial access token in secret: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReconcileKeycloakClientInitialAccessToken) deleteSecret(ctx context.Context, namespace, name string) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+
+	if err := r.client.Delete(ctx, secret); err != nil && !k8sErrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete outdated token secret: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReconcileKeycloakClientInitialAccessToken) handleDeletion(
+	ctx context.Context,
+	token *keycloakApi.KeycloakClientInitialAccessToken,
+) error {
+	if !controllerutil.ContainsFinalizer(token, common.FinalizerName) {
+		return nil
+	}
+
+	if token.Status.TokenID != "" && !objectmeta.PreserveResourcesOnDeletion(token, r.helper.DefaultDeletionPolicy()) {
+		kClient, err := r.helper.CreateKeycloakClientFromRealmRef(ctx, token)
+		if err != nil {
+			if errors.Is(err, helper.ErrKeycloakRealmNotFound) {
+				_, removeErr := helper.RemoveFinalizersOnRealmNotFound(ctx, r.client, token, common.FinalizerName)
+
+				return removeErr
+			}
+
+			return fmt.Errorf("unable to create keycloak client from realm ref: %w", err)
+		}
+
+		realmName, err := r.helper.GetRealmNameFromRef(ctx, token)
+		if err != nil {
+			return fmt.Errorf("unable to get realm name from ref: %w", err)
+		}
+
+		if err := deleteToken(ctx, kClient, realmName, token.Status.TokenID); err != nil {
+			return err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(token, common.FinalizerName)
+
+	if err := r.client.Update(ctx, token); err != nil {
+		return fmt.Errorf("failed to update KeycloakClientInitialAccessToken after finalizer removal: %w", err)
+	}
+
+	return nil
+}
+
+func deleteToken(ctx context.Context, kClient *keycloakapi.KeycloakClient, realmName, tokenID string) error {
+	if _, err := kClient.ClientInitialAccess.DeleteInitialAccessToken(ctx, realmName, tokenID); err != nil &&
+		!keycloakapi.IsNotFound(err) {
+		return fmt.Errorf("unable to delete initial access token: %w", err)
+	}
+
+	return nil
+}
+
+func isExpired(current *keycloakapi.ClientInitialAccessPresentation, now time.Time) bool {
+	expiresAt := expirationTime(current)
+
+	return expiresAt != nil && !now.Before(*expiresAt)
+}
+
+// expirationTime returns the token expiration time or nil if the token doesn't expire.
+func expirationTime(current *keycloakapi.ClientInitialAccessPresentation) *time.Time {
+	expiration := ptr.Deref(current.Expiration, 0)
+	if expiration <= 0 || current.Timestamp == nil {
+		return nil
+	}
+
+	t := time.Unix(int64(*current.Timestamp)+int64(expiration), 0)
+
+	return &t
+}
+
+func setTokenStatus(token *keycloakApi.KeycloakClientInitialAccessToken, current *keycloakapi.ClientInitialAccessPresentation) {
+	token.Status.TokenID = ptr.Deref(current.Id, "")
+	token.Status.SecretName = token.GetSecretName()
+	token.Status.RemainingCount = ptr.Deref(current.RemainingCount, 0)
+	token.Status.IssuedAt = nil
+	token.Status.ExpiresAt = nil
+
+	if current.Timestamp != nil {
+		token.Status.IssuedAt = &metav1.Time{Time: time.Unix(int64(*current.Timestamp), 0)}
+	}
+
+	if expiresAt := expirationTime(current); expiresAt != nil {
+		token.Status.ExpiresAt = &metav1.Time{Time: *expiresAt}
+	}
+}
+
+// hashTokenSpec returns a hash of the token settings.
+func hashTokenSpec(spec *keycloakApi.KeycloakClientInitialAccessTokenSpec) string {
+	// Marshaling of a struct with basic types can't fail.
+	raw, _ := json.Marshal(struct {
+		Count      int32    `json:"count"`
+		Expiration int32    `json:"expiration"`
+		WebOrigins []string `json:"webOrigins"`
+	}{spec.Count, spec.Expiration, spec.WebOrigins})
+
+	sum := sha256.Sum256(raw)
+
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,370 @@
+package keycloakclientinitialaccesstoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	helpermock "github.com/epam/edp-keycloak-operator/internal/controller/helper/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapimocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+var testNow = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestToken() *keycloakApi.KeycloakClientInitialAccessToken {
+	return &keycloakApi.KeycloakClientInitialAccessToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "token",
+			Namespace: "default",
+		},
+		Spec: keycloakApi.KeycloakClientInitialAccessTokenSpec{
+			RealmRef: common.RealmRef{
+				Kind: keycloakApi.KeycloakRealmKind,
+				Name: "realm",
+			},
+			Count:      2,
+			Expiration: 3600,
+		},
+	}
+}
+
+func newTestSecret(token *keycloakApi.KeycloakClientInitialAccessToken) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      token.GetSecretName(),
+			Namespace: token.Namespace,
+			Annotations: map[string]string{
+				tokenSpecAnnotation: hashTokenSpec(&token.Spec),
+			},
+		},
+		Data: map[string][]byte{
+			keycloakApi.ClientInitialAccessTokenSecretKey: []byte("old-token"),
+		},
+	}
+}
+
+func newIssuedToken(id string, remaining int32, issuedAt time.Time) keycloakapi.ClientInitialAccessPresentation {
+	return keycloakapi.ClientInitialAccessPresentation{
+		Id:             ptr.To(id),
+		Count:          ptr.To(int32(2)),
+		RemainingCount: ptr.To(remaining),
+		Expiration:     ptr.To(int32(3600)),
+		Timestamp:      ptr.To(int32(issuedAt.Unix())),
+	}
+}
+
+func newHelper(
+	t *testing.T,
+	tokens *keycloakapimocks.MockClientInitialAccessClient,
+) *helpermock.MockControllerHelper {
+	h := helpermock.NewMockControllerHelper(t)
+
+	h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil).Maybe()
+	h.On("CreateKeycloakClientFromRealmRef", mock.Anything, mock.Anything).
+		Return(&keycloakapi.KeycloakClient{ClientInitialAccess: tokens}, nil)
+	h.On("GetRealmNameFromRef", mock.Anything, mock.Anything).Return("test-realm", nil)
+
+	return h
+}
+
+func TestReconcileKeycloakClientInitialAccessToken_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	tests := []struct {
+		name       string
+		objects    func() []client.Object
+		helper     func(t *testing.T) *helpermock.MockControllerHelper
+		wantResult reconcile.Result
+		wantErr    require.ErrorAssertionFunc
+		check      func(t *testing.T, k8sClient client.Client)
+	}{
+		{
+			name: "token and secret are created",
+			objects: func() []client.Object {
+				return []client.Object{newTestToken()}
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				tokens := keycloakapimocks.NewMockClientInitialAccessClient(t)
+
+				tokens.EXPECT().CreateInitialAccessToken(mock.Anything, "test-realm", mock.MatchedBy(
+					func(req keycloakapi.ClientInitialAccessCreatePresentation) bool {
+						return *req.Count == 2 && *req.Expiration == 3600 && req.WebOrigins == nil
+					})).
+					Return(&keycloakapi.ClientInitialAccessPresentation{
+						Id:         ptr.To("token-id"),
+						Token:      ptr.To("new-token"),
+						Count:      ptr.To(int32(2)),
+						Expiration: ptr.To(int32(3600)),
+						Timestamp:  ptr.To(int32(testNow.Unix())),
+					}, nil, nil)
+
+				return newHelper(t, tokens)
+			},
+			wantResult: reconcile.Result{RequeueAfter: successRequeueTime},
+			wantErr:    require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				token := &keycloakApi.KeycloakClientInitialAccessToken{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, token))
+
+				assert.Equal(t, common.StatusOK, token.Status.Value)
+				assert.Equal(t, "token-id", token.Status.TokenID)
+				assert.Equal(t, "token", token.Status.SecretName)
+				assert.Equal(t, int32(2), token.Status.RemainingCount)
+				require.NotNil(t, token.Status.ExpiresAt)
+				assert.True(t, token.Status.ExpiresAt.Equal(&metav1.Time{Time: testNow.Add(time.Hour)}))
+				assert.Contains(t, token.Finalizers, common.FinalizerName)
+
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, secret))
+
+				assert.Equal(t, "new-token", string(secret.Data[keycloakApi.ClientInitialAccessTokenSecretKey]))
+				assert.Equal(t, hashTokenSpec(&token.Spec), secret.Annotations[tokenSpecAnnotation])
+				require.Len(t, secret.OwnerReferences, 1)
+				assert.Equal(t, "token", secret.OwnerReferences[0].Name)
+			},
+		},
+		{
+			name: "valid token is kept",
+			objects: func() []client.Object {
+				token := newTestToken()
+				token.Finalizers = []string{common.FinalizerName}
+				token.Status.TokenID = "token-id"
+				token.Status.SecretName = "token"
+
+				return []client.Object{token, newTestSecret(token)}
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				tokens := keycloakapimocks.NewMockClientInitialAccessClient(t)
+
+				tokens.EXPECT().GetInitialAccessTokens(mock.Anything, "test-realm").
+					Return([]keycloakapi.ClientInitialAccessPresentation{
+						newIssuedToken("token-id", 1, testNow.Add(-55*time.Minute)),
+					}, nil, nil)
+
+				return newHelper(t, tokens)
+			},
+			wantResult: reconcile.Result{RequeueAfter: 5 * time.Minute},
+			wantErr:    require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				token := &keycloakApi.KeycloakClientInitialAccessToken{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, token))
+
+				assert.Equal(t, "token-id", token.Status.TokenID)
+				assert.Equal(t, int32(1), token.Status.RemainingCount)
+
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, secret))
+
+				assert.Equal(t, "old-token", string(secret.Data[keycloakApi.ClientInitialAccessTokenSecretKey]))
+			},
+		},
+		{
+			name: "exhausted token is replaced",
+			objects: func() []client.Object {
+				token := newTestToken()
+				token.Finalizers = []string{common.FinalizerName}
+				token.Status.TokenID = "token-id"
+				token.Status.SecretName = "token"
+
+				return []client.Object{token, newTestSecret(token)}
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				tokens := keycloakapimocks.NewMockClientInitialAccessClient(t)
+
+				tokens.EXPECT().GetInitialAccessTokens(mock.Anything, "test-realm").
+					Return([]keycloakapi.ClientInitialAccessPresentation{
+						newIssuedToken("token-id", 0, testNow.Add(-time.Minute)),
+					}, nil, nil)
+				tokens.EXPECT().DeleteInitialAccessToken(mock.Anything, "test-realm", "token-id").Return(nil, nil)
+				tokens.EXPECT().CreateInitialAccessToken(mock.Anything, "test-realm", mock.Anything).
+					Return(&keycloakapi.ClientInitialAccessPresentation{
+						Id:         ptr.To("new-token-id"),
+						Token:      ptr.To("new-token"),
+						Count:      ptr.To(int32(2)),
+						Expiration: ptr.To(int32(3600)),
+						Timestamp:  ptr.To(int32(testNow.Unix())),
+					}, nil, nil)
+
+				return newHelper(t, tokens)
+			},
+			wantResult: reconcile.Result{RequeueAfter: successRequeueTime},
+			wantErr:    require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				token := &keycloakApi.KeycloakClientInitialAccessToken{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, token))
+
+				assert.Equal(t, "new-token-id", token.Status.TokenID)
+				assert.Equal(t, int32(2), token.Status.RemainingCount)
+
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, secret))
+
+				assert.Equal(t, "new-token", string(secret.Data[keycloakApi.ClientInitialAccessTokenSecretKey]))
+			},
+		},
+		{
+			name: "expired token is replaced in renamed secret",
+			objects: func() []client.Object {
+				token := newTestToken()
+				token.Finalizers = []string{common.FinalizerName}
+				token.Status.TokenID = "token-id"
+				token.Status.SecretName = "token"
+				secret := newTestSecret(token)
+				token.Spec.SecretName = "renamed"
+
+				return []client.Object{token, secret}
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				tokens := keycloakapimocks.NewMockClientInitialAccessClient(t)
+
+				tokens.EXPECT().GetInitialAccessTokens(mock.Anything, "test-realm").
+					Return([]keycloakapi.ClientInitialAccessPresentation{
+						newIssuedToken("token-id", 2, testNow.Add(-2*time.Hour)),
+					}, nil, nil)
+				tokens.EXPECT().DeleteInitialAccessToken(mock.Anything, "test-realm", "token-id").
+					Return(nil, &keycloakapi.ApiError{Code: 404})
+				tokens.EXPECT().CreateInitialAccessToken(mock.Anything, "test-realm", mock.Anything).
+					Return(&keycloakapi.ClientInitialAccessPresentation{
+						Id:         ptr.To("new-token-id"),
+						Token:      ptr.To("new-token"),
+						Count:      ptr.To(int32(2)),
+						Expiration: ptr.To(int32(3600)),
+						Timestamp:  ptr.To(int32(testNow.Unix())),
+					}, nil, nil)
+
+				return newHelper(t, tokens)
+			},
+			wantResult: reconcile.Result{RequeueAfter: successRequeueTime},
+			wantErr:    require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				token := &keycloakApi.KeycloakClientInitialAccessToken{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, token))
+
+				assert.Equal(t, "new-token-id", token.Status.TokenID)
+				assert.Equal(t, "renamed", token.Status.SecretName)
+
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "renamed", Namespace: "default",
+				}, secret))
+				assert.Equal(t, "new-token", string(secret.Data[keycloakApi.ClientInitialAccessTokenSecretKey]))
+
+				err := k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, &corev1.Secret{})
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "keycloak error is set to status",
+			objects: func() []client.Object {
+				return []client.Object{newTestToken()}
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				tokens := keycloakapimocks.NewMockClientInitialAccessClient(t)
+
+				tokens.EXPECT().CreateInitialAccessToken(mock.Anything, "test-realm", mock.Anything).
+					Return(nil, nil, errors.New("create error"))
+
+				h := newHelper(t, tokens)
+				h.On("SetFailureCount", mock.Anything).Return(time.Minute)
+
+				return h
+			},
+			wantResult: reconcile.Result{RequeueAfter: time.Minute},
+			wantErr:    require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				token := &keycloakApi.KeycloakClientInitialAccessToken{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, token))
+
+				assert.Contains(t, token.Status.Value, "create error")
+			},
+		},
+		{
+			name: "token is deleted",
+			objects: func() []client.Object {
+				token := newTestToken()
+				token.Finalizers = []string{common.FinalizerName}
+				token.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				token.Status.TokenID = "token-id"
+
+				return []client.Object{token}
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				tokens := keycloakapimocks.NewMockClientInitialAccessClient(t)
+
+				tokens.EXPECT().DeleteInitialAccessToken(mock.Anything, "test-realm", "token-id").Return(nil, nil)
+
+				h := newHelper(t, tokens)
+				h.EXPECT().DefaultDeletionPolicy().Return(common.DeletionPolicyDelete)
+
+				return h
+			},
+			wantErr: require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				err := k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "token", Namespace: "default",
+				}, &keycloakApi.KeycloakClientInitialAccessToken{})
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects()...).
+				WithStatusSubresource(&keycloakApi.KeycloakClientInitialAccessToken{}).
+				Build()
+
+			r := NewReconcileKeycloakClientInitialAccessToken(k8sClient, tt.helper(t))
+			r.now = func() time.Time { return testNow }
+
+			res, err := r.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: "token", Namespace: "default"},
+			})
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantResult, res)
+			tt.check(t, k8sClient)
+		})
+	}
+}
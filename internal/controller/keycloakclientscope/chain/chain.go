@@ -7,6 +7,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -39,6 +40,8 @@ func (ch *Chain) Serve(
 		h := ch.handlers[i]
 
 		err := h.Serve(ctx, scope, realmName)
+		status.SetStep(scope, stepCondition(h), err)
+
 		if err != nil {
 			log.Info("KeycloakClientScope chain finished with error")
 
@@ -0,0 +1,28 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionClientScopeSynced indicates whether the client scope has been created or updated in Keycloak.
+	ConditionClientScopeSynced = "ClientScopeSynced"
+
+	// ConditionProtocolMappersSynced indicates whether the protocol mappers have been synced to Keycloak.
+	ConditionProtocolMappersSynced = "ProtocolMappersSynced"
+
+	// ConditionScopeTypeSynced indicates whether the client scope type has been set in the realm.
+	ConditionScopeTypeSynced = "ScopeTypeSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h ClientScopeHandler) string {
+	switch h.(type) {
+	case *CreateOrUpdateScope:
+		return ConditionClientScopeSynced
+	case *SyncProtocolMappers:
+		return ConditionProtocolMappersSynced
+	case *SetScopeType:
+		return ConditionScopeTypeSynced
+	default:
+		return ""
+	}
+}
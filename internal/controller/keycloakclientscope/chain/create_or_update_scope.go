@@ -7,7 +7,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 type CreateOrUpdateScope struct {
@@ -50,20 +53,43 @@ func (h *CreateOrUpdateScope) Serve(
 		}
 
 		scope.Status.ID = keycloakapi.GetResourceIDFromResponse(resp)
+
+		events.Normal(ctx, scope, events.ReasonCreated, "Client scope %s created", spec.Name)
 	} else {
 		if existingScope.Id != nil {
 			scope.Status.ID = *existingScope.Id
 		}
 
-		_, err := scopesClient.UpdateClientScope(ctx, realmName, scope.Status.ID, keycloakapi.ClientScopeRepresentation{
+		desired := keycloakapi.ClientScopeRepresentation{
 			Name:        &spec.Name,
 			Protocol:    &protocol,
 			Description: &desc,
 			Attributes:  &attrs,
+		}
+
+		unchanged, err := drift.Equal(desired, keycloakapi.ClientScopeRepresentation{
+			Name:        existingScope.Name,
+			Protocol:    existingScope.Protocol,
+			Description: existingScope.Description,
+			Attributes:  existingScope.Attributes,
 		})
 		if err != nil {
+			return fmt.Errorf("failed to compare client scope: %w", err)
+		}
+
+		metrics.RecordWrite(keycloakApi.KeycloakClientScopeKind, !unchanged)
+
+		if unchanged {
+			log.Info("Client scope is up to date, skipping update")
+
+			return nil
+		}
+
+		if _, err = scopesClient.UpdateClientScope(ctx, realmName, scope.Status.ID, desired); err != nil {
 			return fmt.Errorf("failed to update client scope: %w", err)
 		}
+
+		events.Normal(ctx, scope, events.ReasonUpdated, "Client scope %s updated", spec.Name)
 	}
 
 	log.Info("Client scope has been synced")
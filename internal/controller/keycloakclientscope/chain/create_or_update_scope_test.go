@@ -6,11 +6,13 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/utils/ptr"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
 )
@@ -95,10 +97,46 @@ func TestCreateOrUpdateScope_Serve_UpdateExisting(t *testing.T) {
 		},
 	).Return(nil, nil)
 
+	updated := metrics.KeycloakObjectOperations.WithLabelValues(metrics.KindOf(scope), metrics.OperationUpdated)
+	updatesBefore := testutil.ToFloat64(updated)
+
+	h := NewCreateOrUpdateScope(kClient)
+	err := h.Serve(context.Background(), scope, testRealmName)
+	require.NoError(t, err)
+	assert.Equal(t, testScopeID, scope.Status.ID)
+	assert.Equal(t, updatesBefore+1, testutil.ToFloat64(updated))
+}
+
+func TestCreateOrUpdateScope_Serve_UpToDate(t *testing.T) {
+	mockScopes := mocks.NewMockClientScopesClient(t)
+	kClient := &keycloakapi.KeycloakClient{ClientScopes: mockScopes}
+
+	scope := &keycloakApi.KeycloakClientScope{}
+	scope.Spec.Name = testScopeName
+	scope.Spec.Protocol = testProtocolOIDC
+	scope.Spec.Description = "Test description"
+	scope.Spec.Attributes = map[string]string{"key": "val"}
+
+	mockScopes.EXPECT().GetClientScopes(
+		context.Background(), testRealmName,
+	).Return([]keycloakapi.ClientScopeRepresentation{
+		{
+			Id:          ptr.To(testScopeID),
+			Name:        ptr.To(testScopeName),
+			Protocol:    ptr.To(testProtocolOIDC),
+			Description: ptr.To("Test description"),
+			Attributes:  &map[string]string{"key": "val"},
+		},
+	}, nil, nil)
+
+	updated := metrics.KeycloakObjectOperations.WithLabelValues(metrics.KindOf(scope), metrics.OperationUpdated)
+	updatesBefore := testutil.ToFloat64(updated)
+
 	h := NewCreateOrUpdateScope(kClient)
 	err := h.Serve(context.Background(), scope, testRealmName)
 	require.NoError(t, err)
 	assert.Equal(t, testScopeID, scope.Status.ID)
+	assert.Equal(t, updatesBefore, testutil.ToFloat64(updated), "no-op resync must not be counted as an update")
 }
 
 func TestCreateOrUpdateScope_Serve_GetScopesError(t *testing.T) {
@@ -6,17 +6,20 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
 type RemoveScope struct {
-	kClient *keycloakapi.KeycloakClient
+	kClient               *keycloakapi.KeycloakClient
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
-func NewRemoveScope(kClient *keycloakapi.KeycloakClient) *RemoveScope {
-	return &RemoveScope{kClient: kClient}
+func NewRemoveScope(kClient *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) *RemoveScope {
+	return &RemoveScope{kClient: kClient, defaultDeletionPolicy: defaultDeletionPolicy}
 }
 
 func (h *RemoveScope) Serve(ctx context.Context, scope *keycloakApi.KeycloakClientScope, realmName string) error {
@@ -24,7 +27,7 @@ func (h *RemoveScope) Serve(ctx context.Context, scope *keycloakApi.KeycloakClie
 
 	log.Info("Start removing client scope")
 
-	if objectmeta.PreserveResourcesOnDeletion(scope) {
+	if objectmeta.PreserveResourcesOnDeletion(scope, h.defaultDeletionPolicy) {
 		log.Info("Preserve resources on deletion, skipping")
 
 		return nil
@@ -53,6 +56,7 @@ func (h *RemoveScope) Serve(ctx context.Context, scope *keycloakApi.KeycloakClie
 	}
 
 	log.Info("Client scope deleted successfully")
+	events.Normal(ctx, scope, events.ReasonDeleted, "Client scope %s deleted", scope.Spec.Name)
 
 	return nil
 }
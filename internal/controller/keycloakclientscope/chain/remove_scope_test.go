@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -33,7 +34,7 @@ func TestRemoveScope_Serve_Success(t *testing.T) {
 		context.Background(), testRealmName, testScopeID,
 	).Return(nil, nil)
 
-	h := NewRemoveScope(kClient)
+	h := NewRemoveScope(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), scope, testRealmName)
 	require.NoError(t, err)
 }
@@ -57,7 +58,7 @@ func TestRemoveScope_Serve_NotFound(t *testing.T) {
 		context.Background(), testRealmName, testScopeID,
 	).Return(nil, keycloakapi.ErrNotFound)
 
-	h := NewRemoveScope(kClient)
+	h := NewRemoveScope(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), scope, testRealmName)
 	require.NoError(t, err)
 }
@@ -74,7 +75,7 @@ func TestRemoveScope_Serve_PreserveOnDeletion(t *testing.T) {
 	}
 	scope.Status.ID = testScopeID
 
-	h := NewRemoveScope(kClient)
+	h := NewRemoveScope(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), scope, testRealmName)
 	require.NoError(t, err)
 }
@@ -98,7 +99,7 @@ func TestRemoveScope_Serve_DeleteError(t *testing.T) {
 		context.Background(), testRealmName, testScopeID,
 	).Return(nil, errors.New("api error"))
 
-	h := NewRemoveScope(kClient)
+	h := NewRemoveScope(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), scope, testRealmName)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to delete client scope")
@@ -115,7 +116,7 @@ func TestRemoveScope_Serve_RemoveDefaultError(t *testing.T) {
 		context.Background(), testRealmName, testScopeID,
 	).Return(nil, errors.New("api error"))
 
-	h := NewRemoveScope(kClient)
+	h := NewRemoveScope(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), scope, testRealmName)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to remove scope from default list")
@@ -136,7 +137,7 @@ func TestRemoveScope_Serve_RemoveOptionalError(t *testing.T) {
 		context.Background(), testRealmName, testScopeID,
 	).Return(nil, errors.New("api error"))
 
-	h := NewRemoveScope(kClient)
+	h := NewRemoveScope(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), scope, testRealmName)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to remove scope from optional list")
@@ -15,8 +15,12 @@ import (
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakclientscope/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -30,6 +34,8 @@ type Helper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 func NewReconcile(k8sClient client.Client, controllerHelper Helper) *Reconcile {
@@ -41,20 +47,50 @@ func NewReconcile(k8sClient client.Client, controllerHelper Helper) *Reconcile {
 
 // Reconcile reconciles a KeycloakClientScope object.
 type Reconcile struct {
-	client client.Client
-	helper Helper
+	client      client.Client
+	helper      Helper
+	adminEvents *adminevents.Watcher
+}
+
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *Reconcile) WithAdminEvents(watcher *adminevents.Watcher) *Reconcile {
+	r.adminEvents = watcher
+
+	return r
 }
 
 func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
-	if err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakClientScope{}).
-		Complete(r); err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakClientScope{})
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakClientScope{},
+		func() client.ObjectList { return &keycloakApi.KeycloakClientScopeList{} },
+		clientScopeAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakClientScope admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakClientScope{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakClientScope{}, r, pause.WithObserveModeSkip(r.helper, common.FinalizerName, legacyFinalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakClientScope controller: %w", err)
 	}
 
 	return nil
 }
 
+// clientScopeAdminEventKeys returns the admin event resource key of the client scope.
+func clientScopeAdminEventKeys(obj client.Object) []string {
+	if scope, ok := obj.(*keycloakApi.KeycloakClientScope); ok {
+		return []string{adminevents.Key(adminevents.ResourceClientScopes, scope.Status.ID)}
+	}
+
+	return nil
+}
+
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclientscopes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclientscopes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakclientscopes/finalizers,verbs=update
@@ -125,7 +161,7 @@ func (r *Reconcile) initializeReconciliation(ctx context.Context, request reconc
 
 func (r *Reconcile) handleDeletion(ctx context.Context, instance *keycloakApi.KeycloakClientScope, kClient *keycloakapi.KeycloakClient, realmName string) (reconcile.Result, error) {
 	if controllerutil.ContainsFinalizer(instance, common.FinalizerName) || controllerutil.ContainsFinalizer(instance, legacyFinalizerName) {
-		if err := chain.NewRemoveScope(kClient).Serve(ctx, instance, realmName); err != nil {
+		if err := chain.NewRemoveScope(kClient, r.helper.DefaultDeletionPolicy()).Serve(ctx, instance, realmName); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to remove client scope: %w", err)
 		}
 
@@ -152,6 +188,9 @@ func (r *Reconcile) handleReconciliation(ctx context.Context, instance *keycloak
 	oldStatus := instance.Status
 
 	if err := chain.MakeChain(kClient).Serve(ctx, instance, realmName); err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
 		log.Error(err, "An error has occurred while handling KeycloakClientScope")
 
 		resultErr := fmt.Errorf("client scope chain processing failed: %w", err)
@@ -165,6 +204,7 @@ func (r *Reconcile) handleReconciliation(ctx context.Context, instance *keycloak
 	}
 
 	instance.Status.Value = common.StatusOK
+	status.SetReady(instance, "Reconciliation succeeded")
 
 	if err := r.updateClientScopeStatus(ctx, instance, oldStatus); err != nil {
 		return reconcile.Result{}, err
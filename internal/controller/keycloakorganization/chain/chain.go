@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -18,7 +19,10 @@ type chain struct {
 
 func (c *chain) Serve(ctx context.Context, organization *keycloakApi.KeycloakOrganization, realmName string) error {
 	for _, handler := range c.handlers {
-		if err := handler.ServeRequest(ctx, organization, realmName); err != nil {
+		err := handler.ServeRequest(ctx, organization, realmName)
+		status.SetStep(organization, stepCondition(handler), err)
+
+		if err != nil {
 			return fmt.Errorf("organization chain handler failed: %w", err)
 		}
 	}
@@ -0,0 +1,23 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionOrganizationSynced indicates whether the organization has been created or updated in Keycloak.
+	ConditionOrganizationSynced = "OrganizationSynced"
+
+	// ConditionIdentityProvidersSynced indicates whether the organization identity providers have been synced to Keycloak.
+	ConditionIdentityProvidersSynced = "IdentityProvidersSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h Handler) string {
+	switch h.(type) {
+	case *CreateOrganization:
+		return ConditionOrganizationSynced
+	case *ProcessIdentityProviders:
+		return ConditionIdentityProvidersSynced
+	default:
+		return ""
+	}
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"slices"
+	"strings"
 
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 type CreateOrganization struct {
@@ -36,15 +40,27 @@ func (h *CreateOrganization) ServeRequest(ctx context.Context, organization *key
 	}
 
 	if err == nil && existingOrg != nil {
+		organization.Status.OrganizationID = ptr.Deref(existingOrg.Id, "")
+
+		unchanged, err := drift.Equal(organizationProjection(orgRepresentation), organizationProjection(*existingOrg))
+		if err != nil {
+			return fmt.Errorf("unable to compare organization: %w", err)
+		}
+
+		if unchanged {
+			log.Info("Organization is up to date, skipping update", "organizationId", organization.Status.OrganizationID)
+
+			return nil
+		}
+
 		// Organization exists, update it
 		orgRepresentation.Id = existingOrg.Id
 		if _, updateErr := h.keycloakClient.UpdateOrganization(ctx, realmName, ptr.Deref(existingOrg.Id, ""), orgRepresentation); updateErr != nil {
 			return fmt.Errorf("unable to update organization: %w", updateErr)
 		}
 
-		organization.Status.OrganizationID = ptr.Deref(existingOrg.Id, "")
-
 		log.Info("Organization updated successfully", "organizationId", organization.Status.OrganizationID)
+		events.Normal(ctx, organization, events.ReasonUpdated, "Organization %s updated", organization.Spec.Name)
 
 		return nil
 	}
@@ -63,6 +79,7 @@ func (h *CreateOrganization) ServeRequest(ctx context.Context, organization *key
 	organization.Status.OrganizationID = ptr.Deref(org.Id, "")
 
 	log.Info("Organization created successfully", "organizationId", organization.Status.OrganizationID)
+	events.Normal(ctx, organization, events.ReasonCreated, "Organization %s created", organization.Spec.Name)
 
 	return nil
 }
@@ -96,3 +113,30 @@ func specToOrganizationRepresentation(org *keycloakApi.KeycloakOrganization) key
 
 	return rep
 }
+
+// organizationProjection returns the fields of the organization managed by the operator.
+// Domains are reduced to sorted names, as Keycloak also returns their verification state.
+func organizationProjection(org keycloakapi.OrganizationRepresentation) keycloakapi.OrganizationRepresentation {
+	projection := keycloakapi.OrganizationRepresentation{
+		Name:        org.Name,
+		Alias:       org.Alias,
+		Description: org.Description,
+		RedirectUrl: org.RedirectUrl,
+		Attributes:  org.Attributes,
+	}
+
+	if org.Domains != nil {
+		domains := make([]keycloakapi.OrganizationDomainRepresentation, 0, len(*org.Domains))
+		for _, d := range *org.Domains {
+			domains = append(domains, keycloakapi.OrganizationDomainRepresentation{Name: d.Name})
+		}
+
+		slices.SortFunc(domains, func(a, b keycloakapi.OrganizationDomainRepresentation) int {
+			return strings.Compare(ptr.Deref(a.Name, ""), ptr.Deref(b.Name, ""))
+		})
+
+		projection.Domains = &domains
+	}
+
+	return projection
+}
@@ -104,6 +104,43 @@ func TestCreateOrganization_ServeRequest(t *testing.T) {
 			wantErr:       require.NoError,
 			expectedOrgID: "existing-org-456",
 		},
+		{
+			name: "existing organization is up to date",
+			organization: &keycloakApi.KeycloakOrganization{
+				Spec: keycloakApi.KeycloakOrganizationSpec{
+					Name:        "Existing Organization",
+					Alias:       "existing-org",
+					Description: "Existing organization",
+					Domains:     []string{"example.com", "test.com"},
+					Attributes: map[string][]string{
+						"attr1": {"value1"},
+					},
+				},
+			},
+			realmName: "test-realm",
+			keycloakClient: func(t *testing.T) keycloakapi.OrganizationsClient {
+				client := keycloakapimocks.NewMockOrganizationsClient(t)
+
+				// GetOrganizationByAlias returns organization matching the spec, UpdateOrganization is not called
+				client.On("GetOrganizationByAlias", mock.Anything, "test-realm", "existing-org").
+					Return(&keycloakapi.OrganizationRepresentation{
+						Id:          ptr.To("existing-org-456"),
+						Name:        ptr.To("Existing Organization"),
+						Alias:       ptr.To("existing-org"),
+						Description: ptr.To("Existing organization"),
+						Enabled:     ptr.To(true),
+						Attributes:  &map[string][]string{"attr1": {"value1"}},
+						Domains: &[]keycloakapi.OrganizationDomainRepresentation{
+							{Name: ptr.To("test.com"), Verified: ptr.To(false)},
+							{Name: ptr.To("example.com"), Verified: ptr.To(true)},
+						},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+
+				return client
+			},
+			wantErr:       require.NoError,
+			expectedOrgID: "existing-org-456",
+		},
 		{
 			name: "error when GetOrganizationByAlias fails with non-not-found error",
 			organization: &keycloakApi.KeycloakOrganization{
@@ -6,19 +6,23 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
-func NewRemoveOrganization(kc *keycloakapi.KeycloakClient) Handler {
+func NewRemoveOrganization(kc *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) Handler {
 	return &RemoveOrganization{
-		keycloakClient: kc.Organizations,
+		keycloakClient:        kc.Organizations,
+		defaultDeletionPolicy: defaultDeletionPolicy,
 	}
 }
 
 type RemoveOrganization struct {
-	keycloakClient keycloakapi.OrganizationsClient
+	keycloakClient        keycloakapi.OrganizationsClient
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
 func (h *RemoveOrganization) ServeRequest(ctx context.Context, organization *keycloakApi.KeycloakOrganization, realmName string) error {
@@ -32,7 +36,7 @@ func (h *RemoveOrganization) ServeRequest(ctx context.Context, organization *key
 		return nil
 	}
 
-	if objectmeta.PreserveResourcesOnDeletion(organization) {
+	if objectmeta.PreserveResourcesOnDeletion(organization, h.defaultDeletionPolicy) {
 		log.Info("Preserve resources on deletion, skipping")
 
 		return nil
@@ -49,6 +53,7 @@ func (h *RemoveOrganization) ServeRequest(ctx context.Context, organization *key
 	}
 
 	log.Info("Organization deleted successfully")
+	events.Normal(ctx, organization, events.ReasonDeleted, "Organization %s deleted", organization.Spec.Name)
 
 	return nil
 }
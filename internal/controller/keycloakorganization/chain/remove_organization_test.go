@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1alpha1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	keycloakapimocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -149,7 +150,7 @@ func TestRemoveOrganization_ServeRequest(t *testing.T) {
 			kc := &keycloakapi.KeycloakClient{}
 			kc.Organizations = orgClient
 
-			handler := NewRemoveOrganization(kc)
+			handler := NewRemoveOrganization(kc, common.DeletionPolicyDelete)
 			err := handler.ServeRequest(context.Background(), tt.organization, tt.realmName)
 
 			tt.wantErr(t, err)
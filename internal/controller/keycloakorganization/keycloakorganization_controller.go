@@ -4,19 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakv1 "github.com/epam/edp-keycloak-operator/api/v1"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/dependency"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakorganization/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -29,6 +37,8 @@ type Helper interface {
 		ctx context.Context,
 		object helper.ObjectWithRealmRef,
 	) (string, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 const successRequeueTime = time.Minute * 10
@@ -42,14 +52,47 @@ func NewReconcileOrganization(k8sClient client.Client, controllerHelper Helper)
 
 // ReconcileOrganization reconciles an Organization object.
 type ReconcileOrganization struct {
-	client client.Client
-	helper Helper
+	client      client.Client
+	helper      Helper
+	adminEvents *adminevents.Watcher
+}
+
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *ReconcileOrganization) WithAdminEvents(watcher *adminevents.Watcher) *ReconcileOrganization {
+	r.adminEvents = watcher
+
+	return r
 }
 
 func (r *ReconcileOrganization) SetupWithManager(mgr ctrl.Manager) error {
-	if err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakOrganization{}).
-		Complete(r); err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakOrganization{})
+
+	if err := dependency.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakOrganization{},
+		func() client.ObjectList { return &keycloakApi.KeycloakOrganizationList{} },
+		organizationDependencies,
+		keycloakv1.KeycloakRealmIdentityProviderKind,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakOrganization dependencies: %w", err)
+	}
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakOrganization{},
+		func() client.ObjectList { return &keycloakApi.KeycloakOrganizationList{} },
+		organizationAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakOrganization admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakOrganization{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakOrganization{}, r, pause.WithObserveModeSkip(r.helper, common.FinalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakOrganization controller: %w", err)
 	}
 
@@ -125,7 +168,7 @@ func (r *ReconcileOrganization) initializeReconciliation(ctx context.Context, re
 
 func (r *ReconcileOrganization) handleDeletion(ctx context.Context, organization *keycloakApi.KeycloakOrganization, kClient *keycloakapi.KeycloakClient, realmName string) (reconcile.Result, error) {
 	if controllerutil.ContainsFinalizer(organization, common.FinalizerName) {
-		if err := chain.NewRemoveOrganization(kClient).ServeRequest(ctx, organization, realmName); err != nil {
+		if err := chain.NewRemoveOrganization(kClient, r.helper.DefaultDeletionPolicy()).ServeRequest(ctx, organization, realmName); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to remove organization: %w", err)
 		}
 
@@ -150,7 +193,30 @@ func (r *ReconcileOrganization) handleReconciliation(ctx context.Context, organi
 
 	oldStatus := organization.Status.DeepCopy()
 
+	notReady, err := dependency.NotReady(ctx, r.client, organization, organizationDependencies(organization))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check organization dependencies: %w", err)
+	}
+
+	meta.SetStatusCondition(&organization.Status.Conditions, dependency.Condition(notReady, organization.Generation))
+
+	if len(notReady) > 0 {
+		log.Info("Waiting for dependencies", "dependencies", notReady)
+
+		organization.Status.Value = fmt.Sprintf("Waiting for %s", strings.Join(notReady, ", "))
+		status.SetNotReady(organization, dependency.ReasonDependencyNotReady, organization.Status.Value)
+
+		if err := r.updateOrganizationStatus(ctx, organization, *oldStatus); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{RequeueAfter: successRequeueTime}, nil
+	}
+
 	if err := chain.MakeChain(kClient).Serve(ctx, organization, realmName); err != nil {
+		events.Error(ctx, organization, err)
+		status.SetFailed(organization, err)
+
 		log.Error(err, "An error has occurred while handling Organization")
 
 		organization.Status.Value = err.Error()
@@ -163,6 +229,7 @@ func (r *ReconcileOrganization) handleReconciliation(ctx context.Context, organi
 	}
 
 	organization.Status.SetOK()
+	status.SetReady(organization, "Reconciliation succeeded")
 
 	if err := r.updateOrganizationStatus(ctx, organization, *oldStatus); err != nil {
 		return reconcile.Result{}, err
@@ -184,3 +251,28 @@ func (r *ReconcileOrganization) updateOrganizationStatus(ctx context.Context, or
 
 	return nil
 }
+
+// organizationDependencies returns references to identity providers the organization depends on.
+func organizationDependencies(obj client.Object) []dependency.Ref {
+	organization, ok := obj.(*keycloakApi.KeycloakOrganization)
+	if !ok {
+		return nil
+	}
+
+	refs := make([]dependency.Ref, 0, len(organization.Spec.IdentityProviders))
+
+	for _, idp := range organization.Spec.IdentityProviders {
+		refs = append(refs, dependency.Ref{Kind: keycloakv1.KeycloakRealmIdentityProviderKind, Name: idp.Alias})
+	}
+
+	return refs
+}
+
+// organizationAdminEventKeys returns the admin event resource key of the organization.
+func organizationAdminEventKeys(obj client.Object) []string {
+	if organization, ok := obj.(*keycloakApi.KeycloakOrganization); ok {
+		return []string{adminevents.Key(adminevents.ResourceOrganizations, organization.Status.OrganizationID)}
+	}
+
+	return nil
+}
@@ -4,29 +4,49 @@ import (
 	"context"
 	"fmt"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain/handler"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/realmbuilder"
 )
 
 type AuthFlow struct {
-	next handler.RealmHandler
+	next   handler.RealmHandler
+	client client.Client
 }
 
 func (a AuthFlow) ServeRequest(ctx context.Context, realm *keycloakApi.KeycloakRealm, kClient *keycloakapi.KeycloakClient) error {
 	rLog := log.WithValues("realm name", realm.Spec.RealmName)
-	rLog.Info("Start configuring keycloak realm auth flow", "flow", realm.Spec.BrowserFlow)
 
-	if realm.Spec.BrowserFlow == nil {
-		rLog.Info("Browser flow is empty, exit")
+	bindings := realmbuilder.FlowBindingsFromV1(realm)
+	aliases := bindings.Aliases()
+
+	rLog.Info("Start configuring keycloak realm auth flows", "flows", aliases)
+
+	if len(aliases) == 0 {
+		rLog.Info("Auth flow bindings are empty, exit")
 		return nextServeOrNil(ctx, a.next, realm, kClient)
 	}
 
-	if _, err := kClient.Realms.SetRealmBrowserFlow(ctx, realm.Spec.RealmName, *realm.Spec.BrowserFlow); err != nil {
-		return fmt.Errorf("unable to set realm auth flow: %w", err)
+	if err := helper.CheckAuthFlowsReady(
+		ctx,
+		a.client,
+		common.RealmRef{Kind: keycloakApi.KeycloakRealmKind, Name: realm.Name},
+		realm.Namespace,
+		aliases,
+	); err != nil {
+		return err
+	}
+
+	if err := realmbuilder.ApplyRealmFlowBindings(ctx, realm.Spec.RealmName, bindings, kClient.Realms); err != nil {
+		return fmt.Errorf("unable to set realm auth flows: %w", err)
 	}
 
-	rLog.Info("End of configuring keycloak realm auth flow")
+	rLog.Info("End of configuring keycloak realm auth flows")
 
 	return nextServeOrNil(ctx, a.next, realm, kClient)
 }
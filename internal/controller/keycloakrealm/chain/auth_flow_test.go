@@ -8,17 +8,33 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
 )
 
+func newAuthFlowTestClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(s))
+
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+}
+
 func TestAuthFlow_ServeRequest(t *testing.T) {
-	af := AuthFlow{}
+	af := AuthFlow{client: newAuthFlowTestClient(t)}
 
 	realm := keycloakApi.KeycloakRealm{
+		ObjectMeta: metav1.ObjectMeta{Name: "realm", Namespace: "default"},
 		Spec: keycloakApi.KeycloakRealmSpec{
 			RealmName: "realm1",
 		},
@@ -30,9 +46,20 @@ func TestAuthFlow_ServeRequest(t *testing.T) {
 	require.NoError(t, err)
 
 	mockRealm := v2mocks.NewMockRealmClient(t)
-	mockRealm.On("SetRealmBrowserFlow", mock.Anything, "realm1", "flow-alias-1").Return(nil, nil)
+	mockRealm.On("GetAuthenticationFlows", mock.Anything, "realm1").Return([]keycloakapi.AuthenticationFlowRepresentation{
+		{Alias: ptr.To("flow-alias-1")},
+		{Alias: ptr.To("custom-registration")},
+	}, nil, nil)
+	mockRealm.On("GetRealm", mock.Anything, "realm1").Return(&keycloakapi.RealmRepresentation{
+		BrowserFlow: ptr.To("browser"),
+	}, nil, nil)
+	mockRealm.On("UpdateRealm", mock.Anything, "realm1", mock.MatchedBy(func(rep keycloakapi.RealmRepresentation) bool {
+		return assert.Equal(t, ptr.To("flow-alias-1"), rep.BrowserFlow) &&
+			assert.Equal(t, ptr.To("custom-registration"), rep.RegistrationFlow)
+	})).Return(nil, nil)
 
 	realm.Spec.BrowserFlow = ptr.To("flow-alias-1")
+	realm.Spec.AuthenticationFlows = &common.AuthenticationFlowBindings{RegistrationFlow: "custom-registration"}
 
 	kClient := &keycloakapi.KeycloakClient{Realms: mockRealm}
 	err = af.ServeRequest(ctx, &realm, kClient)
@@ -41,9 +68,10 @@ func TestAuthFlow_ServeRequest(t *testing.T) {
 
 func TestAuthFlow_ServeRequest_Failure(t *testing.T) {
 	mockRealm := v2mocks.NewMockRealmClient(t)
-	af := AuthFlow{}
+	af := AuthFlow{client: newAuthFlowTestClient(t)}
 
 	realm := keycloakApi.KeycloakRealm{
+		ObjectMeta: metav1.ObjectMeta{Name: "realm", Namespace: "default"},
 		Spec: keycloakApi.KeycloakRealmSpec{
 			RealmName: "realm1",
 		},
@@ -51,7 +79,11 @@ func TestAuthFlow_ServeRequest_Failure(t *testing.T) {
 
 	mockErr := errors.New("fatal")
 
-	mockRealm.On("SetRealmBrowserFlow", mock.Anything, "realm1", "flow-alias-1").Return(nil, mockErr)
+	mockRealm.On("GetAuthenticationFlows", mock.Anything, "realm1").Return([]keycloakapi.AuthenticationFlowRepresentation{
+		{Alias: ptr.To("flow-alias-1")},
+	}, nil, nil)
+	mockRealm.On("GetRealm", mock.Anything, "realm1").Return(&keycloakapi.RealmRepresentation{}, nil, nil)
+	mockRealm.On("UpdateRealm", mock.Anything, "realm1", mock.Anything).Return(nil, mockErr)
 
 	realm.Spec.BrowserFlow = ptr.To("flow-alias-1")
 
@@ -64,3 +96,27 @@ func TestAuthFlow_ServeRequest_Failure(t *testing.T) {
 
 	assert.ErrorIs(t, err, mockErr)
 }
+
+func TestAuthFlow_ServeRequest_WaitsForAuthFlow(t *testing.T) {
+	authFlow := &keycloakApi.KeycloakAuthFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: "registration", Namespace: "default"},
+		Spec: keycloakApi.KeycloakAuthFlowSpec{
+			RealmRef: common.RealmRef{Kind: keycloakApi.KeycloakRealmKind, Name: "realm"},
+			Alias:    "custom-registration",
+		},
+	}
+
+	af := AuthFlow{client: newAuthFlowTestClient(t, authFlow)}
+
+	realm := keycloakApi.KeycloakRealm{
+		ObjectMeta: metav1.ObjectMeta{Name: "realm", Namespace: "default"},
+		Spec: keycloakApi.KeycloakRealmSpec{
+			RealmName:           "realm1",
+			AuthenticationFlows: &common.AuthenticationFlowBindings{RegistrationFlow: "custom-registration"},
+		},
+	}
+
+	err := af.ServeRequest(context.Background(), &realm, &keycloakapi.KeycloakClient{Realms: v2mocks.NewMockRealmClient(t)})
+	require.ErrorIs(t, err, helper.ErrAuthFlowNotReady)
+	assert.Contains(t, err.Error(), "default/registration")
+}
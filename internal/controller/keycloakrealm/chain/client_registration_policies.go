@@ -0,0 +1,46 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain/handler"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/realmbuilder"
+)
+
+type ClientRegistrationPolicies struct {
+	next handler.RealmHandler
+}
+
+func (h ClientRegistrationPolicies) ServeRequest(ctx context.Context, realm *keycloakApi.KeycloakRealm, kClient *keycloakapi.KeycloakClient) error {
+	l := ctrl.LoggerFrom(ctx)
+
+	if realm.Spec.ClientRegistrationPolicies == nil && len(realm.Status.ClientRegistrationPolicies) == 0 {
+		l.Info("Client registration policies are empty, skipping configuration")
+
+		return nextServeOrNil(ctx, h.next, realm, kClient)
+	}
+
+	l.Info("Start configuring keycloak realm client registration policies")
+
+	managed, err := realmbuilder.SyncClientRegistrationPolicies(
+		ctx,
+		realm.Spec.RealmName,
+		realm.Spec.ClientRegistrationPolicies,
+		realm.Status.ClientRegistrationPolicies,
+		kClient.RealmComponents,
+	)
+	realm.Status.ClientRegistrationPolicies = managed
+
+	if err != nil {
+		return fmt.Errorf("unable to sync client registration policies: %w", err)
+	}
+
+	l.Info("Client registration policies have been configured")
+
+	return nextServeOrNil(ctx, h.next, realm, kClient)
+}
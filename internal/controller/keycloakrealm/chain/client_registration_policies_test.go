@@ -0,0 +1,114 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/realmbuilder"
+)
+
+func TestClientRegistrationPolicies_ServeRequest(t *testing.T) {
+	t.Parallel()
+
+	maxClients := &common.ClientRegistrationPolicies{
+		Anonymous: []common.ClientRegistrationPolicy{
+			{Name: "Max Clients Limit", MaxClients: &common.MaxClientsPolicy{Limit: 10}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		policies    *common.ClientRegistrationPolicies
+		managed     []string
+		kClient     func(t *testing.T) *keycloakapi.KeycloakClient
+		wantManaged []string
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name: "policies are not configured",
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				return &keycloakapi.KeycloakClient{}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:     "created policy is managed",
+			policies: maxClients,
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				m := v2mocks.NewMockRealmComponentsClient(t)
+				m.On("GetComponents", mock.Anything, "realm", mock.Anything).Return(nil, nil, nil)
+				m.On("CreateComponent", mock.Anything, "realm", mock.Anything).Return(nil, nil)
+
+				return &keycloakapi.KeycloakClient{RealmComponents: m}
+			},
+			wantManaged: []string{"anonymous/Max Clients Limit"},
+			wantErr:     require.NoError,
+		},
+		{
+			name:     "existing policy is not managed",
+			policies: maxClients,
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				m := v2mocks.NewMockRealmComponentsClient(t)
+				m.On("GetComponents", mock.Anything, "realm", mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{{
+						Id:         ptr.To("mc-id"),
+						Name:       ptr.To("Max Clients Limit"),
+						ProviderId: ptr.To("max-clients"),
+						SubType:    ptr.To(realmbuilder.ClientRegistrationPolicyAnonymous),
+					}}, nil, nil)
+				m.On("UpdateComponent", mock.Anything, "realm", "mc-id", mock.Anything).Return(nil, nil)
+
+				return &keycloakapi.KeycloakClient{RealmComponents: m}
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:    "failed to get policies",
+			managed: []string{"anonymous/Max Clients Limit"},
+			kClient: func(t *testing.T) *keycloakapi.KeycloakClient {
+				m := v2mocks.NewMockRealmComponentsClient(t)
+				m.On("GetComponents", mock.Anything, "realm", mock.Anything).Return(nil, nil, errors.New("get error"))
+
+				return &keycloakapi.KeycloakClient{RealmComponents: m}
+			},
+			wantManaged: []string{"anonymous/Max Clients Limit"},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "unable to sync client registration policies")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			realm := &keycloakApi.KeycloakRealm{
+				Spec: keycloakApi.KeycloakRealmSpec{
+					RealmName:                  "realm",
+					ClientRegistrationPolicies: tt.policies,
+				},
+				Status: keycloakApi.KeycloakRealmStatus{ClientRegistrationPolicies: tt.managed},
+			}
+
+			err := ClientRegistrationPolicies{}.ServeRequest(
+				ctrl.LoggerInto(context.Background(), logr.Discard()),
+				realm,
+				tt.kClient(t),
+			)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantManaged, realm.Status.ClientRegistrationPolicies)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package chain
+
+import "github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain/handler"
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionRealmSynced indicates whether the realm has been created in Keycloak.
+	ConditionRealmSynced = "RealmSynced"
+
+	// ConditionUsersSynced indicates whether the realm users have been created in Keycloak.
+	ConditionUsersSynced = "UsersSynced"
+
+	// ConditionUserRolesSynced indicates whether the realm user roles have been synced to Keycloak.
+	ConditionUserRolesSynced = "UserRolesSynced"
+
+	// ConditionRealmSettingsSynced indicates whether the realm settings have been applied.
+	ConditionRealmSettingsSynced = "RealmSettingsSynced"
+
+	// ConditionLocalizationTextsSynced indicates whether the realm localization texts have been synced to Keycloak.
+	ConditionLocalizationTextsSynced = "LocalizationTextsSynced"
+
+	// ConditionAuthFlowsSynced indicates whether the realm authentication flows have been applied.
+	ConditionAuthFlowsSynced = "AuthFlowsSynced"
+
+	// ConditionUserProfileSynced indicates whether the user profile has been synced to Keycloak.
+	ConditionUserProfileSynced = "UserProfileSynced"
+
+	// ConditionClientRegistrationPoliciesSynced indicates whether the client registration policies have been synced to Keycloak.
+	ConditionClientRegistrationPoliciesSynced = "ClientRegistrationPoliciesSynced"
+
+	// ConditionEmailSynced indicates whether the realm email settings have been applied.
+	ConditionEmailSynced = "EmailSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h handler.RealmHandler) string {
+	switch h.(type) {
+	case PutRealm:
+		return ConditionRealmSynced
+	case PutUsers:
+		return ConditionUsersSynced
+	case PutUsersRoles:
+		return ConditionUserRolesSynced
+	case RealmSettings:
+		return ConditionRealmSettingsSynced
+	case RealmLocalizationTexts:
+		return ConditionLocalizationTextsSynced
+	case AuthFlow:
+		return ConditionAuthFlowsSynced
+	case UserProfile:
+		return ConditionUserProfileSynced
+	case ClientRegistrationPolicies:
+		return ConditionClientRegistrationPoliciesSynced
+	case ConfigureEmail:
+		return ConditionEmailSynced
+	default:
+		return ""
+	}
+}
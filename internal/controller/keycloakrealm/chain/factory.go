@@ -2,6 +2,7 @@ package chain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -11,13 +12,37 @@ import (
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain/handler"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
 var log = ctrl.Log.WithName("realm_handler")
 
+// stepError is an error returned by a chain step.
+// It lets the previous steps know that they are completed and the failure is reported by the failed step.
+type stepError struct {
+	err error
+}
+
+func (e stepError) Error() string {
+	return e.err.Error()
+}
+
+func (e stepError) Unwrap() error {
+	return e.err
+}
+
+// chainStart runs the first step of the chain, so its condition is reported as for the next steps.
+type chainStart struct {
+	next handler.RealmHandler
+}
+
+func (c chainStart) ServeRequest(ctx context.Context, realm *keycloakApi.KeycloakRealm, kClient *keycloakapi.KeycloakClient) error {
+	return nextServeOrNil(ctx, c.next, realm, kClient)
+}
+
 func CreateDefChain(k8sClient client.Client, scheme *runtime.Scheme) handler.RealmHandler {
-	return PutRealm{
+	return chainStart{next: PutRealm{
 		client: k8sClient,
 		next: SetLabels{
 			client: k8sClient,
@@ -26,9 +51,12 @@ func CreateDefChain(k8sClient client.Client, scheme *runtime.Scheme) handler.Rea
 					next: RealmSettings{
 						next: RealmLocalizationTexts{
 							next: AuthFlow{
+								client: k8sClient,
 								next: UserProfile{
-									next: ConfigureEmail{
-										client: k8sClient,
+									next: ClientRegistrationPolicies{
+										next: ConfigureEmail{
+											client: k8sClient,
+										},
 									},
 								},
 							},
@@ -37,17 +65,27 @@ func CreateDefChain(k8sClient client.Client, scheme *runtime.Scheme) handler.Rea
 				},
 			},
 		},
-	}
+	}}
 }
 
 func nextServeOrNil(ctx context.Context, next handler.RealmHandler, realm *keycloakApi.KeycloakRealm, kClient *keycloakapi.KeycloakClient) error {
 	if next != nil {
 		err := next.ServeRequest(ctx, realm, kClient)
-		if err != nil {
-			return fmt.Errorf("chain failed %s: %w", reflect.TypeOf(next).Name(), err)
+		if err == nil {
+			status.SetStep(realm, stepCondition(next), nil)
+
+			return nil
+		}
+
+		if errors.As(err, &stepError{}) {
+			// The error is returned by one of the next steps, so the current step is completed.
+			status.SetStep(realm, stepCondition(next), nil)
+		} else {
+			status.SetStep(realm, stepCondition(next), err)
+			err = stepError{err: err}
 		}
 
-		return nil
+		return fmt.Errorf("chain failed %s: %w", reflect.TypeOf(next).Name(), err)
 	}
 
 	log.Info("handling of realm has been finished", "realm name", realm.Spec.RealmName)
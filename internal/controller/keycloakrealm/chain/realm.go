@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain/handler"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
@@ -51,6 +52,8 @@ func (h PutRealm) createRealm(ctx context.Context, realm *keycloakApi.KeycloakRe
 		return fmt.Errorf("unable to create realm with default config: %w", err)
 	}
 
+	events.Normal(ctx, realm, events.ReasonCreated, "Realm %s created", realmName)
+
 	if err := h.putRealmRoles(ctx, realm, kClient); err != nil {
 		return fmt.Errorf("unable to create realm roles on no sso scenario: %w", err)
 	}
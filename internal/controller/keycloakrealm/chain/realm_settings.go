@@ -2,10 +2,14 @@ package chain
 
 import (
 	"context"
+	"fmt"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain/handler"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	"github.com/epam/edp-keycloak-operator/pkg/realmbuilder"
 )
 
@@ -17,17 +21,54 @@ func (h RealmSettings) ServeRequest(ctx context.Context, realm *keycloakApi.Keyc
 	rLog := log.WithValues("realm name", realm.Spec.RealmName)
 	rLog.Info("Start updating of Keycloak realm settings")
 
-	if err := realmbuilder.ApplyRealmEventConfig(ctx, realm.Spec.RealmName, realm.Spec.RealmEventConfig, kClient.Events); err != nil {
+	eventsUpdated, err := realmbuilder.ApplyRealmEventConfig(ctx, realm.Spec.RealmName, realm.Spec.RealmEventConfig, kClient.Events)
+	if err != nil {
 		return err
 	}
 
+	if realm.Spec.RealmEventConfig != nil {
+		metrics.RecordWrite(keycloakApi.KeycloakRealmKind, eventsUpdated)
+	}
+
 	overlay := realmbuilder.BuildRealmRepresentationFromV1(realm)
 
-	if err := realmbuilder.ApplyRealmSettings(ctx, realm.Spec.RealmName, overlay, kClient.Realms); err != nil {
+	updated, err := realmbuilder.ApplyRealmSettings(ctx, realm.Spec.RealmName, overlay, kClient.Realms)
+	if err != nil {
 		return err
 	}
 
+	metrics.RecordWrite(keycloakApi.KeycloakRealmKind, updated)
+
+	if updated || eventsUpdated {
+		events.Normal(ctx, realm, events.ReasonUpdated, "Realm %s updated", realm.Spec.RealmName)
+	}
+
 	rLog.Info("Realm settings is updating done.")
 
 	return nextServeOrNil(ctx, h.next, realm, kClient)
 }
+
+// ObserveRealmSettings returns the list of differences between the realm spec and the live Keycloak realm
+// without applying any changes.
+func ObserveRealmSettings(ctx context.Context, realm *keycloakApi.KeycloakRealm, kClient *keycloakapi.KeycloakClient) ([]string, error) {
+	rLog := log.WithValues("realm name", realm.Spec.RealmName)
+	rLog.Info("Start observing Keycloak realm settings")
+
+	live, _, err := kClient.Realms.GetRealm(ctx, realm.Spec.RealmName)
+	if err != nil {
+		if keycloakapi.IsNotFound(err) {
+			return []string{fmt.Sprintf("realm %s does not exist in Keycloak", realm.Spec.RealmName)}, nil
+		}
+
+		return nil, fmt.Errorf("unable to get realm: %w", err)
+	}
+
+	diffs, err := drift.Diff(realmbuilder.BuildRealmRepresentationFromV1(realm), live)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare realm settings: %w", err)
+	}
+
+	rLog.Info("Realm settings observing done.", "driftedFields", len(diffs))
+
+	return diffs, nil
+}
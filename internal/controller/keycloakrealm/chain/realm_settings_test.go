@@ -26,13 +26,11 @@ func TestRealmSettings_ServeRequest(t *testing.T) {
 		wantErr         require.ErrorAssertionFunc
 	}{
 		{
-			name:  "minimal realm — no event config",
+			name:  "minimal realm — no event config, update skipped",
 			realm: &keycloakApi.KeycloakRealm{},
 			setupMock: func(m *v2mocks.MockRealmClient) {
 				m.EXPECT().GetRealm(mock.Anything, "").
 					Return(&keycloakapi.RealmRepresentation{}, nil, nil)
-				m.EXPECT().UpdateRealm(mock.Anything, "", mock.Anything).
-					Return(nil, nil)
 			},
 			setupEventsMock: func(_ *v2mocks.MockEventsClient) {},
 			wantErr:         require.NoError,
@@ -127,8 +125,10 @@ func TestRealmSettings_ServeRequest(t *testing.T) {
 			},
 		},
 		{
-			name:  "UpdateRealm fails",
-			realm: &keycloakApi.KeycloakRealm{},
+			name: "UpdateRealm fails",
+			realm: &keycloakApi.KeycloakRealm{
+				Spec: keycloakApi.KeycloakRealmSpec{DisplayName: ptr.To("Realm")},
+			},
 			setupMock: func(m *v2mocks.MockRealmClient) {
 				m.EXPECT().GetRealm(mock.Anything, "").
 					Return(&keycloakapi.RealmRepresentation{}, nil, nil)
@@ -276,3 +276,78 @@ func TestRealmSettings_ServeRequest_WithSSOSessionSettings(t *testing.T) {
 	err := rs.ServeRequest(context.Background(), &realm, kClient)
 	require.NoError(t, err)
 }
+
+func TestObserveRealmSettings(t *testing.T) {
+	t.Parallel()
+
+	realm := &keycloakApi.KeycloakRealm{
+		Spec: keycloakApi.KeycloakRealmSpec{
+			RealmName:   "realm1",
+			DisplayName: ptr.To("Realm 1"),
+			FrontendURL: "http://example.com",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*v2mocks.MockRealmClient)
+		wantDiffs []string
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name: "no drift",
+			setupMock: func(m *v2mocks.MockRealmClient) {
+				m.EXPECT().GetRealm(mock.Anything, "realm1").
+					Return(&keycloakapi.RealmRepresentation{
+						Realm:       ptr.To("realm1"),
+						DisplayName: ptr.To("Realm 1"),
+						Attributes:  &map[string]string{"frontendUrl": "http://example.com", "other": "value"},
+					}, nil, nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "drift detected",
+			setupMock: func(m *v2mocks.MockRealmClient) {
+				m.EXPECT().GetRealm(mock.Anything, "realm1").
+					Return(&keycloakapi.RealmRepresentation{
+						Realm:       ptr.To("realm1"),
+						DisplayName: ptr.To("Changed in console"),
+						Attributes:  &map[string]string{"frontendUrl": "http://example.com"},
+					}, nil, nil)
+			},
+			wantDiffs: []string{`displayName: "Changed in console" -> "Realm 1"`},
+			wantErr:   require.NoError,
+		},
+		{
+			name: "realm does not exist",
+			setupMock: func(m *v2mocks.MockRealmClient) {
+				m.EXPECT().GetRealm(mock.Anything, "realm1").
+					Return(nil, nil, keycloakapi.ErrNotFound)
+			},
+			wantDiffs: []string{"realm realm1 does not exist in Keycloak"},
+			wantErr:   require.NoError,
+		},
+		{
+			name: "GetRealm fails",
+			setupMock: func(m *v2mocks.MockRealmClient) {
+				m.EXPECT().GetRealm(mock.Anything, "realm1").
+					Return(nil, nil, assert.AnError)
+			},
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRealm := v2mocks.NewMockRealmClient(t)
+			tt.setupMock(mockRealm)
+
+			diffs, err := ObserveRealmSettings(context.Background(), realm, &keycloakapi.KeycloakClient{Realms: mockRealm})
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantDiffs, diffs)
+		})
+	}
+}
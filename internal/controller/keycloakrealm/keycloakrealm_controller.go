@@ -7,29 +7,49 @@ import (
 	"time"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealm/chain/handler"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
-const keyCloakRealmOperatorFinalizerName = "keycloak.realm.operator.finalizer.name"
+const (
+	keyCloakRealmOperatorFinalizerName = "keycloak.realm.operator.finalizer.name"
+
+	// conditionDrifted indicates whether the live Keycloak realm differs from the spec.
+	// It is set only in observe reconcile mode.
+	conditionDrifted    = "Drifted"
+	reasonDriftDetected = "DriftDetected"
+	reasonNoDrift       = "NoDrift"
+)
 
 type Helper interface {
 	SetFailureCount(fc helper.FailureCountable) time.Duration
 	TryToDelete(ctx context.Context, obj client.Object, terminator helper.Terminator, finalizer string) (isDeleted bool, resultErr error)
 	CreateKeycloakClientFromRealm(ctx context.Context, realm *keycloakApi.KeycloakRealm) (*keycloakapi.KeycloakClient, error)
 	SetKeycloakOwnerRef(ctx context.Context, object helper.ObjectWithKeycloakRef) error
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 func NewReconcileKeycloakRealm(
@@ -50,6 +70,14 @@ type ReconcileKeycloakRealm struct {
 	helper                  Helper
 	chain                   handler.RealmHandler
 	successReconcileTimeout time.Duration
+	adminEvents             *adminevents.Watcher
+}
+
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *ReconcileKeycloakRealm) WithAdminEvents(watcher *adminevents.Watcher) *ReconcileKeycloakRealm {
+	r.adminEvents = watcher
+
+	return r
 }
 
 func (r *ReconcileKeycloakRealm) SetupWithManager(mgr ctrl.Manager, successReconcileTimeout time.Duration) error {
@@ -58,16 +86,50 @@ func (r *ReconcileKeycloakRealm) SetupWithManager(mgr ctrl.Manager, successRecon
 		UpdateFunc: helper.IsFailuresUpdated,
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakRealm{}, builder.WithPredicates(pred)).
-		Complete(r)
-	if err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakRealm{}, builder.WithPredicates(pred))
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakRealm{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmList{} },
+		realmSecretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealm secret watches: %w", err)
+	}
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakRealm{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmList{} },
+		nil,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealm admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealm{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealm{}, r)))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakRealm controller: %w", err)
 	}
 
 	return nil
 }
 
+// realmSecretRefs returns Secrets and ConfigMaps referenced by the realm.
+func realmSecretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	if realm, ok := obj.(*keycloakApi.KeycloakRealm); ok {
+		refs.AddSMTP(realm.Namespace, realm.Spec.Smtp)
+	}
+
+	return refs
+}
+
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealms,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealms/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealms/finalizers,verbs=update
@@ -93,6 +155,9 @@ func (r *ReconcileKeycloakRealm) Reconcile(ctx context.Context, request reconcil
 	}
 
 	if err := r.tryReconcile(ctx, instance); err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
 		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
 			return ctrl.Result{
 				RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod,
@@ -109,6 +174,8 @@ func (r *ReconcileKeycloakRealm) Reconcile(ctx context.Context, request reconcil
 		instance.Status.Value = common.StatusOK
 		instance.Status.FailureCount = 0
 		result.RequeueAfter = r.successReconcileTimeout
+
+		status.SetReady(instance, "Reconciliation succeeded")
 	}
 
 	if err := r.client.Status().Update(ctx, instance); err != nil {
@@ -128,10 +195,17 @@ func (r *ReconcileKeycloakRealm) tryReconcile(ctx context.Context, realm *keyclo
 		return fmt.Errorf("failed to create keycloak v2 client for realm: %w", err)
 	}
 
+	if r.helper.GetReconcileMode(realm) == common.ReconcileModeObserve {
+		return r.tryObserve(ctx, realm, kClient)
+	}
+
+	meta.RemoveStatusCondition(&realm.Status.Conditions, conditionDrifted)
+	metrics.DeleteDriftedFields(keycloakApi.KeycloakRealmKind, realm.Namespace, realm.Name)
+
 	deleted, err := r.helper.TryToDelete(
 		ctx,
 		realm,
-		makeTerminator(realm.Spec.RealmName, kClient.Realms, objectmeta.PreserveResourcesOnDeletion(realm)),
+		makeTerminator(realm.Spec.RealmName, kClient.Realms, objectmeta.PreserveResourcesOnDeletion(realm, r.helper.DefaultDeletionPolicy())),
 		keyCloakRealmOperatorFinalizerName,
 	)
 	if err != nil {
@@ -148,3 +222,44 @@ func (r *ReconcileKeycloakRealm) tryReconcile(ctx context.Context, realm *keyclo
 
 	return nil
 }
+
+// tryObserve reports the drift between the spec and Keycloak without applying any changes.
+// On deletion, the finalizer is removed without deleting the realm from Keycloak.
+func (r *ReconcileKeycloakRealm) tryObserve(ctx context.Context, realm *keycloakApi.KeycloakRealm, kClient *keycloakapi.KeycloakClient) error {
+	if realm.GetDeletionTimestamp() != nil {
+		metrics.DeleteDriftedFields(keycloakApi.KeycloakRealmKind, realm.Namespace, realm.Name)
+
+		if controllerutil.RemoveFinalizer(realm, keyCloakRealmOperatorFinalizerName) {
+			if err := r.client.Update(ctx, realm); err != nil {
+				return fmt.Errorf("failed to remove finalizer: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	diffs, err := chain.ObserveRealmSettings(ctx, realm, kClient)
+	if err != nil {
+		return fmt.Errorf("error during realm drift detection: %w", err)
+	}
+
+	metrics.SetDriftedFields(keycloakApi.KeycloakRealmKind, realm.Namespace, realm.Name, len(diffs))
+
+	driftCondition := metav1.Condition{
+		Type:               conditionDrifted,
+		Status:             metav1.ConditionFalse,
+		Reason:             reasonNoDrift,
+		Message:            "Keycloak realm matches the spec",
+		ObservedGeneration: realm.Generation,
+	}
+
+	if len(diffs) > 0 {
+		driftCondition.Status = metav1.ConditionTrue
+		driftCondition.Reason = reasonDriftDetected
+		driftCondition.Message = drift.FormatMessage(diffs)
+	}
+
+	meta.SetStatusCondition(&realm.Status.Conditions, driftCondition)
+
+	return nil
+}
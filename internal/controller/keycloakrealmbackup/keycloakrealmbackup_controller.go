@@ -0,0 +1,242 @@
+package keycloakrealmbackup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+type Helper interface {
+	SetFailureCount(fc helper.FailureCountable) time.Duration
+	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
+	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
+	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+}
+
+func NewReconcileKeycloakRealmBackup(k8sClient client.Client, controllerHelper Helper) *ReconcileKeycloakRealmBackup {
+	return &ReconcileKeycloakRealmBackup{
+		client: k8sClient,
+		helper: controllerHelper,
+		now:    time.Now,
+	}
+}
+
+// ReconcileKeycloakRealmBackup reconciles a KeycloakRealmBackup object.
+type ReconcileKeycloakRealmBackup struct {
+	client client.Client
+	helper Helper
+	now    func() time.Time
+}
+
+func (r *ReconcileKeycloakRealmBackup) SetupWithManager(mgr ctrl.Manager) error {
+	pred := predicate.Or(predicate.GenerationChangedPredicate{}, pause.AnnotationsChanged())
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakRealmBackup{}, builder.WithPredicates(pred)).
+		Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmBackup{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmBackup{}, r))))
+	if err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmBackup controller: %w", err)
+	}
+
+	return nil
+}
+
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmbackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmbackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmbackups/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile takes a realm backup when it is due according to the schedule.
+func (r *ReconcileKeycloakRealmBackup) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, resultErr error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling KeycloakRealmBackup")
+
+	backup := &keycloakApi.KeycloakRealmBackup{}
+	if err := r.client.Get(ctx, request.NamespacedName, backup); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("unable to get KeycloakRealmBackup: %w", err)
+	}
+
+	if backup.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	oldStatus := backup.Status.DeepCopy()
+
+	requeueAfter, err := r.tryReconcile(ctx, backup)
+	if err != nil {
+		events.Error(ctx, backup, err)
+		status.SetFailed(backup, err)
+
+		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
+			return ctrl.Result{RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod}, nil
+		}
+
+		log.Error(err, "An error has occurred while handling KeycloakRealmBackup")
+
+		backup.Status.Value = err.Error()
+		result.RequeueAfter = r.helper.SetFailureCount(backup)
+	} else {
+		helper.SetSuccessStatus(backup)
+		status.SetReady(backup, "Reconciliation succeeded")
+
+		result.RequeueAfter = requeueAfter
+	}
+
+	if !equality.Semantic.DeepEqual(&backup.Status, oldStatus) {
+		if err := r.client.Status().Update(ctx, backup); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to update KeycloakRealmBackup status: %w", err)
+		}
+	}
+
+	log.Info("Reconciling done")
+
+	return result, nil
+}
+
+// tryReconcile takes a backup if it is due and returns duration until the next scheduled backup.
+func (r *ReconcileKeycloakRealmBackup) tryReconcile(ctx context.Context, backup *keycloakApi.KeycloakRealmBackup) (time.Duration, error) {
+	if err := r.helper.SetRealmOwnerRef(ctx, backup); err != nil {
+		return 0, fmt.Errorf("unable to set realm owner ref: %w", err)
+	}
+
+	sched, err := parseSchedule(backup.Spec.Schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	now := r.now().UTC()
+
+	last := backup.GetCreationTimestamp().Time
+	if backup.Status.LastBackupTime != nil {
+		last = backup.Status.LastBackupTime.Time
+	}
+
+	due := sched.Next(last)
+	if due.IsZero() {
+		return 0, fmt.Errorf("schedule %q has no activation time", backup.Spec.Schedule)
+	}
+
+	if now.Before(due) {
+		backup.Status.NextBackupTime = &metav1.Time{Time: due}
+
+		return due.Sub(now), nil
+	}
+
+	if err := r.takeBackup(ctx, backup, now); err != nil {
+		return 0, err
+	}
+
+	next := sched.Next(now)
+	backup.Status.NextBackupTime = &metav1.Time{Time: next}
+
+	return next.Sub(now), nil
+}
+
+func (r *ReconcileKeycloakRealmBackup) takeBackup(ctx context.Context, backup *keycloakApi.KeycloakRealmBackup, now time.Time) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	kClient, err := r.helper.CreateKeycloakClientFromRealmRef(ctx, backup)
+	if err != nil {
+		return fmt.Errorf("unable to create keycloak client from realm ref: %w", err)
+	}
+
+	realmName, err := r.helper.GetRealmNameFromRef(ctx, backup)
+	if err != nil {
+		return fmt.Errorf("unable to get realm name from ref: %w", err)
+	}
+
+	exported, _, err := kClient.Realms.PartialExportRealm(ctx, realmName, &keycloakapi.PartialExportRealmParams{
+		ExportClients:        ptr.To(backup.Spec.IncludeClients),
+		ExportGroupsAndRoles: ptr.To(backup.Spec.IncludeGroupsAndRoles),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export realm %s: %w", realmName, err)
+	}
+
+	data, err := marshalBackup(exported, backup.ShouldRedactSecrets())
+	if err != nil {
+		return err
+	}
+
+	storage, err := newBackupStorage(r.client, backup.Spec.Destination)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	name, err := storage.Store(ctx, backup, makeBackupID(now), data, checksum)
+	if err != nil {
+		return fmt.Errorf("unable to store realm backup: %w", err)
+	}
+
+	log.Info("Realm backup has been stored", "backup", name, "size", len(data))
+
+	retention := backup.Spec.Retention
+	if retention <= 0 {
+		retention = defaultRetention
+	}
+
+	if err := storage.Prune(ctx, backup, retention); err != nil {
+		return fmt.Errorf("unable to remove outdated realm backups: %w", err)
+	}
+
+	backup.Status.LastBackupTime = &metav1.Time{Time: now}
+	backup.Status.LastBackupName = name
+	backup.Status.LastBackupChecksum = checksum
+	backup.Status.LastBackupSize = int64(len(data))
+
+	return nil
+}
+
+// marshalBackup converts the exported realm to indented JSON with sorted keys,
+// so unchanged realms produce identical checksums.
+func marshalBackup(exported *keycloakapi.RealmRepresentation, redact bool) ([]byte, error) {
+	raw, err := json.Marshal(exported)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal realm export: %w", err)
+	}
+
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("unable to decode realm export: %w", err)
+	}
+
+	if redact {
+		doc = redactSecrets(doc)
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal realm backup: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,250 @@
+package keycloakrealmbackup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	helpermock "github.com/epam/edp-keycloak-operator/internal/controller/helper/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapimocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func newTestBackup(destination keycloakApi.RealmBackupDestination, status keycloakApi.KeycloakRealmBackupStatus) *keycloakApi.KeycloakRealmBackup {
+	return &keycloakApi.KeycloakRealmBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "backup",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Spec: keycloakApi.KeycloakRealmBackupSpec{
+			RealmRef: common.RealmRef{
+				Kind: keycloakApi.KeycloakRealmKind,
+				Name: "realm",
+			},
+			Schedule:       "0 2 * * *",
+			IncludeClients: true,
+			Retention:      2,
+			Destination:    destination,
+		},
+		Status: status,
+	}
+}
+
+func TestReconcileKeycloakRealmBackup_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	now := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)
+	lastBackup := metav1.NewTime(time.Date(2024, time.March, 14, 2, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name       string
+		backup     *keycloakApi.KeycloakRealmBackup
+		objects    []client.Object
+		helper     func(t *testing.T) *helpermock.MockControllerHelper
+		wantResult reconcile.Result
+		check      func(t *testing.T, k8sClient client.Client, backup *keycloakApi.KeycloakRealmBackup)
+	}{
+		{
+			name: "backup is due, stored in secret and outdated backups are removed",
+			backup: newTestBackup(
+				keycloakApi.RealmBackupDestination{Secret: &keycloakApi.RealmBackupObjectDestination{ChunkSize: 1024}},
+				keycloakApi.KeycloakRealmBackupStatus{LastBackupTime: &lastBackup},
+			),
+			objects: []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      "backup-20240313-020000-0",
+					Namespace: "default",
+					Labels:    map[string]string{backupLabel: "backup", backupIDLabel: "20240313-020000"},
+				}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      "backup-20240314-020000-0",
+					Namespace: "default",
+					Labels:    map[string]string{backupLabel: "backup", backupIDLabel: "20240314-020000"},
+				}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-20240301-020000-0",
+					Namespace: "default",
+					Labels:    map[string]string{backupLabel: "other", backupIDLabel: "20240301-020000"},
+				}},
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				h := helpermock.NewMockControllerHelper(t)
+				realmClient := keycloakapimocks.NewMockRealmClient(t)
+
+				h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil)
+				h.On("CreateKeycloakClientFromRealmRef", mock.Anything, mock.Anything).
+					Return(&keycloakapi.KeycloakClient{Realms: realmClient}, nil)
+				h.On("GetRealmNameFromRef", mock.Anything, mock.Anything).Return("test-realm", nil)
+
+				realmClient.On("PartialExportRealm", mock.Anything, "test-realm", &keycloakapi.PartialExportRealmParams{
+					ExportClients:        ptr.To(true),
+					ExportGroupsAndRoles: ptr.To(false),
+				}).Return(&keycloakapi.RealmRepresentation{
+					Realm: ptr.To("test-realm"),
+					Clients: &[]keycloakapi.ClientRepresentation{
+						{ClientId: ptr.To("app"), Secret: ptr.To("client-secret")},
+					},
+				}, nil, nil)
+
+				return h
+			},
+			wantResult: reconcile.Result{RequeueAfter: 15*time.Hour + 30*time.Minute},
+			check: func(t *testing.T, k8sClient client.Client, backup *keycloakApi.KeycloakRealmBackup) {
+				assert.Equal(t, common.StatusOK, backup.Status.Value)
+				assert.Equal(t, now, backup.Status.LastBackupTime.UTC())
+				assert.Equal(t, "backup-20240315-103000", backup.Status.LastBackupName)
+				assert.NotEmpty(t, backup.Status.LastBackupChecksum)
+				assert.Positive(t, backup.Status.LastBackupSize)
+				assert.Equal(t, time.Date(2024, time.March, 16, 2, 0, 0, 0, time.UTC), backup.Status.NextBackupTime.UTC())
+
+				secrets := &corev1.SecretList{}
+				require.NoError(t, k8sClient.List(context.Background(), secrets, client.InNamespace("default")))
+
+				names := make([]string, 0, len(secrets.Items))
+				for _, s := range secrets.Items {
+					names = append(names, s.Name)
+				}
+
+				assert.ElementsMatch(t, []string{
+					"backup-20240314-020000-0",
+					"backup-20240315-103000-0",
+					"other-20240301-020000-0",
+				}, names)
+
+				stored := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Namespace: "default",
+					Name:      "backup-20240315-103000-0",
+				}, stored))
+
+				assert.Equal(t, backup.Status.LastBackupChecksum, stored.Annotations[checksumAnnotation])
+				assert.Equal(t, "1", stored.Annotations[chunkTotalAnnotation])
+
+				var exported map[string]any
+				require.NoError(t, json.Unmarshal(stored.Data[backupDataKey], &exported))
+				assert.Equal(t, redactedValue, exported["clients"].([]any)[0].(map[string]any)["secret"])
+			},
+		},
+		{
+			name: "backup is not due",
+			backup: newTestBackup(
+				keycloakApi.RealmBackupDestination{Secret: &keycloakApi.RealmBackupObjectDestination{}},
+				keycloakApi.KeycloakRealmBackupStatus{
+					LastBackupTime: ptr.To(metav1.NewTime(time.Date(2024, time.March, 15, 2, 0, 0, 0, time.UTC))),
+				},
+			),
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				h := helpermock.NewMockControllerHelper(t)
+
+				h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil)
+
+				return h
+			},
+			wantResult: reconcile.Result{RequeueAfter: 15*time.Hour + 30*time.Minute},
+			check: func(t *testing.T, k8sClient client.Client, backup *keycloakApi.KeycloakRealmBackup) {
+				assert.Equal(t, common.StatusOK, backup.Status.Value)
+				assert.Equal(t, time.Date(2024, time.March, 16, 2, 0, 0, 0, time.UTC), backup.Status.NextBackupTime.UTC())
+
+				secrets := &corev1.SecretList{}
+				require.NoError(t, k8sClient.List(context.Background(), secrets))
+				assert.Empty(t, secrets.Items)
+			},
+		},
+		{
+			name: "invalid schedule",
+			backup: func() *keycloakApi.KeycloakRealmBackup {
+				b := newTestBackup(keycloakApi.RealmBackupDestination{Secret: &keycloakApi.RealmBackupObjectDestination{}},
+					keycloakApi.KeycloakRealmBackupStatus{})
+				b.Spec.Schedule = "invalid"
+
+				return b
+			}(),
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				h := helpermock.NewMockControllerHelper(t)
+
+				h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil)
+				h.On("SetFailureCount", mock.Anything).Return(time.Minute)
+
+				return h
+			},
+			wantResult: reconcile.Result{RequeueAfter: time.Minute},
+			check: func(t *testing.T, _ client.Client, backup *keycloakApi.KeycloakRealmBackup) {
+				assert.Contains(t, backup.Status.Value, "invalid schedule")
+			},
+		},
+		{
+			name: "export fails",
+			backup: newTestBackup(
+				keycloakApi.RealmBackupDestination{ConfigMap: &keycloakApi.RealmBackupObjectDestination{}},
+				keycloakApi.KeycloakRealmBackupStatus{},
+			),
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				h := helpermock.NewMockControllerHelper(t)
+				realmClient := keycloakapimocks.NewMockRealmClient(t)
+
+				h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil)
+				h.On("CreateKeycloakClientFromRealmRef", mock.Anything, mock.Anything).
+					Return(&keycloakapi.KeycloakClient{Realms: realmClient}, nil)
+				h.On("GetRealmNameFromRef", mock.Anything, mock.Anything).Return("test-realm", nil)
+				h.On("SetFailureCount", mock.Anything).Return(time.Minute)
+
+				realmClient.On("PartialExportRealm", mock.Anything, "test-realm", mock.Anything).
+					Return(nil, nil, errors.New("export error"))
+
+				return h
+			},
+			wantResult: reconcile.Result{RequeueAfter: time.Minute},
+			check: func(t *testing.T, _ client.Client, backup *keycloakApi.KeycloakRealmBackup) {
+				assert.Contains(t, backup.Status.Value, "export error")
+				assert.Nil(t, backup.Status.LastBackupTime)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objects, tt.backup)...).
+				WithStatusSubresource(tt.backup).
+				Build()
+
+			r := NewReconcileKeycloakRealmBackup(k8sClient, tt.helper(t))
+			r.now = func() time.Time { return now }
+
+			res, err := r.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: tt.backup.Namespace, Name: tt.backup.Name},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, res)
+
+			got := &keycloakApi.KeycloakRealmBackup{}
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tt.backup), got))
+
+			tt.check(t, k8sClient, got)
+		})
+	}
+}
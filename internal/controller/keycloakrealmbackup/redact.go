@@ -0,0 +1,76 @@
+package keycloakrealmbackup
+
+import (
+	"strings"
+)
+
+// redactedValue is the same mask Keycloak uses for secret values in realm exports.
+const redactedValue = "**********"
+
+// secretFields are lowercase names of fields that hold secret values.
+// Realm representation fields (e.g. smtpServer.password, clients[].secret),
+// identity provider config (clientSecret) and component config keys (e.g. bindCredential, privateKey) are covered.
+var secretFields = map[string]struct{}{
+	"secret":             {},
+	"clientsecret":       {},
+	"password":           {},
+	"bindcredential":     {},
+	"privatekey":         {},
+	"keystorepassword":   {},
+	"keypassword":        {},
+	"truststorepassword": {},
+}
+
+// redactSecrets walks the decoded JSON document and replaces values of secret fields with a mask.
+func redactSecrets(doc any) any {
+	switch v := doc.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if isSecretField(key) {
+				v[key] = redactValue(val)
+				continue
+			}
+
+			v[key] = redactSecrets(val)
+		}
+
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = redactSecrets(val)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// redactValue masks string values, including strings in arrays (component config values).
+// Non-string values like booleans are kept to preserve the document structure.
+func redactValue(val any) any {
+	switch v := val.(type) {
+	case string:
+		if v == "" {
+			return v
+		}
+
+		return redactedValue
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+
+		return v
+	case map[string]any:
+		return redactSecrets(v)
+	default:
+		return v
+	}
+}
+
+func isSecretField(name string) bool {
+	_, ok := secretFields[strings.ToLower(name)]
+
+	return ok
+}
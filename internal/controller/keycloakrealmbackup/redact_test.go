@@ -0,0 +1,57 @@
+package keycloakrealmbackup
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	t.Parallel()
+
+	input := `{
+		"realm": "test",
+		"passwordPolicy": "length(8)",
+		"smtpServer": {"host": "smtp.example.com", "password": "smtp-pass"},
+		"clients": [
+			{"clientId": "app", "secret": "client-secret", "publicClient": false},
+			{"clientId": "public", "secret": ""}
+		],
+		"identityProviders": [
+			{"alias": "github", "config": {"clientId": "gh", "clientSecret": "gh-secret"}}
+		],
+		"components": {
+			"org.keycloak.storage.UserStorageProvider": [
+				{"name": "ldap", "config": {"bindDn": ["cn=admin"], "bindCredential": ["ldap-pass"]}}
+			]
+		}
+	}`
+
+	var doc any
+	require.NoError(t, json.Unmarshal([]byte(input), &doc))
+
+	got, ok := redactSecrets(doc).(map[string]any)
+	require.True(t, ok)
+
+	assert.Equal(t, "test", got["realm"])
+	assert.Equal(t, "length(8)", got["passwordPolicy"])
+
+	smtp := got["smtpServer"].(map[string]any)
+	assert.Equal(t, "smtp.example.com", smtp["host"])
+	assert.Equal(t, redactedValue, smtp["password"])
+
+	clients := got["clients"].([]any)
+	assert.Equal(t, redactedValue, clients[0].(map[string]any)["secret"])
+	assert.Equal(t, false, clients[0].(map[string]any)["publicClient"])
+	assert.Equal(t, "", clients[1].(map[string]any)["secret"], "empty values should be kept")
+
+	idpConfig := got["identityProviders"].([]any)[0].(map[string]any)["config"].(map[string]any)
+	assert.Equal(t, "gh", idpConfig["clientId"])
+	assert.Equal(t, redactedValue, idpConfig["clientSecret"])
+
+	ldapConfig := got["components"].(map[string]any)["org.keycloak.storage.UserStorageProvider"].([]any)[0].(map[string]any)["config"].(map[string]any)
+	assert.Equal(t, []any{"cn=admin"}, ldapConfig["bindDn"])
+	assert.Equal(t, []any{redactedValue}, ldapConfig["bindCredential"])
+}
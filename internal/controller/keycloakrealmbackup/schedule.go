@@ -0,0 +1,21 @@
+package keycloakrealmbackup
+
+import (
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+)
+
+// scheduleParser parses standard five-field cron expressions and descriptors like @daily.
+var scheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// parseSchedule parses the backup schedule.
+// Next of the returned schedule returns zero time if there is no activation within five years, e.g. for "0 0 30 2 *".
+func parseSchedule(spec string) (cron.Schedule, error) {
+	sched, err := scheduleParser.Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+
+	return sched, nil
+}
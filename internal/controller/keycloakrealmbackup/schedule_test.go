@@ -0,0 +1,130 @@
+package keycloakrealmbackup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule_Next(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC) // Friday
+
+	tests := []struct {
+		name     string
+		schedule string
+		want     time.Time
+	}{
+		{
+			name:     "every minute",
+			schedule: "* * * * *",
+			want:     time.Date(2024, time.March, 15, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name:     "daily at 2am",
+			schedule: "0 2 * * *",
+			want:     time.Date(2024, time.March, 16, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "daily descriptor",
+			schedule: "@daily",
+			want:     time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "hourly descriptor",
+			schedule: "@hourly",
+			want:     time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "every 15 minutes",
+			schedule: "*/15 * * * *",
+			want:     time.Date(2024, time.March, 15, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name:     "list of hours",
+			schedule: "0 6,18 * * *",
+			want:     time.Date(2024, time.March, 15, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekdays range by name",
+			schedule: "0 9 * * mon-fri",
+			want:     time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "every descriptor",
+			schedule: "@every 2h",
+			want:     time.Date(2024, time.March, 15, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "monthly by month name",
+			schedule: "0 0 1 jun *",
+			want:     time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day of month or day of week",
+			schedule: "0 0 20 * 6",
+			want:     time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "step with start",
+			schedule: "5/20 * * * *",
+			want:     time.Date(2024, time.March, 15, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day",
+			schedule: "0 0 29 2 *",
+			want:     time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := parseSchedule(tt.schedule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Next(after))
+		})
+	}
+}
+
+func TestParseSchedule_NeverMatches(t *testing.T) {
+	t.Parallel()
+
+	s, err := parseSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, s.Next(time.Now()).IsZero())
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		schedule string
+		wantErr  string
+	}{
+		{name: "empty", schedule: "", wantErr: "empty spec string"},
+		{name: "too many fields", schedule: "0 0 * * * *", wantErr: "expected exactly 5 fields"},
+		{name: "minute out of range", schedule: "60 * * * *", wantErr: "above maximum"},
+		{name: "hour out of range", schedule: "0 24 * * *", wantErr: "above maximum"},
+		{name: "zero day of month", schedule: "0 0 0 * *", wantErr: "below minimum"},
+		{name: "unknown month", schedule: "0 0 1 foo *", wantErr: "failed to parse int"},
+		{name: "invalid step", schedule: "*/0 * * * *", wantErr: "step of range should be a positive number"},
+		{name: "reversed range", schedule: "0 0 * * 5-1", wantErr: "beyond end of range"},
+		{name: "unknown descriptor", schedule: "@fortnightly", wantErr: "unrecognized descriptor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseSchedule(tt.schedule)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
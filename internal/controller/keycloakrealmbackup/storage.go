@@ -0,0 +1,299 @@
+package keycloakrealmbackup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+const (
+	// backupLabel is set on Secrets and ConfigMaps holding backup data, the value is the KeycloakRealmBackup name.
+	backupLabel = "edp.epam.com/realm-backup"
+	// backupIDLabel is set on Secrets and ConfigMaps holding backup data, the value is the backup ID.
+	backupIDLabel = "edp.epam.com/realm-backup-id"
+
+	checksumAnnotation   = "edp.epam.com/realm-backup-checksum"
+	chunkAnnotation      = "edp.epam.com/realm-backup-chunk"
+	chunkTotalAnnotation = "edp.epam.com/realm-backup-chunks"
+
+	// backupDataKey is a key of the Secret or ConfigMap data that holds the backup chunk.
+	backupDataKey = "realm.json"
+
+	// backupIDLayout is a layout of the backup ID. IDs are sortable in chronological order.
+	backupIDLayout = "20060102-150405"
+
+	defaultChunkSize = 512 * 1024
+	defaultRetention = 7
+)
+
+// backupStorage stores realm backups and removes the outdated ones.
+type backupStorage interface {
+	// Store saves backup data and returns the backup name.
+	Store(ctx context.Context, backup *keycloakApi.KeycloakRealmBackup, id string, data []byte, checksum string) (string, error)
+	// Prune removes all backups except the given number of the latest ones.
+	Prune(ctx context.Context, backup *keycloakApi.KeycloakRealmBackup, retention int) error
+}
+
+func makeBackupID(t time.Time) string {
+	return t.UTC().Format(backupIDLayout)
+}
+
+// newBackupStorage returns storage for the backup destination.
+func newBackupStorage(k8sClient client.Client, destination keycloakApi.RealmBackupDestination) (backupStorage, error) {
+	switch {
+	case destination.Secret != nil:
+		return &objectStorage{client: k8sClient, destination: destination.Secret, useSecret: true}, nil
+	case destination.ConfigMap != nil:
+		return &objectStorage{client: k8sClient, destination: destination.ConfigMap}, nil
+	case destination.Volume != nil:
+		return &volumeStorage{path: destination.Volume.Path}, nil
+	default:
+		return nil, fmt.Errorf("backup destination is not specified")
+	}
+}
+
+// objectStorage stores backups in Secrets or ConfigMaps split into chunks.
+type objectStorage struct {
+	client      client.Client
+	destination *keycloakApi.RealmBackupObjectDestination
+	useSecret   bool
+}
+
+func (s *objectStorage) Store(
+	ctx context.Context,
+	backup *keycloakApi.KeycloakRealmBackup,
+	id string,
+	data []byte,
+	checksum string,
+) (string, error) {
+	name := fmt.Sprintf("%s-%s", s.namePrefix(backup), id)
+	chunks := splitChunks(data, s.chunkSize())
+
+	for i, chunk := range chunks {
+		meta := metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", name, i),
+			Namespace: backup.Namespace,
+			Labels: map[string]string{
+				backupLabel:   backup.Name,
+				backupIDLabel: id,
+			},
+			Annotations: map[string]string{
+				checksumAnnotation:   checksum,
+				chunkAnnotation:      strconv.Itoa(i),
+				chunkTotalAnnotation: strconv.Itoa(len(chunks)),
+			},
+		}
+
+		var obj client.Object
+		if s.useSecret {
+			obj = &corev1.Secret{
+				ObjectMeta: meta,
+				Type:       corev1.SecretTypeOpaque,
+				Data:       map[string][]byte{backupDataKey: chunk},
+			}
+		} else {
+			obj = &corev1.ConfigMap{
+				ObjectMeta: meta,
+				BinaryData: map[string][]byte{backupDataKey: chunk},
+			}
+		}
+
+		// Chunks are owned by the KeycloakRealmBackup, so they are garbage collected together with it.
+		if err := controllerutil.SetControllerReference(backup, obj, s.client.Scheme()); err != nil {
+			return "", fmt.Errorf("unable to set owner reference on backup chunk %s: %w", obj.GetName(), err)
+		}
+
+		if err := s.client.Create(ctx, obj); err != nil {
+			return "", fmt.Errorf("unable to create backup chunk %s: %w", obj.GetName(), err)
+		}
+	}
+
+	return name, nil
+}
+
+func (s *objectStorage) Prune(ctx context.Context, backup *keycloakApi.KeycloakRealmBackup, retention int) error {
+	objects, err := s.listBackupObjects(ctx, backup)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string][]client.Object)
+	for _, obj := range objects {
+		id := obj.GetLabels()[backupIDLabel]
+		byID[id] = append(byID[id], obj)
+	}
+
+	for _, id := range outdatedBackupIDs(byID, retention) {
+		for _, obj := range byID[id] {
+			if err := s.client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("unable to delete outdated backup chunk %s: %w", obj.GetName(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *objectStorage) listBackupObjects(ctx context.Context, backup *keycloakApi.KeycloakRealmBackup) ([]client.Object, error) {
+	opts := []client.ListOption{
+		client.InNamespace(backup.Namespace),
+		client.MatchingLabels{backupLabel: backup.Name},
+	}
+
+	var objects []client.Object
+
+	if s.useSecret {
+		list := &corev1.SecretList{}
+		if err := s.client.List(ctx, list, opts...); err != nil {
+			return nil, fmt.Errorf("unable to list backup secrets: %w", err)
+		}
+
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+
+		return objects, nil
+	}
+
+	list := &corev1.ConfigMapList{}
+	if err := s.client.List(ctx, list, opts...); err != nil {
+		return nil, fmt.Errorf("unable to list backup configmaps: %w", err)
+	}
+
+	for i := range list.Items {
+		objects = append(objects, &list.Items[i])
+	}
+
+	return objects, nil
+}
+
+func (s *objectStorage) namePrefix(backup *keycloakApi.KeycloakRealmBackup) string {
+	if s.destination.NamePrefix != "" {
+		return s.destination.NamePrefix
+	}
+
+	return backup.Name
+}
+
+func (s *objectStorage) chunkSize() int {
+	if s.destination.ChunkSize > 0 {
+		return s.destination.ChunkSize
+	}
+
+	return defaultChunkSize
+}
+
+// volumeStorage stores backups as files in a directory on a mounted volume.
+type volumeStorage struct {
+	path string
+}
+
+func (s *volumeStorage) Store(
+	_ context.Context,
+	backup *keycloakApi.KeycloakRealmBackup,
+	id string,
+	data []byte,
+	_ string,
+) (string, error) {
+	if err := os.MkdirAll(s.path, 0o750); err != nil {
+		return "", fmt.Errorf("unable to create backup directory: %w", err)
+	}
+
+	fileName := filepath.Join(s.path, backupFilePrefix(backup)+id+".json")
+
+	// Write to a temporary file first so that a partially written backup is never picked up.
+	tmpName := fileName + ".tmp"
+	if err := os.WriteFile(tmpName, data, 0o600); err != nil {
+		return "", fmt.Errorf("unable to write backup file: %w", err)
+	}
+
+	if err := os.Rename(tmpName, fileName); err != nil {
+		return "", fmt.Errorf("unable to rename backup file: %w", err)
+	}
+
+	return fileName, nil
+}
+
+func (s *volumeStorage) Prune(_ context.Context, backup *keycloakApi.KeycloakRealmBackup, retention int) error {
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		return fmt.Errorf("unable to read backup directory: %w", err)
+	}
+
+	prefix := backupFilePrefix(backup)
+	files := make(map[string][]string)
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+
+		id := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".json")
+
+		// Skip files of other backups whose name starts with the same prefix.
+		if _, err := time.Parse(backupIDLayout, id); err != nil {
+			continue
+		}
+
+		files[id] = append(files[id], filepath.Join(s.path, name))
+	}
+
+	for _, id := range outdatedBackupIDs(files, retention) {
+		for _, f := range files[id] {
+			if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("unable to delete outdated backup file %s: %w", f, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func backupFilePrefix(backup *keycloakApi.KeycloakRealmBackup) string {
+	return fmt.Sprintf("%s-%s-", backup.Namespace, backup.Name)
+}
+
+// outdatedBackupIDs returns IDs of backups that exceed the retention.
+func outdatedBackupIDs[T any](backups map[string]T, retention int) []string {
+	ids := make([]string, 0, len(backups))
+	for id := range backups {
+		ids = append(ids, id)
+	}
+
+	if len(ids) <= retention {
+		return nil
+	}
+
+	// IDs are timestamps, so the newest backups are at the end.
+	sort.Strings(ids)
+
+	return ids[:len(ids)-retention]
+}
+
+func splitChunks(data []byte, size int) [][]byte {
+	if len(data) == 0 {
+		return [][]byte{{}}
+	}
+
+	chunks := make([][]byte, 0, len(data)/size+1)
+
+	for start := 0; start < len(data); start += size {
+		end := min(start+size, len(data))
+		chunks = append(chunks, data[start:end])
+	}
+
+	return chunks
+}
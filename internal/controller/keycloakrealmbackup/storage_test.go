@@ -0,0 +1,106 @@
+package keycloakrealmbackup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+func TestObjectStorage_StoreChunks(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	backup := &keycloakApi.KeycloakRealmBackup{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default", UID: "backup-uid"}}
+
+	storage, err := newBackupStorage(k8sClient, keycloakApi.RealmBackupDestination{
+		ConfigMap: &keycloakApi.RealmBackupObjectDestination{NamePrefix: "realm", ChunkSize: 4},
+	})
+	require.NoError(t, err)
+
+	name, err := storage.Store(context.Background(), backup, "20240315-103000", []byte("0123456789"), "sum")
+	require.NoError(t, err)
+	assert.Equal(t, "realm-20240315-103000", name)
+
+	configMaps := &corev1.ConfigMapList{}
+	require.NoError(t, k8sClient.List(context.Background(), configMaps, client.MatchingLabels{backupLabel: "backup"}))
+	require.Len(t, configMaps.Items, 3)
+
+	var data []byte
+
+	for i, suffix := range []string{"0", "1", "2"} {
+		cm := &corev1.ConfigMap{}
+		require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{
+			Namespace: "default",
+			Name:      "realm-20240315-103000-" + suffix,
+		}, cm))
+
+		assert.Equal(t, suffix, cm.Annotations[chunkAnnotation], "chunk %d", i)
+		assert.Equal(t, "3", cm.Annotations[chunkTotalAnnotation])
+		assert.Equal(t, "sum", cm.Annotations[checksumAnnotation])
+		require.Len(t, cm.OwnerReferences, 1)
+		assert.Equal(t, backup.UID, cm.OwnerReferences[0].UID)
+
+		data = append(data, cm.BinaryData[backupDataKey]...)
+	}
+
+	assert.Equal(t, "0123456789", string(data))
+}
+
+func TestVolumeStorage_StoreAndPrune(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	backup := &keycloakApi.KeycloakRealmBackup{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"}}
+
+	// Backup of another resource with the same name prefix must not be pruned.
+	otherFile := filepath.Join(dir, "default-backup-other-20240301-000000.json")
+	require.NoError(t, os.WriteFile(otherFile, []byte("{}"), 0o600))
+
+	storage, err := newBackupStorage(nil, keycloakApi.RealmBackupDestination{
+		Volume: &keycloakApi.RealmBackupVolumeDestination{Path: dir},
+	})
+	require.NoError(t, err)
+
+	for _, id := range []string{"20240313-020000", "20240314-020000", "20240315-020000"} {
+		_, err = storage.Store(context.Background(), backup, id, []byte(`{"realm":"test"}`), "")
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, storage.Prune(context.Background(), backup, 2))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+
+	assert.ElementsMatch(t, []string{
+		"default-backup-other-20240301-000000.json",
+		"default-backup-20240314-020000.json",
+		"default-backup-20240315-020000.json",
+	}, names)
+}
+
+func TestNewBackupStorage_NoDestination(t *testing.T) {
+	t.Parallel()
+
+	_, err := newBackupStorage(nil, keycloakApi.RealmBackupDestination{})
+	require.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -35,7 +36,10 @@ func (ch *Chain) Serve(ctx context.Context, component *keycloakApi.KeycloakRealm
 	log.Info("Starting KeycloakRealmComponent chain")
 
 	for _, h := range ch.handlers {
-		if err := h.Serve(ctx, component, realmName); err != nil {
+		err := h.Serve(ctx, component, realmName)
+		status.SetStep(component, stepCondition(h), err)
+
+		if err != nil {
 			log.Info("KeycloakRealmComponent chain finished with error")
 
 			return fmt.Errorf("failed to serve handler: %w", err)
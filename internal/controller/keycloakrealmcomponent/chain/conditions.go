@@ -0,0 +1,18 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionComponentSynced indicates whether the realm component has been created or updated in Keycloak.
+	ConditionComponentSynced = "ComponentSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h RealmComponentHandler) string {
+	switch h.(type) {
+	case *CreateOrUpdateComponent:
+		return ConditionComponentSynced
+	default:
+		return ""
+	}
+}
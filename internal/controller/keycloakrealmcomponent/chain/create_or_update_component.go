@@ -8,7 +8,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -69,6 +72,11 @@ func (h *CreateOrUpdateComponent) Serve(
 		repr.ParentId = &parentID
 	}
 
+	configHash, err := drift.Hash(repr)
+	if err != nil {
+		return fmt.Errorf("unable to hash realm component: %w", err)
+	}
+
 	existing, err := h.kClient.RealmComponents.FindComponentByName(ctx, realmName, spec.Name)
 	if err != nil {
 		return fmt.Errorf("failed to find component by name: %w", err)
@@ -81,8 +89,10 @@ func (h *CreateOrUpdateComponent) Serve(
 		}
 
 		component.Status.ID = keycloakapi.GetResourceIDFromResponse(resp)
+		component.Status.ConfigHash = configHash
 
 		log.Info("Realm component created")
+		events.Normal(ctx, component, events.ReasonCreated, "Realm component %s created", component.Spec.Name)
 
 		return nil
 	}
@@ -92,11 +102,30 @@ func (h *CreateOrUpdateComponent) Serve(
 		repr.Id = existing.Id
 	}
 
+	diffs, err := drift.Diff(repr, existing)
+	if err != nil {
+		return fmt.Errorf("unable to compare realm component: %w", err)
+	}
+
+	// Keycloak doesn't return secrets, so their changes are detected by the hash of the applied configuration.
+	upToDate := len(diffs) == 0 && component.Status.ConfigHash == configHash
+
+	metrics.RecordWrite(keycloakApi.KeycloakRealmComponentKind, !upToDate)
+
+	if upToDate {
+		log.Info("Realm component is up to date, skipping update")
+
+		return nil
+	}
+
 	if _, err := h.kClient.RealmComponents.UpdateComponent(ctx, realmName, component.Status.ID, repr); err != nil {
 		return fmt.Errorf("failed to update realm component: %w", err)
 	}
 
+	component.Status.ConfigHash = configHash
+
 	log.Info("Realm component updated")
+	events.Normal(ctx, component, events.ReasonUpdated, "Realm component %s updated", component.Spec.Name)
 
 	return nil
 }
@@ -16,6 +16,7 @@ import (
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 const (
@@ -122,6 +123,53 @@ func TestCreateOrUpdateComponent_Serve_UpdateExisting(t *testing.T) {
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.NoError(t, err)
 	assert.Equal(t, testComponentID, component.Status.ID)
+	assert.NotEmpty(t, component.Status.ConfigHash)
+}
+
+func TestCreateOrUpdateComponent_Serve_UpToDate(t *testing.T) {
+	mockComponents := mocks.NewMockRealmComponentsClient(t)
+	kClient := &keycloakapi.KeycloakClient{RealmComponents: mockComponents}
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme(t)).Build()
+
+	component := baseComponent()
+	component.Spec.Config = map[string][]string{
+		"bindDn":         {"cn=admin"},
+		"bindCredential": {"secret"},
+	}
+
+	configHash, err := drift.Hash(keycloakapi.ComponentRepresentation{
+		Name:         ptr.To(testComponentName),
+		ProviderId:   ptr.To(testProviderID),
+		ProviderType: ptr.To(testProviderType),
+		Config: ptr.To(keycloakapi.MultivaluedHashMapStringString{
+			"bindDn":         {"cn=admin"},
+			"bindCredential": {"secret"},
+		}),
+	})
+	require.NoError(t, err)
+
+	component.Status.ConfigHash = configHash
+
+	mockComponents.EXPECT().
+		FindComponentByName(context.Background(), testRealmName, testComponentName).
+		Return(&keycloakapi.ComponentRepresentation{
+			Id:           ptr.To(testComponentID),
+			Name:         ptr.To(testComponentName),
+			ProviderId:   ptr.To(testProviderID),
+			ProviderType: ptr.To(testProviderType),
+			ParentId:     ptr.To("realm-id"),
+			Config: ptr.To(keycloakapi.MultivaluedHashMapStringString{
+				"bindDn":         {"cn=admin"},
+				"bindCredential": {"**********"},
+				"enabled":        {"true"},
+			}),
+		}, nil)
+
+	h := NewCreateOrUpdateComponent(fakeClient, kClient, &fakeSecretRefClient{})
+	err = h.Serve(context.Background(), component, testRealmName)
+	require.NoError(t, err)
+	assert.Equal(t, testComponentID, component.Status.ID)
+	assert.Equal(t, configHash, component.Status.ConfigHash)
 }
 
 func TestCreateOrUpdateComponent_Serve_FindByNameError(t *testing.T) {
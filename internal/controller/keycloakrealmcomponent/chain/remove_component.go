@@ -6,18 +6,21 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
 // RemoveComponent deletes a realm component from Keycloak.
 type RemoveComponent struct {
-	kClient *keycloakapi.KeycloakClient
+	kClient               *keycloakapi.KeycloakClient
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
-func NewRemoveComponent(kClient *keycloakapi.KeycloakClient) *RemoveComponent {
-	return &RemoveComponent{kClient: kClient}
+func NewRemoveComponent(kClient *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) *RemoveComponent {
+	return &RemoveComponent{kClient: kClient, defaultDeletionPolicy: defaultDeletionPolicy}
 }
 
 func (h *RemoveComponent) Serve(
@@ -28,7 +31,7 @@ func (h *RemoveComponent) Serve(
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start removing realm component")
 
-	if objectmeta.PreserveResourcesOnDeletion(component) {
+	if objectmeta.PreserveResourcesOnDeletion(component, h.defaultDeletionPolicy) {
 		log.Info("Preserve resources on deletion, skipping")
 
 		return nil
@@ -62,6 +65,7 @@ func (h *RemoveComponent) Serve(
 	}
 
 	log.Info("Realm component deleted successfully")
+	events.Normal(ctx, component, events.ReasonDeleted, "Realm component %s deleted", component.Spec.Name)
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -28,7 +29,7 @@ func TestRemoveComponent_Serve_SuccessWithStatusID(t *testing.T) {
 		DeleteComponent(context.Background(), testRealmName, testComponentID).
 		Return(nil, nil)
 
-	h := NewRemoveComponent(kClient)
+	h := NewRemoveComponent(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.NoError(t, err)
 }
@@ -50,7 +51,7 @@ func TestRemoveComponent_Serve_SuccessFallbackByName(t *testing.T) {
 		DeleteComponent(context.Background(), testRealmName, testComponentID).
 		Return(nil, nil)
 
-	h := NewRemoveComponent(kClient)
+	h := NewRemoveComponent(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.NoError(t, err)
 }
@@ -68,7 +69,7 @@ func TestRemoveComponent_Serve_ComponentNotFoundByName(t *testing.T) {
 		FindComponentByName(context.Background(), testRealmName, testComponentName).
 		Return(nil, nil)
 
-	h := NewRemoveComponent(kClient)
+	h := NewRemoveComponent(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.NoError(t, err)
 }
@@ -86,7 +87,7 @@ func TestRemoveComponent_Serve_DeleteNotFound(t *testing.T) {
 		DeleteComponent(context.Background(), testRealmName, testComponentID).
 		Return(nil, &keycloakapi.ApiError{Code: 404, Message: "Not Found"})
 
-	h := NewRemoveComponent(kClient)
+	h := NewRemoveComponent(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.NoError(t, err)
 }
@@ -104,7 +105,7 @@ func TestRemoveComponent_Serve_PreserveAnnotation(t *testing.T) {
 		Status: keycloakApi.KeycloakComponentStatus{ID: testComponentID},
 	}
 
-	h := NewRemoveComponent(kClient)
+	h := NewRemoveComponent(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.NoError(t, err)
 	// no mock expectations — verify no API calls were made
@@ -123,7 +124,7 @@ func TestRemoveComponent_Serve_FindByNameError(t *testing.T) {
 		FindComponentByName(context.Background(), testRealmName, testComponentName).
 		Return(nil, errors.New("lookup error"))
 
-	h := NewRemoveComponent(kClient)
+	h := NewRemoveComponent(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to find component for deletion")
@@ -142,7 +143,7 @@ func TestRemoveComponent_Serve_DeleteError(t *testing.T) {
 		DeleteComponent(context.Background(), testRealmName, testComponentID).
 		Return(nil, errors.New("delete error"))
 
-	h := NewRemoveComponent(kClient)
+	h := NewRemoveComponent(kClient, common.DeletionPolicyDelete)
 	err := h.Serve(context.Background(), component, testRealmName)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to delete realm component")
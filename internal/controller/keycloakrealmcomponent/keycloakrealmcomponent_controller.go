@@ -20,8 +20,13 @@ import (
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmcomponent/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -37,6 +42,8 @@ type RealmComponentHelper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 type RealmComponentReconciler struct {
@@ -44,6 +51,7 @@ type RealmComponentReconciler struct {
 	helper          RealmComponentHelper
 	secretRefClient chain.SecretRefClient
 	scheme          *runtime.Scheme
+	adminEvents     *adminevents.Watcher
 }
 
 func NewRealmComponentReconciler(
@@ -60,16 +68,71 @@ func NewRealmComponentReconciler(
 	}
 }
 
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *RealmComponentReconciler) WithAdminEvents(watcher *adminevents.Watcher) *RealmComponentReconciler {
+	r.adminEvents = watcher
+
+	return r
+}
+
 func (r *RealmComponentReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	if err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakRealmComponent{}).
-		Complete(r); err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakRealmComponent{})
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakRealmComponent{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmComponentList{} },
+		componentSecretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmComponent secret watches: %w", err)
+	}
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakRealmComponent{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmComponentList{} },
+		componentAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmComponent admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmComponent{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmComponent{}, r, pause.WithObserveModeSkip(r.helper, common.FinalizerName, legacyFinalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakRealmComponent controller: %w", err)
 	}
 
 	return nil
 }
 
+// componentSecretRefs returns Secrets referenced by the component config.
+func componentSecretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	if component, ok := obj.(*keycloakApi.KeycloakRealmComponent); ok {
+		for _, values := range component.Spec.Config {
+			for _, v := range values {
+				refs.AddSecretRefValue(component.Namespace, v)
+			}
+		}
+	}
+
+	return refs
+}
+
+// componentAdminEventKeys returns the admin event resource key of the component.
+func componentAdminEventKeys(obj client.Object) []string {
+	if component, ok := obj.(*keycloakApi.KeycloakRealmComponent); ok {
+		return []string{adminevents.Key(adminevents.ResourceComponents, component.Status.ID)}
+	}
+
+	return nil
+}
+
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmcomponents,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmcomponents/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmcomponents/finalizers,verbs=update
@@ -154,7 +217,7 @@ func (r *RealmComponentReconciler) handleDeletion(
 ) (reconcile.Result, error) {
 	if controllerutil.ContainsFinalizer(instance, common.FinalizerName) ||
 		controllerutil.ContainsFinalizer(instance, legacyFinalizerName) {
-		if err := chain.NewRemoveComponent(kClient).Serve(ctx, instance, realmName); err != nil {
+		if err := chain.NewRemoveComponent(kClient, r.helper.DefaultDeletionPolicy()).Serve(ctx, instance, realmName); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to remove realm component: %w", err)
 		}
 
@@ -186,6 +249,9 @@ func (r *RealmComponentReconciler) handleReconciliation(
 	oldStatus := instance.Status
 
 	if err := chain.MakeChain(r.client, kClient, r.secretRefClient).Serve(ctx, instance, realmName); err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
 		log.Error(err, "An error has occurred while handling KeycloakRealmComponent")
 
 		resultErr := fmt.Errorf("realm component chain processing failed: %w", err)
@@ -199,6 +265,7 @@ func (r *RealmComponentReconciler) handleReconciliation(
 	}
 
 	instance.Status.Value = common.StatusOK
+	status.SetReady(instance, "Reconciliation succeeded")
 
 	if err := r.updateStatus(ctx, instance, oldStatus); err != nil {
 		return reconcile.Result{}, err
@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -57,6 +58,8 @@ func (ch *Chain) Serve(
 		h := ch.handlers[i]
 
 		err := h.Serve(ctx, group, kClient, groupCtx)
+		status.SetStep(group, stepCondition(h), err)
+
 		if err != nil {
 			log.Info("KeycloakRealmGroup chain finished with error")
 
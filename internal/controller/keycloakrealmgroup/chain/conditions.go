@@ -0,0 +1,33 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionGroupSynced indicates whether the group has been created or updated in Keycloak.
+	ConditionGroupSynced = "GroupSynced"
+
+	// ConditionRealmRolesSynced indicates whether the group realm roles have been synced to Keycloak.
+	ConditionRealmRolesSynced = "RealmRolesSynced"
+
+	// ConditionClientRolesSynced indicates whether the group client roles have been synced to Keycloak.
+	ConditionClientRolesSynced = "ClientRolesSynced"
+
+	// ConditionSubGroupsSynced indicates whether the subgroups have been synced to Keycloak.
+	ConditionSubGroupsSynced = "SubGroupsSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h RealmGroupHandler) string {
+	switch h.(type) {
+	case *CreateOrUpdateGroup:
+		return ConditionGroupSynced
+	case *SyncRealmRoles:
+		return ConditionRealmRolesSynced
+	case *SyncClientRoles:
+		return ConditionClientRolesSynced
+	case *SyncSubGroups:
+		return ConditionSubGroupsSynced
+	default:
+		return ""
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 type CreateOrUpdateGroup struct {
@@ -109,8 +112,31 @@ func (h *CreateOrUpdateGroup) Serve(
 
 		groupCtx.GroupID = keycloakapi.GetResourceIDFromResponse(resp)
 		log.Info("Group created", "groupID", groupCtx.GroupID)
+		events.Normal(ctx, group, events.ReasonCreated, "Group %s created", group.Spec.Name)
 	} else {
 		groupCtx.GroupID = *existingGroup.Id
+
+		// Path is derived by Keycloak from the group name and parent, so it is not compared.
+		unchanged, err := drift.Equal(
+			keycloakapi.GroupRepresentation{Name: &spec.Name, Description: &spec.Description, Attributes: &spec.Attributes},
+			keycloakapi.GroupRepresentation{
+				Name:        existingGroup.Name,
+				Description: existingGroup.Description,
+				Attributes:  existingGroup.Attributes,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to compare group %q: %w", spec.Name, err)
+		}
+
+		metrics.RecordWrite(keycloakApi.KeycloakRealmGroupKind, !unchanged)
+
+		if unchanged {
+			log.Info("Group is up to date, skipping update", "groupID", groupCtx.GroupID)
+
+			return nil
+		}
+
 		existingGroup.Name = &spec.Name
 		existingGroup.Description = &spec.Description
 		existingGroup.Path = &spec.Path
@@ -121,6 +147,7 @@ func (h *CreateOrUpdateGroup) Serve(
 		}
 
 		log.Info("Group updated", "groupID", groupCtx.GroupID)
+		events.Normal(ctx, group, events.ReasonUpdated, "Group %s updated", group.Spec.Name)
 	}
 
 	return nil
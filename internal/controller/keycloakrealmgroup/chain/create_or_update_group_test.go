@@ -149,6 +149,33 @@ func TestCreateOrUpdateGroup_Serve_UpdateExisting(t *testing.T) {
 	assert.Equal(t, "existing-id", groupCtx.GroupID)
 }
 
+func TestCreateOrUpdateGroup_Serve_UpToDateSkipsUpdate(t *testing.T) {
+	mockGroups := mocks.NewMockGroupsClient(t)
+
+	kClient := &keycloakapi.KeycloakClient{Groups: mockGroups}
+	groupCtx := &GroupContext{RealmName: "test-realm", GroupID: "existing-id"}
+
+	group := &keycloakApi.KeycloakRealmGroup{}
+	group.Spec.Name = testExistingGroup
+	group.Spec.Path = testUpdatedPath
+	group.Spec.Attributes = map[string][]string{"key": {"val"}}
+
+	// No UpdateGroup expectation: the group already matches the spec.
+	mockGroups.EXPECT().GetGroup(
+		context.Background(), "test-realm", "existing-id",
+	).Return(&keycloakapi.GroupRepresentation{
+		Id:         ptr.To("existing-id"),
+		Name:       ptr.To(testExistingGroup),
+		Path:       ptr.To("/" + testExistingGroup),
+		Attributes: &map[string][]string{"key": {"val"}},
+	}, nil, nil)
+
+	h := NewCreateOrUpdateGroup(newFakeK8sClient(t))
+	err := h.Serve(context.Background(), group, kClient, groupCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "existing-id", groupCtx.GroupID)
+}
+
 func TestCreateOrUpdateGroup_Serve_FindGroupError(t *testing.T) {
 	mockGroups := mocks.NewMockGroupsClient(t)
 
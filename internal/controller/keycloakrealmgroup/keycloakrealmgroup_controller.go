@@ -4,18 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/dependency"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmgroup/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
@@ -29,6 +37,8 @@ type Helper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 func NewReconcileKeycloakRealmGroup(
@@ -45,6 +55,14 @@ type ReconcileKeycloakRealmGroup struct {
 	client                  client.Client
 	helper                  Helper
 	successReconcileTimeout time.Duration
+	adminEvents             *adminevents.Watcher
+}
+
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *ReconcileKeycloakRealmGroup) WithAdminEvents(watcher *adminevents.Watcher) *ReconcileKeycloakRealmGroup {
+	r.adminEvents = watcher
+
+	return r
 }
 
 func (r *ReconcileKeycloakRealmGroup) SetupWithManager(mgr ctrl.Manager, successReconcileTimeout time.Duration) error {
@@ -54,16 +72,76 @@ func (r *ReconcileKeycloakRealmGroup) SetupWithManager(mgr ctrl.Manager, success
 		UpdateFunc: helper.IsFailuresUpdated,
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakRealmGroup{}, builder.WithPredicates(pred)).
-		Complete(r)
-	if err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakRealmGroup{}, builder.WithPredicates(pred))
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakRealmGroup{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmGroupList{} },
+		realmGroupAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmGroup admin events watch: %w", err)
+	}
+
+	if err := dependency.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakRealmGroup{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmGroupList{} },
+		realmGroupDependencies,
+		keycloakApi.KeycloakRealmRoleKind,
+		keycloakApi.KeycloakRealmGroupKind,
+		keycloakApi.KeycloakClientKind,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmGroup dependencies: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmGroup{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmGroup{}, r, pause.WithObserveModeSkip(r.helper, keyCloakRealmGroupOperatorFinalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakRealmGroup controller: %w", err)
 	}
 
 	return nil
 }
 
+// realmGroupAdminEventKeys returns the admin event resource key of the group.
+func realmGroupAdminEventKeys(obj client.Object) []string {
+	if group, ok := obj.(*keycloakApi.KeycloakRealmGroup); ok {
+		return []string{adminevents.Key(adminevents.ResourceGroups, group.Status.ID)}
+	}
+
+	return nil
+}
+
+// realmGroupDependencies returns references to Keycloak objects the group depends on.
+// The parent group is referenced by the custom resource name and is resolved separately.
+func realmGroupDependencies(obj client.Object) []dependency.Ref {
+	group, ok := obj.(*keycloakApi.KeycloakRealmGroup)
+	if !ok {
+		return nil
+	}
+
+	var refs []dependency.Ref
+
+	for _, role := range group.Spec.RealmRoles {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmRoleKind, Name: role})
+	}
+
+	for _, subGroup := range group.Spec.SubGroups {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmGroupKind, Name: subGroup})
+	}
+
+	for _, clientRole := range group.Spec.ClientRoles {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakClientKind, Name: clientRole.ClientID})
+	}
+
+	return refs
+}
+
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmgroups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmgroups/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmgroups/finalizers,verbs=update
@@ -84,7 +162,23 @@ func (r *ReconcileKeycloakRealmGroup) Reconcile(ctx context.Context, request rec
 		return result, resultErr
 	}
 
+	if instance.GetDeletionTimestamp() == nil {
+		notReady, err := dependency.NotReady(ctx, r.client, &instance, realmGroupDependencies(&instance))
+		if err != nil {
+			return result, fmt.Errorf("failed to check keycloak realm group dependencies: %w", err)
+		}
+
+		meta.SetStatusCondition(&instance.Status.Conditions, dependency.Condition(notReady, instance.Generation))
+
+		if len(notReady) > 0 {
+			return r.waitForDependencies(ctx, &instance, notReady)
+		}
+	}
+
 	if err := r.tryReconcile(ctx, &instance); err != nil {
+		events.Error(ctx, &instance, err)
+		status.SetFailed(&instance, err)
+
 		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
 			return ctrl.Result{
 				RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod,
@@ -97,6 +191,7 @@ func (r *ReconcileKeycloakRealmGroup) Reconcile(ctx context.Context, request rec
 		log.Error(err, "an error has occurred while handling keycloak realm group", "name", request.Name)
 	} else {
 		helper.SetSuccessStatus(&instance)
+		status.SetReady(&instance, "Reconciliation succeeded")
 
 		result.RequeueAfter = r.successReconcileTimeout
 	}
@@ -110,6 +205,28 @@ func (r *ReconcileKeycloakRealmGroup) Reconcile(ctx context.Context, request rec
 	return result, resultErr
 }
 
+// waitForDependencies sets the status of the group that waits for not ready dependencies.
+// The group is enqueued by the dependency watches as soon as the dependencies become ready.
+func (r *ReconcileKeycloakRealmGroup) waitForDependencies(
+	ctx context.Context,
+	instance *keycloakApi.KeycloakRealmGroup,
+	notReady []string,
+) (reconcile.Result, error) {
+	ctrl.LoggerFrom(ctx).Info("Waiting for dependencies", "dependencies", notReady)
+
+	message := fmt.Sprintf("Waiting for %s", strings.Join(notReady, ", "))
+
+	status.SetNotReady(instance, dependency.ReasonDependencyNotReady, message)
+
+	instance.Status.Value = message
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to update status: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.successReconcileTimeout}, nil
+}
+
 func (r *ReconcileKeycloakRealmGroup) tryReconcile(ctx context.Context, keycloakRealmGroup *keycloakApi.KeycloakRealmGroup) error {
 	// TODO: Move this validation to a validating webhook when webhook is configured.
 	// Validate that SubGroups and ParentGroup are not used together.
@@ -148,7 +265,7 @@ func (r *ReconcileKeycloakRealmGroup) tryReconcile(ctx context.Context, keycloak
 			realmName,
 			keycloakRealmGroup.Status.ID,
 			keycloakRealmGroup.Spec.Name,
-			objectmeta.PreserveResourcesOnDeletion(keycloakRealmGroup),
+			objectmeta.PreserveResourcesOnDeletion(keycloakRealmGroup, r.helper.DefaultDeletionPolicy()),
 		),
 		keyCloakRealmGroupOperatorFinalizerName,
 	)
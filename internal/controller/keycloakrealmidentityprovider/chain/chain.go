@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/secretref"
 )
@@ -41,6 +42,8 @@ func (ch *Chain) Serve(
 		h := ch.handlers[i]
 
 		err := h.Serve(ctx, keycloakRealmIDP, realmName)
+		status.SetStep(keycloakRealmIDP, stepCondition(h), err)
+
 		if err != nil {
 			log.Info("KeycloakIDP chain finished with error")
 
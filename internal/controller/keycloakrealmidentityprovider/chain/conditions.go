@@ -0,0 +1,28 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionIdentityProviderSynced indicates whether the identity provider has been created or updated in Keycloak.
+	ConditionIdentityProviderSynced = "IdentityProviderSynced"
+
+	// ConditionMappersSynced indicates whether the identity provider mappers have been synced to Keycloak.
+	ConditionMappersSynced = "MappersSynced"
+
+	// ConditionAdminFineGrainedPermissionsSynced indicates whether the admin fine-grained permissions have been synced to Keycloak.
+	ConditionAdminFineGrainedPermissionsSynced = "AdminFineGrainedPermissionsSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h ClientHandler) string {
+	switch h.(type) {
+	case *PutIDP:
+		return ConditionIdentityProviderSynced
+	case *PutIDPMappers:
+		return ConditionMappersSynced
+	case *PutAdminFineGrainedPermissions:
+		return ConditionAdminFineGrainedPermissionsSynced
+	default:
+		return ""
+	}
+}
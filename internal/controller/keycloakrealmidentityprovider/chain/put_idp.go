@@ -9,7 +9,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 type refClient interface {
@@ -43,21 +46,58 @@ func (h *PutIDP) Serve(ctx context.Context, keycloakRealmIDP *keycloakApi.Keyclo
 		return fmt.Errorf("failed to check if the identity provider exists: %w", err)
 	}
 
+	configHash, err := drift.Hash(idpRep)
+	if err != nil {
+		return fmt.Errorf("unable to hash idp: %w", err)
+	}
+
 	if existingIDP != nil {
+		upToDate, err := isIdentityProviderUpToDate(idpRep, existingIDP)
+		if err != nil {
+			return err
+		}
+
+		// Keycloak doesn't return secrets, so their changes are detected by the hash of the applied configuration.
+		upToDate = upToDate && keycloakRealmIDP.Status.ConfigHash == configHash
+
+		metrics.RecordWrite(keycloakApi.KeycloakRealmIdentityProviderKind, !upToDate)
+
+		if upToDate {
+			log.Info("Identity provider is up to date, skipping update")
+
+			return nil
+		}
+
 		if _, err = h.idpClient.UpdateIdentityProvider(ctx, realmName, keycloakRealmIDP.Spec.Alias, idpRep); err != nil {
 			return fmt.Errorf("unable to update idp: %w", err)
 		}
+
+		events.Normal(ctx, keycloakRealmIDP, events.ReasonUpdated, "Identity provider %s updated", keycloakRealmIDP.Spec.Alias)
 	} else {
 		if _, err = h.idpClient.CreateIdentityProvider(ctx, realmName, idpRep); err != nil {
 			return fmt.Errorf("unable to create idp: %w", err)
 		}
+
+		events.Normal(ctx, keycloakRealmIDP, events.ReasonCreated, "Identity provider %s created", keycloakRealmIDP.Spec.Alias)
 	}
 
+	keycloakRealmIDP.Status.ConfigHash = configHash
+
 	log.Info("End put keycloak idp")
 
 	return nil
 }
 
+// isIdentityProviderUpToDate returns true if the live identity provider already has all fields set in the desired one.
+func isIdentityProviderUpToDate(desired keycloakapi.IdentityProviderRepresentation, live *keycloakapi.IdentityProviderRepresentation) (bool, error) {
+	diffs, err := drift.Diff(desired, live)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare idp: %w", err)
+	}
+
+	return len(diffs) == 0, nil
+}
+
 func specToIdentityProviderRepresentation(spec *keycloakApi.KeycloakRealmIdentityProviderSpec, config map[string]string) keycloakapi.IdentityProviderRepresentation {
 	return keycloakapi.IdentityProviderRepresentation{
 		Alias:                     &spec.Alias,
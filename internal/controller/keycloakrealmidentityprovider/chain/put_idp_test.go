@@ -15,6 +15,7 @@ import (
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	keycloakapimocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 	secretrefmocks "github.com/epam/edp-keycloak-operator/pkg/secretref/mocks"
 )
 
@@ -85,6 +86,63 @@ func TestPutIDP_Serve(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "identity provider is up to date",
+			idp:  upToDateIDP(t),
+			idpClient: func(t *testing.T) keycloakapi.IdentityProvidersClient {
+				m := keycloakapimocks.NewMockIdentityProvidersClient(t)
+				m.On("GetIdentityProvider", mock.Anything, "realm", "test-idp").
+					Return(&keycloakapi.IdentityProviderRepresentation{
+						Alias:      ptr.To("test-idp"),
+						InternalId: ptr.To("internal-id"),
+						ProviderId: ptr.To("github"),
+						Enabled:    ptr.To(true),
+						Config: &map[string]string{
+							"clientId":     "test-client",
+							"clientSecret": "**********",
+							"syncMode":     "LEGACY",
+						},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+				return m
+			},
+			secretRef: func(t *testing.T) refClient {
+				m := secretrefmocks.NewMockRefClient(t)
+				m.On("MapConfigSecretsRefs", mock.Anything, mock.Anything, "default").Return(nil)
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "secret of identity provider changed",
+			idp: func() *keycloakApi.KeycloakRealmIdentityProvider {
+				idp := upToDateIDP(t)
+				idp.Status.ConfigHash = "previous-hash"
+
+				return idp
+			}(),
+			idpClient: func(t *testing.T) keycloakapi.IdentityProvidersClient {
+				m := keycloakapimocks.NewMockIdentityProvidersClient(t)
+				m.On("GetIdentityProvider", mock.Anything, "realm", "test-idp").
+					Return(&keycloakapi.IdentityProviderRepresentation{
+						Alias:      ptr.To("test-idp"),
+						ProviderId: ptr.To("github"),
+						Enabled:    ptr.To(true),
+						Config: &map[string]string{
+							"clientId":     "test-client",
+							"clientSecret": "**********",
+						},
+					}, (*keycloakapi.Response)(nil), nil).Once()
+				m.On("UpdateIdentityProvider", mock.Anything, "realm", "test-idp", mock.Anything).
+					Return((*keycloakapi.Response)(nil), nil).Once()
+				return m
+			},
+			secretRef: func(t *testing.T) refClient {
+				m := secretrefmocks.NewMockRefClient(t)
+				m.On("MapConfigSecretsRefs", mock.Anything, mock.Anything, "default").Return(nil)
+				return m
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "secret ref mapping fails",
 			idp: &keycloakApi.KeycloakRealmIdentityProvider{
@@ -119,6 +177,36 @@ func TestPutIDP_Serve(t *testing.T) {
 				"realm",
 			)
 			tt.wantErr(t, err)
+
+			if err == nil {
+				require.NotEqual(t, "previous-hash", tt.idp.Status.ConfigHash)
+				require.NotEmpty(t, tt.idp.Status.ConfigHash)
+			}
 		})
 	}
 }
+
+// upToDateIDP returns an identity provider with the hash of its applied configuration in the status.
+func upToDateIDP(t *testing.T) *keycloakApi.KeycloakRealmIdentityProvider {
+	t.Helper()
+
+	idp := &keycloakApi.KeycloakRealmIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Spec: keycloakApi.KeycloakRealmIdentityProviderSpec{
+			Alias:      "test-idp",
+			ProviderID: "github",
+			Enabled:    true,
+			Config: map[string]string{
+				"clientId":     "test-client",
+				"clientSecret": "secret-value",
+			},
+		},
+	}
+
+	hash, err := drift.Hash(specToIdentityProviderRepresentation(&idp.Spec, idp.Spec.Config))
+	require.NoError(t, err)
+
+	idp.Status.ConfigHash = hash
+
+	return idp
+}
@@ -6,24 +6,27 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
 type RemoveIDP struct {
-	kClient *keycloakapi.KeycloakClient
+	kClient               *keycloakapi.KeycloakClient
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
-func NewRemoveIDP(kClient *keycloakapi.KeycloakClient) *RemoveIDP {
-	return &RemoveIDP{kClient: kClient}
+func NewRemoveIDP(kClient *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) *RemoveIDP {
+	return &RemoveIDP{kClient: kClient, defaultDeletionPolicy: defaultDeletionPolicy}
 }
 
 func (h *RemoveIDP) Serve(ctx context.Context, idp *keycloakApi.KeycloakRealmIdentityProvider, realmName string) error {
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Start removing identity provider")
 
-	if objectmeta.PreserveResourcesOnDeletion(idp) {
+	if objectmeta.PreserveResourcesOnDeletion(idp, h.defaultDeletionPolicy) {
 		log.Info("Preserve resources on deletion, skipping")
 
 		return nil
@@ -40,6 +43,7 @@ func (h *RemoveIDP) Serve(ctx context.Context, idp *keycloakApi.KeycloakRealmIde
 	}
 
 	log.Info("Identity provider deleted successfully")
+	events.Normal(ctx, idp, events.ReasonDeleted, "Identity provider %s deleted", idp.Spec.Alias)
 
 	return nil
 }
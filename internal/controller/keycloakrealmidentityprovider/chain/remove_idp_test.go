@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	keycloakapimocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -96,7 +97,7 @@ func TestRemoveIDP_Serve(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			h := NewRemoveIDP(tt.kClient(t))
+			h := NewRemoveIDP(tt.kClient(t), common.DeletionPolicyDelete)
 			err := h.Serve(
 				ctrl.LoggerInto(context.Background(), logr.Discard()),
 				tt.idp,
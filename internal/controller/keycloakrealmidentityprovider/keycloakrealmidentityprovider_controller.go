@@ -15,8 +15,13 @@ import (
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmidentityprovider/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -30,11 +35,14 @@ type IdentityProviderReconcilerCtrlHelper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 type IdentityProviderReconciler struct {
-	client client.Client
-	helper IdentityProviderReconcilerCtrlHelper
+	client      client.Client
+	helper      IdentityProviderReconcilerCtrlHelper
+	adminEvents *adminevents.Watcher
 }
 
 func NewIdentityProviderReconciler(k8sClient client.Client, controllerHelper IdentityProviderReconcilerCtrlHelper) *IdentityProviderReconciler {
@@ -44,16 +52,69 @@ func NewIdentityProviderReconciler(k8sClient client.Client, controllerHelper Ide
 	}
 }
 
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *IdentityProviderReconciler) WithAdminEvents(watcher *adminevents.Watcher) *IdentityProviderReconciler {
+	r.adminEvents = watcher
+
+	return r
+}
+
 func (r *IdentityProviderReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	if err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakRealmIdentityProvider{}).
-		Complete(r); err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakRealmIdentityProvider{})
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakRealmIdentityProvider{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmIdentityProviderList{} },
+		identityProviderSecretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmIdentityProvider secret watches: %w", err)
+	}
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakRealmIdentityProvider{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmIdentityProviderList{} },
+		identityProviderAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmIdentityProvider admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmIdentityProvider{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmIdentityProvider{}, r, pause.WithObserveModeSkip(r.helper, common.FinalizerName, legacyFinalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakRealmIdentityProvider controller: %w", err)
 	}
 
 	return nil
 }
 
+// identityProviderSecretRefs returns Secrets referenced by the identity provider config.
+func identityProviderSecretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	if idp, ok := obj.(*keycloakApi.KeycloakRealmIdentityProvider); ok {
+		for _, v := range idp.Spec.Config {
+			refs.AddSecretRefValue(idp.Namespace, v)
+		}
+	}
+
+	return refs
+}
+
+// identityProviderAdminEventKeys returns the admin event resource key of the identity provider.
+func identityProviderAdminEventKeys(obj client.Object) []string {
+	if idp, ok := obj.(*keycloakApi.KeycloakRealmIdentityProvider); ok {
+		return []string{adminevents.Key(adminevents.ResourceIdentityProviders, idp.Spec.Alias)}
+	}
+
+	return nil
+}
+
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmidentityproviders,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmidentityproviders/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmidentityproviders/finalizers,verbs=update
@@ -123,7 +184,7 @@ func (r *IdentityProviderReconciler) initializeReconciliation(ctx context.Contex
 
 func (r *IdentityProviderReconciler) handleDeletion(ctx context.Context, instance *keycloakApi.KeycloakRealmIdentityProvider, kClient *keycloakapi.KeycloakClient, realmName string) (reconcile.Result, error) {
 	if controllerutil.ContainsFinalizer(instance, common.FinalizerName) || controllerutil.ContainsFinalizer(instance, legacyFinalizerName) {
-		if err := chain.NewRemoveIDP(kClient).Serve(ctx, instance, realmName); err != nil {
+		if err := chain.NewRemoveIDP(kClient, r.helper.DefaultDeletionPolicy()).Serve(ctx, instance, realmName); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to remove identity provider: %w", err)
 		}
 
@@ -150,6 +211,9 @@ func (r *IdentityProviderReconciler) handleReconciliation(ctx context.Context, i
 	oldStatus := instance.Status
 
 	if err := chain.MakeChain(kClient, r.client).Serve(ctx, instance, realmName); err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
 		log.Error(err, "An error has occurred while handling KeycloakRealmIdentityProvider")
 
 		resultErr := fmt.Errorf("identity provider chain processing failed: %w", err)
@@ -163,6 +227,7 @@ func (r *IdentityProviderReconciler) handleReconciliation(ctx context.Context, i
 	}
 
 	instance.Status.Value = common.StatusOK
+	status.SetReady(instance, "Reconciliation succeeded")
 
 	if err := r.updateStatus(ctx, instance, oldStatus); err != nil {
 		return reconcile.Result{}, err
@@ -7,6 +7,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -47,6 +48,8 @@ func (ch *Chain) Serve(
 		h := ch.handlers[i]
 
 		err := h.Serve(ctx, role, realmName, roleCtx)
+		status.SetStep(role, stepCondition(h), err)
+
 		if err != nil {
 			log.Info("KeycloakRealmRole chain finished with error")
 
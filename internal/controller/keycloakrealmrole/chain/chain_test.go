@@ -80,3 +80,12 @@ func TestChain_Serve_MiddleHandlerError(t *testing.T) {
 	assert.True(t, h1.called)
 	assert.False(t, h3.called)
 }
+
+func TestMakeChain_StepConditions(t *testing.T) {
+	ch := MakeChain(nil)
+	for _, h := range ch.handlers {
+		assert.NotEmpty(t, stepCondition(h))
+	}
+
+	assert.Empty(t, stepCondition(&mockHandler{}))
+}
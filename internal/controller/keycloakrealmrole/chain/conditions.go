@@ -0,0 +1,28 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionRoleSynced indicates whether the realm role has been created or updated in Keycloak.
+	ConditionRoleSynced = "RoleSynced"
+
+	// ConditionCompositesSynced indicates whether the composite roles have been synced to Keycloak.
+	ConditionCompositesSynced = "CompositesSynced"
+
+	// ConditionDefaultRoleSynced indicates whether the default realm role setting has been applied.
+	ConditionDefaultRoleSynced = "DefaultRoleSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h RealmRoleHandler) string {
+	switch h.(type) {
+	case *CreateOrUpdateRole:
+		return ConditionRoleSynced
+	case *SyncComposites:
+		return ConditionCompositesSynced
+	case *MakeDefault:
+		return ConditionDefaultRoleSynced
+	default:
+		return ""
+	}
+}
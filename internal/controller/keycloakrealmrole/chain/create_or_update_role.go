@@ -7,7 +7,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 type CreateOrUpdateRole struct {
@@ -49,18 +52,14 @@ func (h *CreateOrUpdateRole) Serve(
 			return fmt.Errorf("failed to create realm role: %w", err)
 		}
 
+		events.Normal(ctx, role, events.ReasonCreated, "Realm role %s created", spec.Name)
+
 		existingRole, _, err = rolesClient.GetRealmRole(ctx, realmName, spec.Name)
 		if err != nil {
 			return fmt.Errorf("failed to get created realm role: %w", err)
 		}
-	} else {
-		existingRole.Description = &desc
-		existingRole.Composite = &isComposite
-		existingRole.Attributes = &attrs
-
-		if _, err = rolesClient.UpdateRealmRole(ctx, realmName, spec.Name, *existingRole); err != nil {
-			return fmt.Errorf("failed to update realm role: %w", err)
-		}
+	} else if err = h.updateRole(ctx, role, realmName, existingRole); err != nil {
+		return err
 	}
 
 	if existingRole.Id != nil {
@@ -71,3 +70,48 @@ func (h *CreateOrUpdateRole) Serve(
 
 	return nil
 }
+
+// updateRole updates the realm role if its description, composite flag or attributes differ from the spec.
+func (h *CreateOrUpdateRole) updateRole(
+	ctx context.Context,
+	role *keycloakApi.KeycloakRealmRole,
+	realmName string,
+	existingRole *keycloakapi.RoleRepresentation,
+) error {
+	spec := role.Spec
+	isComposite := spec.Composite
+	attrs := spec.Attributes
+	desc := spec.Description
+
+	unchanged, err := drift.Equal(
+		keycloakapi.RoleRepresentation{Description: &desc, Composite: &isComposite, Attributes: &attrs},
+		keycloakapi.RoleRepresentation{
+			Description: existingRole.Description,
+			Composite:   existingRole.Composite,
+			Attributes:  existingRole.Attributes,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to compare realm role: %w", err)
+	}
+
+	metrics.RecordWrite(keycloakApi.KeycloakRealmRoleKind, !unchanged)
+
+	if unchanged {
+		ctrl.LoggerFrom(ctx).Info("Realm role is up to date, skipping update")
+
+		return nil
+	}
+
+	existingRole.Description = &desc
+	existingRole.Composite = &isComposite
+	existingRole.Attributes = &attrs
+
+	if _, err = h.kClient.Roles.UpdateRealmRole(ctx, realmName, spec.Name, *existingRole); err != nil {
+		return fmt.Errorf("failed to update realm role: %w", err)
+	}
+
+	events.Normal(ctx, role, events.ReasonUpdated, "Realm role %s updated", spec.Name)
+
+	return nil
+}
@@ -97,6 +97,32 @@ func TestCreateOrUpdateRole_Serve_UpdateExisting(t *testing.T) {
 	assert.Equal(t, "role-id-123", roleCtx.RoleID)
 }
 
+func TestCreateOrUpdateRole_Serve_UpToDate(t *testing.T) {
+	mockRoles := mocks.NewMockRolesClient(t)
+	kClient := &keycloakapi.KeycloakClient{Roles: mockRoles}
+	roleCtx := &RoleContext{}
+
+	role := &keycloakApi.KeycloakRealmRole{}
+	role.Spec.Name = testRoleName
+	role.Spec.Description = "Test description"
+	role.Spec.Attributes = map[string][]string{"key": {"val"}}
+
+	mockRoles.EXPECT().GetRealmRole(
+		context.Background(), "test-realm", testRoleName,
+	).Return(&keycloakapi.RoleRepresentation{
+		Id:          ptr.To("role-id-123"),
+		Name:        ptr.To(testRoleName),
+		Description: ptr.To("Test description"),
+		Composite:   ptr.To(false),
+		Attributes:  &map[string][]string{"key": {"val"}},
+	}, nil, nil)
+
+	h := NewCreateOrUpdateRole(kClient)
+	err := h.Serve(context.Background(), role, "test-realm", roleCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "role-id-123", roleCtx.RoleID)
+}
+
 func TestCreateOrUpdateRole_Serve_GetRealmRoleError(t *testing.T) {
 	mockRoles := mocks.NewMockRolesClient(t)
 	kClient := &keycloakapi.KeycloakClient{Roles: mockRoles}
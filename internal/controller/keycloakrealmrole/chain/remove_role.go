@@ -6,17 +6,20 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
 type RemoveRole struct {
-	kClient *keycloakapi.KeycloakClient
+	kClient               *keycloakapi.KeycloakClient
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
-func NewRemoveRole(kClient *keycloakapi.KeycloakClient) *RemoveRole {
-	return &RemoveRole{kClient: kClient}
+func NewRemoveRole(kClient *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) *RemoveRole {
+	return &RemoveRole{kClient: kClient, defaultDeletionPolicy: defaultDeletionPolicy}
 }
 
 func (h *RemoveRole) ServeRequest(ctx context.Context, role *keycloakApi.KeycloakRealmRole, realmName string) error {
@@ -24,7 +27,7 @@ func (h *RemoveRole) ServeRequest(ctx context.Context, role *keycloakApi.Keycloa
 
 	log.Info("Start removing realm role")
 
-	if objectmeta.PreserveResourcesOnDeletion(role) {
+	if objectmeta.PreserveResourcesOnDeletion(role, h.defaultDeletionPolicy) {
 		log.Info("Preserve resources on deletion, skipping")
 
 		return nil
@@ -41,6 +44,7 @@ func (h *RemoveRole) ServeRequest(ctx context.Context, role *keycloakApi.Keycloa
 	}
 
 	log.Info("Realm role deleted successfully")
+	events.Normal(ctx, role, events.ReasonDeleted, "Realm role %s deleted", role.Spec.Name)
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
@@ -27,7 +28,7 @@ func TestRemoveRole_ServeRequest_Success(t *testing.T) {
 		context.Background(), "test-realm", testRoleName,
 	).Return(nil, nil)
 
-	h := NewRemoveRole(kClient)
+	h := NewRemoveRole(kClient, common.DeletionPolicyDelete)
 	err := h.ServeRequest(context.Background(), role, "test-realm")
 	require.NoError(t, err)
 }
@@ -43,7 +44,7 @@ func TestRemoveRole_ServeRequest_NotFound(t *testing.T) {
 		context.Background(), "test-realm", testRoleName,
 	).Return(nil, keycloakapi.ErrNotFound)
 
-	h := NewRemoveRole(kClient)
+	h := NewRemoveRole(kClient, common.DeletionPolicyDelete)
 	err := h.ServeRequest(context.Background(), role, "test-realm")
 	require.NoError(t, err)
 }
@@ -60,7 +61,7 @@ func TestRemoveRole_ServeRequest_PreserveOnDeletion(t *testing.T) {
 	}
 	role.Spec.Name = testRoleName
 
-	h := NewRemoveRole(kClient)
+	h := NewRemoveRole(kClient, common.DeletionPolicyDelete)
 	err := h.ServeRequest(context.Background(), role, "test-realm")
 	require.NoError(t, err)
 }
@@ -76,7 +77,7 @@ func TestRemoveRole_ServeRequest_DeleteError(t *testing.T) {
 		context.Background(), "test-realm", testRoleName,
 	).Return(nil, errors.New("api error"))
 
-	h := NewRemoveRole(kClient)
+	h := NewRemoveRole(kClient, common.DeletionPolicyDelete)
 	err := h.ServeRequest(context.Background(), role, "test-realm")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to delete realm role")
@@ -16,9 +16,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmrole/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -30,6 +35,8 @@ type Helper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 func NewReconcileKeycloakRealmRole(k8sClient client.Client, controllerHelper Helper) *ReconcileKeycloakRealmRole {
@@ -43,6 +50,14 @@ type ReconcileKeycloakRealmRole struct {
 	client                  client.Client
 	helper                  Helper
 	successReconcileTimeout time.Duration
+	adminEvents             *adminevents.Watcher
+}
+
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *ReconcileKeycloakRealmRole) WithAdminEvents(watcher *adminevents.Watcher) *ReconcileKeycloakRealmRole {
+	r.adminEvents = watcher
+
+	return r
 }
 
 func (r *ReconcileKeycloakRealmRole) SetupWithManager(mgr ctrl.Manager, successReconcileTimeout time.Duration) error {
@@ -52,16 +67,40 @@ func (r *ReconcileKeycloakRealmRole) SetupWithManager(mgr ctrl.Manager, successR
 		UpdateFunc: isSpecUpdated,
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakRealmRole{}, builder.WithPredicates(pred)).
-		Complete(r)
-	if err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakRealmRole{}, builder.WithPredicates(pred))
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakRealmRole{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmRoleList{} },
+		realmRoleAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmRole admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmRole{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmRole{}, r, pause.WithObserveModeSkip(r.helper, keyCloakRealmRoleOperatorFinalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakRealmRole controller: %w", err)
 	}
 
 	return nil
 }
 
+// realmRoleAdminEventKeys returns admin event resource keys of the role by ID and by name.
+func realmRoleAdminEventKeys(obj client.Object) []string {
+	if role, ok := obj.(*keycloakApi.KeycloakRealmRole); ok {
+		return []string{
+			adminevents.Key(adminevents.ResourceRolesByID, role.Status.ID),
+			adminevents.Key(adminevents.ResourceRoles, role.Spec.Name),
+		}
+	}
+
+	return nil
+}
+
 func isSpecUpdated(e event.UpdateEvent) bool {
 	oo, ok := e.ObjectOld.(*keycloakApi.KeycloakRealmRole)
 	if !ok {
@@ -105,6 +144,9 @@ func (r *ReconcileKeycloakRealmRole) Reconcile(ctx context.Context, request reco
 
 	roleID, err := r.tryReconcile(ctx, &instance)
 	if err != nil {
+		events.Error(ctx, &instance, err)
+		status.SetFailed(&instance, err)
+
 		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
 			return ctrl.Result{
 				RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod,
@@ -120,8 +162,10 @@ func (r *ReconcileKeycloakRealmRole) Reconcile(ctx context.Context, request reco
 		return result, resultErr
 	}
 
-	helper.SetSuccessStatus(&instance)
 	instance.Status.ID = roleID
+	helper.SetSuccessStatus(&instance)
+	status.SetReady(&instance, "Reconciliation succeeded")
+
 	result.RequeueAfter = r.successReconcileTimeout
 
 	log.Info("Reconciling done")
@@ -155,7 +199,7 @@ func (r *ReconcileKeycloakRealmRole) tryReconcile(ctx context.Context, keycloakR
 
 	if keycloakRealmRole.GetDeletionTimestamp() != nil {
 		if controllerutil.ContainsFinalizer(keycloakRealmRole, keyCloakRealmRoleOperatorFinalizerName) {
-			if err := chain.NewRemoveRole(kClient).ServeRequest(ctx, keycloakRealmRole, realmName); err != nil {
+			if err := chain.NewRemoveRole(kClient, r.helper.DefaultDeletionPolicy()).ServeRequest(ctx, keycloakRealmRole, realmName); err != nil {
 				return "", fmt.Errorf("failed to remove role: %w", err)
 			}
 
@@ -17,8 +17,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
@@ -28,6 +32,8 @@ type Helper interface {
 	TryToDelete(ctx context.Context, obj client.Object, terminator helper.Terminator, finalizer string) (isDeleted bool, resultErr error)
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	SetFailureCount(fc helper.FailureCountable) time.Duration
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 func NewReconcileKeycloakRealmRoleBatch(k8sClient client.Client, controllerHelper Helper) *ReconcileKeycloakRealmRoleBatch {
@@ -52,7 +58,7 @@ func (r *ReconcileKeycloakRealmRoleBatch) SetupWithManager(mgr ctrl.Manager, suc
 
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&keycloakApi.KeycloakRealmRoleBatch{}, builder.WithPredicates(pred)).
-		Complete(r)
+		Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmRoleBatch{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmRoleBatch{}, r, pause.WithObserveModeSkip(r.helper, keyCloakRealmRoleBatchOperatorFinalizerName)))))
 	if err != nil {
 		return fmt.Errorf("failed to setup KeycloakRealmRoleBatch controller: %w", err)
 	}
@@ -81,12 +87,16 @@ func (r *ReconcileKeycloakRealmRoleBatch) Reconcile(ctx context.Context, request
 	}
 
 	if err := r.tryReconcile(ctx, &instance); err != nil {
+		events.Error(ctx, &instance, err)
+		status.SetFailed(&instance, err)
+
 		instance.Status.Value = err.Error()
 		result.RequeueAfter = r.helper.SetFailureCount(&instance)
 
 		log.Error(err, "an error has occurred while handling keycloak realm role batch")
 	} else {
 		helper.SetSuccessStatus(&instance)
+		status.SetReady(&instance, "Reconciliation succeeded")
 
 		result.RequeueAfter = r.successReconcileTimeout
 	}
@@ -213,7 +223,7 @@ func (r *ReconcileKeycloakRealmRoleBatch) tryReconcile(ctx context.Context, batc
 	if _, err := r.helper.TryToDelete(
 		ctx,
 		batch,
-		makeTerminator(r.client, createdRoles, objectmeta.PreserveResourcesOnDeletion(batch)),
+		makeTerminator(r.client, createdRoles, objectmeta.PreserveResourcesOnDeletion(batch, r.helper.DefaultDeletionPolicy())),
 		keyCloakRealmRoleBatchOperatorFinalizerName,
 	); err != nil {
 		return fmt.Errorf("unable to delete keycloak realm role batch: %w", err)
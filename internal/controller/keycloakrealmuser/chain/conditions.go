@@ -0,0 +1,33 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionUserSynced indicates whether the user has been created or updated in Keycloak.
+	ConditionUserSynced = "UserSynced"
+
+	// ConditionRolesSynced indicates whether the user roles have been synced to Keycloak.
+	ConditionRolesSynced = "RolesSynced"
+
+	// ConditionGroupsSynced indicates whether the user groups have been synced to Keycloak.
+	ConditionGroupsSynced = "GroupsSynced"
+
+	// ConditionIdentityProvidersSynced indicates whether the user identity provider links have been synced to Keycloak.
+	ConditionIdentityProvidersSynced = "IdentityProvidersSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h RealmUserHandler) string {
+	switch h.(type) {
+	case *CreateOrUpdateUser:
+		return ConditionUserSynced
+	case *SyncUserRoles:
+		return ConditionRolesSynced
+	case *SyncUserGroups:
+		return ConditionGroupsSynced
+	case *SyncUserIdentityProviders:
+		return ConditionIdentityProvidersSynced
+	default:
+		return ""
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 const updatePasswordAction = "UPDATE_PASSWORD"
@@ -69,11 +71,17 @@ func (h *CreateOrUpdateUser) Serve(
 		userCtx.UserID = userID
 
 		log.Info("User created successfully", "userID", userID)
+		events.Normal(ctx, user, events.ReasonCreated, "User %s created", user.Spec.Username)
 
 		return nil
 	}
 
 	// User exists — update
+	before, err := drift.Normalize(*existing)
+	if err != nil {
+		return fmt.Errorf("unable to normalize user: %w", err)
+	}
+
 	requiredActions := preserveUpdatePasswordAction(existing.RequiredActions, userSpec.RequiredUserActions)
 	existing.Username = &userSpec.Username
 	existing.Enabled = &userSpec.Enabled
@@ -87,13 +95,25 @@ func (h *CreateOrUpdateUser) Serve(
 		existing.Attributes = makeUserAttributes(existing.Attributes, userSpec.AttributesV2, addOnly)
 	}
 
+	userCtx.UserID = *existing.Id
+
+	unchanged, err := drift.Equal(*existing, before)
+	if err != nil {
+		return fmt.Errorf("unable to compare user: %w", err)
+	}
+
+	if unchanged {
+		log.Info("User is up to date, skipping update", "userID", *existing.Id)
+
+		return nil
+	}
+
 	if _, err := h.kClient.Users.UpdateUser(ctx, realmName, *existing.Id, *existing); err != nil {
 		return fmt.Errorf("unable to update user: %w", err)
 	}
 
-	userCtx.UserID = *existing.Id
-
 	log.Info("User updated successfully", "userID", *existing.Id)
+	events.Normal(ctx, user, events.ReasonUpdated, "User %s updated", user.Spec.Username)
 
 	return nil
 }
@@ -135,6 +135,39 @@ func TestCreateOrUpdateUser_Serve(t *testing.T) {
 			wantErr:    require.NoError,
 			expectedID: "existing-user-id",
 		},
+		{
+			name: "success - existing user is up to date",
+			user: &keycloakApi.KeycloakRealmUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-user",
+					Namespace: "default",
+				},
+				Spec: keycloakApi.KeycloakRealmUserSpec{
+					Username:      "testuser",
+					Email:         "test@example.com",
+					FirstName:     "Test",
+					LastName:      "User",
+					Enabled:       true,
+					EmailVerified: true,
+				},
+			},
+			mockSetup: func(m *v2mocks.MockUsersClient) {
+				existingUser := &keycloakapi.UserRepresentation{
+					Id:              ptr.To("existing-user-id"),
+					Username:        ptr.To("testuser"),
+					Email:           ptr.To("test@example.com"),
+					FirstName:       ptr.To("Test"),
+					LastName:        ptr.To("User"),
+					Enabled:         ptr.To(true),
+					EmailVerified:   ptr.To(true),
+					RequiredActions: ptr.To([]string{}),
+				}
+				m.EXPECT().FindUserByUsername(context.Background(), "test-realm", "testuser").
+					Return(existingUser, nil, nil)
+			},
+			wantErr:    require.NoError,
+			expectedID: "existing-user-id",
+		},
 		{
 			name: "success - update user preserves UPDATE_PASSWORD action",
 			user: &keycloakApi.KeycloakRealmUser{
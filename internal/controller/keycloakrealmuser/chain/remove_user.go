@@ -6,17 +6,20 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
 )
 
 type RemoveUser struct {
-	kClient *keycloakapi.KeycloakClient
+	kClient               *keycloakapi.KeycloakClient
+	defaultDeletionPolicy common.DeletionPolicy
 }
 
-func NewRemoveUser(kClient *keycloakapi.KeycloakClient) *RemoveUser {
-	return &RemoveUser{kClient: kClient}
+func NewRemoveUser(kClient *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) *RemoveUser {
+	return &RemoveUser{kClient: kClient, defaultDeletionPolicy: defaultDeletionPolicy}
 }
 
 func (h *RemoveUser) ServeRequest(ctx context.Context, user *keycloakApi.KeycloakRealmUser, realmName string) error {
@@ -24,7 +27,7 @@ func (h *RemoveUser) ServeRequest(ctx context.Context, user *keycloakApi.Keycloa
 
 	log.Info("Start removing user")
 
-	if objectmeta.PreserveResourcesOnDeletion(user) {
+	if objectmeta.PreserveResourcesOnDeletion(user, h.defaultDeletionPolicy) {
 		log.Info("Preserve resources on deletion, skipping")
 
 		return nil
@@ -52,6 +55,7 @@ func (h *RemoveUser) ServeRequest(ctx context.Context, user *keycloakApi.Keycloa
 	}
 
 	log.Info("User deleted successfully")
+	events.Normal(ctx, user, events.ReasonDeleted, "User %s deleted", user.Spec.Username)
 
 	return nil
 }
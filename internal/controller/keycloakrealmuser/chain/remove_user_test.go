@@ -9,13 +9,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
 )
 
 func TestNewRemoveUser(t *testing.T) {
-	h := NewRemoveUser(nil)
+	h := NewRemoveUser(nil, common.DeletionPolicyDelete)
 	require.NotNil(t, h)
 }
 
@@ -143,7 +144,7 @@ func TestRemoveUser_ServeRequest(t *testing.T) {
 
 			h := NewRemoveUser(&keycloakapi.KeycloakClient{
 				Users: mockUsers,
-			})
+			}, common.DeletionPolicyDelete)
 
 			err := h.ServeRequest(context.Background(), tt.user, "test-realm")
 			tt.wantErr(t, err)
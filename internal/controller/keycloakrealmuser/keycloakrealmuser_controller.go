@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,8 +19,13 @@ import (
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/dependency"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
 	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
 	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakrealmuser/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
@@ -29,6 +36,8 @@ type Helper interface {
 	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
 	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
 	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
 }
 
 type Reconcile struct {
@@ -50,16 +59,83 @@ func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
 		},
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&keycloakApi.KeycloakRealmUser{}, builder.WithPredicates(pred)).
-		Complete(r)
-	if err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakRealmUser{}, builder.WithPredicates(pred))
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakRealmUser{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmUserList{} },
+		userSecretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmUser secret watches: %w", err)
+	}
+
+	if err := dependency.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakRealmUser{},
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmUserList{} },
+		userDependencies,
+		keycloakApi.KeycloakRealmRoleKind,
+		keycloakApi.KeycloakRealmGroupKind,
+		keycloakApi.KeycloakClientKind,
+		keycloakApi.KeycloakRealmIdentityProviderKind,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakRealmUser dependencies: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmUser{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakRealmUser{}, r, pause.WithObserveModeSkip(r.helper, finalizerName))))); err != nil {
 		return fmt.Errorf("failed to setup KeycloakRealmUser controller: %w", err)
 	}
 
 	return nil
 }
 
+// userSecretRefs returns the Secret with the user password.
+func userSecretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	if user, ok := obj.(*keycloakApi.KeycloakRealmUser); ok && user.Spec.PasswordSecret != nil {
+		refs.AddSecret(user.Namespace, user.Spec.PasswordSecret.Name)
+	}
+
+	return refs
+}
+
+// userDependencies returns references to Keycloak objects the user depends on.
+func userDependencies(obj client.Object) []dependency.Ref {
+	user, ok := obj.(*keycloakApi.KeycloakRealmUser)
+	if !ok {
+		return nil
+	}
+
+	var refs []dependency.Ref
+
+	for _, role := range user.Spec.Roles {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmRoleKind, Name: role})
+	}
+
+	for _, group := range user.Spec.Groups {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmGroupKind, Name: group})
+	}
+
+	for _, clientRole := range user.Spec.ClientRoles {
+		refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakClientKind, Name: clientRole.ClientID})
+	}
+
+	if user.Spec.IdentityProviders != nil {
+		for _, idp := range *user.Spec.IdentityProviders {
+			refs = append(refs, dependency.Ref{Kind: keycloakApi.KeycloakRealmIdentityProviderKind, Name: idp})
+		}
+	}
+
+	return refs
+}
+
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmusers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmusers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakrealmusers/finalizers,verbs=update
@@ -86,7 +162,23 @@ func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (c
 		return reconcile.Result{}, nil
 	}
 
+	if instance.GetDeletionTimestamp() == nil {
+		notReady, err := dependency.NotReady(ctx, r.client, &instance, userDependencies(&instance))
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to check keycloak realm user dependencies: %w", err)
+		}
+
+		meta.SetStatusCondition(&instance.Status.Conditions, dependency.Condition(notReady, instance.Generation))
+
+		if len(notReady) > 0 {
+			return r.waitForDependencies(ctx, &instance, oldStatus, notReady)
+		}
+	}
+
 	if err := r.tryReconcile(ctx, &instance); err != nil {
+		events.Error(ctx, &instance, err)
+		status.SetFailed(&instance, err)
+
 		log.Error(err, "An error has occurred while handling KeycloakRealmUser")
 
 		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
@@ -103,6 +195,8 @@ func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (c
 	}
 
 	instance.Status.Value = common.StatusOK
+	status.SetReady(&instance, "Reconciliation succeeded")
+
 	if statusErr := r.updateKeycloakRealmUserStatus(ctx, &instance, oldStatus); statusErr != nil {
 		return ctrl.Result{}, statusErr
 	}
@@ -112,6 +206,29 @@ func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (c
 	return ctrl.Result{}, nil
 }
 
+// waitForDependencies sets the status of the user that waits for not ready dependencies.
+// The user is enqueued by the dependency watches as soon as the dependencies become ready.
+func (r *Reconcile) waitForDependencies(
+	ctx context.Context,
+	instance *keycloakApi.KeycloakRealmUser,
+	oldStatus keycloakApi.KeycloakRealmUserStatus,
+	notReady []string,
+) (ctrl.Result, error) {
+	ctrl.LoggerFrom(ctx).Info("Waiting for dependencies", "dependencies", notReady)
+
+	message := fmt.Sprintf("Waiting for %s", strings.Join(notReady, ", "))
+
+	status.SetNotReady(instance, dependency.ReasonDependencyNotReady, message)
+
+	instance.Status.Value = message
+
+	if err := r.updateKeycloakRealmUserStatus(ctx, instance, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
 func (r *Reconcile) applyDefaults(ctx context.Context, instance *keycloakApi.KeycloakRealmUser) (bool, error) {
 	updated := false
 
@@ -158,7 +275,7 @@ func (r *Reconcile) tryReconcile(ctx context.Context, instance *keycloakApi.Keyc
 	if instance.Spec.KeepResource {
 		if instance.GetDeletionTimestamp() != nil {
 			if controllerutil.ContainsFinalizer(instance, finalizerName) {
-				if err := chain.NewRemoveUser(kClient).ServeRequest(ctx, instance, realmName); err != nil {
+				if err := chain.NewRemoveUser(kClient, r.helper.DefaultDeletionPolicy()).ServeRequest(ctx, instance, realmName); err != nil {
 					return fmt.Errorf("failed to remove user: %w", err)
 				}
 
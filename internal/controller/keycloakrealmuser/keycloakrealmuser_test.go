@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/dependency"
 )
 
 func TestReconcileKeycloakRealmUser_migrateAttributes(t *testing.T) {
@@ -90,3 +92,22 @@ func TestReconcileKeycloakRealmUser_migrateAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestUserDependencies(t *testing.T) {
+	user := &keycloakApi.KeycloakRealmUser{
+		Spec: keycloakApi.KeycloakRealmUserSpec{
+			Roles:             []string{"developer"},
+			Groups:            []string{"team"},
+			ClientRoles:       []keycloakApi.UserClientRole{{ClientID: "app", Roles: []string{"viewer"}}},
+			IdentityProviders: ptr.To([]string{"github"}),
+		},
+	}
+
+	assert.Equal(t, []dependency.Ref{
+		{Kind: keycloakApi.KeycloakRealmRoleKind, Name: "developer"},
+		{Kind: keycloakApi.KeycloakRealmGroupKind, Name: "team"},
+		{Kind: keycloakApi.KeycloakClientKind, Name: "app"},
+		{Kind: keycloakApi.KeycloakRealmIdentityProviderKind, Name: "github"},
+	}, userDependencies(user))
+	assert.Nil(t, userDependencies(&keycloakApi.KeycloakRealmGroup{}))
+}
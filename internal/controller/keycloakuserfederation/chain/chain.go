@@ -0,0 +1,60 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+// UserFederationHandler is a handler in the chain of responsibility for KeycloakUserFederation.
+type UserFederationHandler interface {
+	Serve(ctx context.Context, federation *keycloakApi.KeycloakUserFederation, realmName string) error
+}
+
+// Chain executes a sequence of UserFederationHandler instances.
+type Chain struct {
+	handlers []UserFederationHandler
+}
+
+func (ch *Chain) Use(handlers ...UserFederationHandler) {
+	ch.handlers = append(ch.handlers, handlers...)
+}
+
+func (ch *Chain) Serve(ctx context.Context, federation *keycloakApi.KeycloakUserFederation, realmName string) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Starting KeycloakUserFederation chain")
+
+	for _, h := range ch.handlers {
+		err := h.Serve(ctx, federation, realmName)
+		status.SetStep(federation, stepCondition(h), err)
+
+		if err != nil {
+			log.Info("KeycloakUserFederation chain finished with error")
+
+			return fmt.Errorf("failed to serve handler: %w", err)
+		}
+	}
+
+	log.Info("Handling of KeycloakUserFederation has been finished")
+
+	return nil
+}
+
+// MakeChain creates the reconciliation chain for KeycloakUserFederation.
+func MakeChain(k8sClient client.Client, kClient *keycloakapi.KeycloakClient) *Chain {
+	ch := &Chain{}
+
+	ch.Use(
+		NewCreateOrUpdateFederation(k8sClient, kClient),
+		NewPutMappers(kClient),
+		NewProcessActions(k8sClient, kClient),
+	)
+
+	return ch
+}
@@ -0,0 +1,28 @@
+package chain
+
+// Chain step conditions, set by the chain after each step.
+const (
+	// ConditionFederationSynced indicates whether the user federation has been created or updated in Keycloak.
+	ConditionFederationSynced = "FederationSynced"
+
+	// ConditionMappersSynced indicates whether the user federation mappers have been synced to Keycloak.
+	ConditionMappersSynced = "MappersSynced"
+
+	// ConditionActionsSynced indicates whether the user federation actions have been processed.
+	ConditionActionsSynced = "ActionsSynced"
+)
+
+// stepCondition returns the condition type reported for the chain handler.
+// Handlers without a dedicated condition return an empty string.
+func stepCondition(h UserFederationHandler) string {
+	switch h.(type) {
+	case *CreateOrUpdateFederation:
+		return ConditionFederationSynced
+	case *PutMappers:
+		return ConditionMappersSynced
+	case *ProcessActions:
+		return ConditionActionsSynced
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,218 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+const (
+	userStorageProviderType = "org.keycloak.storage.UserStorageProvider"
+	ldapMapperProviderType  = "org.keycloak.storage.ldap.mappers.LDAPStorageMapper"
+
+	providerLDAP     = "ldap"
+	providerKerberos = "kerberos"
+
+	// syncPeriodDisabled is a value that disables periodic synchronization in Keycloak.
+	syncPeriodDisabled = "-1"
+)
+
+// searchScopes maps search scope names to values expected by Keycloak.
+var searchScopes = map[string]string{
+	"OneLevel": "1",
+	"Subtree":  "2",
+}
+
+// providerID returns the provider ID of the federation, ldap is used by default.
+func providerID(federation *keycloakApi.KeycloakUserFederation) string {
+	if federation.Spec.ProviderID == "" {
+		return providerLDAP
+	}
+
+	return federation.Spec.ProviderID
+}
+
+// getBindCredential returns the LDAP bind credential from the referenced Secret or ConfigMap.
+func getBindCredential(
+	ctx context.Context,
+	k8sClient client.Client,
+	federation *keycloakApi.KeycloakUserFederation,
+) (string, error) {
+	if federation.Spec.LDAP == nil || federation.Spec.LDAP.Connection.BindCredential == nil {
+		return "", nil
+	}
+
+	credential, err := secretref.GetValueFromSourceRef(
+		ctx,
+		federation.Spec.LDAP.Connection.BindCredential,
+		federation.Namespace,
+		k8sClient,
+	)
+	if err != nil {
+		return "", fmt.Errorf("unable to get bind credential: %w", err)
+	}
+
+	return credential, nil
+}
+
+// federationConfig converts the typed federation spec to the Keycloak component config.
+func federationConfig(spec *keycloakApi.KeycloakUserFederationSpec, bindCredential string) keycloakapi.MultivaluedHashMapStringString {
+	config := keycloakapi.MultivaluedHashMapStringString{
+		"enabled":  {strconv.FormatBool(spec.Enabled == nil || *spec.Enabled)},
+		"priority": {strconv.Itoa(spec.Priority)},
+	}
+
+	setIfNotEmpty(config, "cachePolicy", spec.CachePolicy)
+
+	if ldap := spec.LDAP; ldap != nil {
+		config["vendor"] = []string{ldap.Vendor}
+		config["editMode"] = []string{ldap.EditMode}
+		config["importEnabled"] = []string{strconv.FormatBool(ldap.ImportEnabled == nil || *ldap.ImportEnabled)}
+		config["syncRegistrations"] = []string{strconv.FormatBool(ldap.SyncRegistrations)}
+		config["usersDn"] = []string{ldap.UsersDN}
+		config["usernameLDAPAttribute"] = []string{ldap.UsernameLDAPAttribute}
+		config["rdnLDAPAttribute"] = []string{ldap.RDNLDAPAttribute}
+		config["uuidLDAPAttribute"] = []string{ldap.UUIDLDAPAttribute}
+		config["userObjectClasses"] = []string{strings.Join(ldap.UserObjectClasses, ", ")}
+		config["customUserSearchFilter"] = []string{ldap.CustomUserSearchFilter}
+		config["searchScope"] = []string{searchScopes[ldap.SearchScope]}
+		config["pagination"] = []string{strconv.FormatBool(ldap.Pagination)}
+
+		conn := ldap.Connection
+		config["connectionUrl"] = []string{conn.URL}
+		config["startTls"] = []string{strconv.FormatBool(conn.StartTLS)}
+		config["useTruststoreSpi"] = []string{conn.UseTruststoreSPI}
+		config["connectionPooling"] = []string{strconv.FormatBool(conn.ConnectionPooling == nil || *conn.ConnectionPooling)}
+		config["authType"] = []string{conn.BindType}
+		config["bindDn"] = []string{conn.BindDN}
+		config["bindCredential"] = []string{bindCredential}
+
+		if conn.ConnectionTimeout != nil {
+			config["connectionTimeout"] = []string{strconv.Itoa(*conn.ConnectionTimeout)}
+		}
+
+		config["fullSyncPeriod"] = []string{syncPeriodDisabled}
+		config["changedSyncPeriod"] = []string{syncPeriodDisabled}
+
+		if ldap.Sync != nil {
+			if ldap.Sync.FullSyncPeriod != nil {
+				config["fullSyncPeriod"] = []string{strconv.Itoa(*ldap.Sync.FullSyncPeriod)}
+			}
+
+			if ldap.Sync.ChangedSyncPeriod != nil {
+				config["changedSyncPeriod"] = []string{strconv.Itoa(*ldap.Sync.ChangedSyncPeriod)}
+			}
+		}
+
+		config["allowKerberosAuthentication"] = []string{strconv.FormatBool(spec.Kerberos != nil)}
+	}
+
+	if kerberos := spec.Kerberos; kerberos != nil {
+		config["kerberosRealm"] = []string{kerberos.KerberosRealm}
+		config["serverPrincipal"] = []string{kerberos.ServerPrincipal}
+		config["keyTab"] = []string{kerberos.KeyTab}
+		config["debug"] = []string{strconv.FormatBool(kerberos.Debug)}
+
+		if spec.LDAP != nil {
+			config["useKerberosForPasswordAuthentication"] = []string{
+				strconv.FormatBool(kerberos.UseKerberosForPasswordAuthentication),
+			}
+		} else {
+			config["allowPasswordAuthentication"] = []string{strconv.FormatBool(kerberos.AllowPasswordAuthentication)}
+		}
+	}
+
+	return config
+}
+
+// mapperProviderID returns Keycloak provider ID of the mapper.
+func mapperProviderID(mapper *keycloakApi.UserFederationMapper) string {
+	switch {
+	case mapper.UserAttribute != nil:
+		return "user-attribute-ldap-mapper"
+	case mapper.FullName != nil:
+		return "full-name-ldap-mapper"
+	case mapper.Group != nil:
+		return "group-ldap-mapper"
+	case mapper.Role != nil:
+		return "role-ldap-mapper"
+	case mapper.HardcodedRole != nil:
+		return "hardcoded-ldap-role-mapper"
+	case mapper.Custom != nil:
+		return mapper.Custom.ProviderID
+	default:
+		return ""
+	}
+}
+
+// mapperConfig converts the typed mapper spec to the Keycloak component config.
+func mapperConfig(mapper *keycloakApi.UserFederationMapper) keycloakapi.MultivaluedHashMapStringString {
+	config := keycloakapi.MultivaluedHashMapStringString{}
+
+	switch {
+	case mapper.UserAttribute != nil:
+		m := mapper.UserAttribute
+		config["user.model.attribute"] = []string{m.UserModelAttribute}
+		config["ldap.attribute"] = []string{m.LDAPAttribute}
+		config["read.only"] = []string{strconv.FormatBool(m.ReadOnly)}
+		config["always.read.value.from.ldap"] = []string{strconv.FormatBool(m.AlwaysReadValueFromLDAP)}
+		config["is.mandatory.in.ldap"] = []string{strconv.FormatBool(m.IsMandatoryInLDAP)}
+		setIfNotEmpty(config, "attribute.default.value", m.DefaultValue)
+	case mapper.FullName != nil:
+		m := mapper.FullName
+		config["ldap.full.name.attribute"] = []string{m.LDAPFullNameAttribute}
+		config["read.only"] = []string{strconv.FormatBool(m.ReadOnly)}
+		config["write.only"] = []string{strconv.FormatBool(m.WriteOnly)}
+	case mapper.Group != nil:
+		m := mapper.Group
+		setMembershipConfig(config, &m.LDAPMembershipSettings)
+		config["groups.dn"] = []string{m.GroupsDN}
+		config["group.name.ldap.attribute"] = []string{m.GroupNameLDAPAttribute}
+		config["group.object.classes"] = []string{strings.Join(m.GroupObjectClasses, ", ")}
+		config["preserve.group.inheritance"] = []string{strconv.FormatBool(m.PreserveGroupInheritance)}
+		config["ignore.missing.groups"] = []string{strconv.FormatBool(m.IgnoreMissingGroups)}
+		config["drop.non.existing.groups.during.sync"] = []string{strconv.FormatBool(m.DropNonExistingGroupsDuringSync)}
+		setIfNotEmpty(config, "groups.ldap.filter", m.GroupsLDAPFilter)
+		setIfNotEmpty(config, "groups.path", m.GroupsPath)
+	case mapper.Role != nil:
+		m := mapper.Role
+		setMembershipConfig(config, &m.LDAPMembershipSettings)
+		config["roles.dn"] = []string{m.RolesDN}
+		config["role.name.ldap.attribute"] = []string{m.RoleNameLDAPAttribute}
+		config["role.object.classes"] = []string{strings.Join(m.RoleObjectClasses, ", ")}
+		config["use.realm.roles.mapping"] = []string{strconv.FormatBool(m.ClientID == "")}
+		setIfNotEmpty(config, "roles.ldap.filter", m.RolesLDAPFilter)
+		setIfNotEmpty(config, "client.id", m.ClientID)
+	case mapper.HardcodedRole != nil:
+		config["role"] = []string{mapper.HardcodedRole.Role}
+	case mapper.Custom != nil:
+		for k, v := range mapper.Custom.Config {
+			copied := make([]string, len(v))
+			copy(copied, v)
+			config[k] = copied
+		}
+	}
+
+	return config
+}
+
+func setMembershipConfig(config keycloakapi.MultivaluedHashMapStringString, m *keycloakApi.LDAPMembershipSettings) {
+	config["membership.ldap.attribute"] = []string{m.MembershipLDAPAttribute}
+	config["membership.attribute.type"] = []string{m.MembershipAttributeType}
+	config["membership.user.ldap.attribute"] = []string{m.MembershipUserLDAPAttribute}
+	config["mode"] = []string{m.Mode}
+	setIfNotEmpty(config, "user.roles.retrieve.strategy", m.UserRolesRetrieveStrategy)
+}
+
+func setIfNotEmpty(config keycloakapi.MultivaluedHashMapStringString, key, value string) {
+	if value != "" {
+		config[key] = []string{value}
+	}
+}
@@ -0,0 +1,206 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+func TestFederationConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		spec           keycloakApi.KeycloakUserFederationSpec
+		bindCredential string
+		want           keycloakapi.MultivaluedHashMapStringString
+	}{
+		{
+			name: "ldap with kerberos and sync periods",
+			spec: keycloakApi.KeycloakUserFederationSpec{
+				Enabled:     ptr.To(true),
+				Priority:    1,
+				CachePolicy: "NO_CACHE",
+				LDAP: &keycloakApi.LDAPFederationSettings{
+					Vendor:   "ad",
+					EditMode: "WRITABLE",
+					Connection: keycloakApi.LDAPConnection{
+						URL:               "ldaps://ldap:636",
+						UseTruststoreSPI:  "always",
+						ConnectionTimeout: ptr.To(5000),
+						BindType:          "simple",
+						BindDN:            "cn=admin",
+					},
+					UsersDN:               "ou=users",
+					UsernameLDAPAttribute: "sAMAccountName",
+					RDNLDAPAttribute:      "cn",
+					UUIDLDAPAttribute:     "objectGUID",
+					UserObjectClasses:     []string{"person", "user"},
+					SearchScope:           "Subtree",
+					Sync: &keycloakApi.UserFederationSyncSettings{
+						ChangedSyncPeriod: ptr.To(3600),
+					},
+				},
+				Kerberos: &keycloakApi.KerberosFederationSettings{
+					KerberosRealm:                        "EXAMPLE.COM",
+					ServerPrincipal:                      "HTTP/host@EXAMPLE.COM",
+					KeyTab:                               "/etc/krb5.keytab",
+					UseKerberosForPasswordAuthentication: true,
+				},
+			},
+			bindCredential: "secret",
+			want: keycloakapi.MultivaluedHashMapStringString{
+				"enabled":                              {"true"},
+				"priority":                             {"1"},
+				"cachePolicy":                          {"NO_CACHE"},
+				"vendor":                               {"ad"},
+				"editMode":                             {"WRITABLE"},
+				"importEnabled":                        {"true"},
+				"syncRegistrations":                    {"false"},
+				"usersDn":                              {"ou=users"},
+				"usernameLDAPAttribute":                {"sAMAccountName"},
+				"rdnLDAPAttribute":                     {"cn"},
+				"uuidLDAPAttribute":                    {"objectGUID"},
+				"userObjectClasses":                    {"person, user"},
+				"customUserSearchFilter":               {""},
+				"searchScope":                          {"2"},
+				"pagination":                           {"false"},
+				"connectionUrl":                        {"ldaps://ldap:636"},
+				"startTls":                             {"false"},
+				"useTruststoreSpi":                     {"always"},
+				"connectionPooling":                    {"true"},
+				"connectionTimeout":                    {"5000"},
+				"authType":                             {"simple"},
+				"bindDn":                               {"cn=admin"},
+				"bindCredential":                       {"secret"},
+				"fullSyncPeriod":                       {"-1"},
+				"changedSyncPeriod":                    {"3600"},
+				"allowKerberosAuthentication":          {"true"},
+				"kerberosRealm":                        {"EXAMPLE.COM"},
+				"serverPrincipal":                      {"HTTP/host@EXAMPLE.COM"},
+				"keyTab":                               {"/etc/krb5.keytab"},
+				"debug":                                {"false"},
+				"useKerberosForPasswordAuthentication": {"true"},
+			},
+		},
+		{
+			name: "kerberos",
+			spec: keycloakApi.KeycloakUserFederationSpec{
+				ProviderID: providerKerberos,
+				Enabled:    ptr.To(false),
+				Kerberos: &keycloakApi.KerberosFederationSettings{
+					KerberosRealm:               "EXAMPLE.COM",
+					ServerPrincipal:             "HTTP/host@EXAMPLE.COM",
+					KeyTab:                      "/etc/krb5.keytab",
+					AllowPasswordAuthentication: true,
+					Debug:                       true,
+				},
+			},
+			want: keycloakapi.MultivaluedHashMapStringString{
+				"enabled":                     {"false"},
+				"priority":                    {"0"},
+				"kerberosRealm":               {"EXAMPLE.COM"},
+				"serverPrincipal":             {"HTTP/host@EXAMPLE.COM"},
+				"keyTab":                      {"/etc/krb5.keytab"},
+				"debug":                       {"true"},
+				"allowPasswordAuthentication": {"true"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, federationConfig(&tt.spec, tt.bindCredential))
+		})
+	}
+}
+
+func TestMapperConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mapper         keycloakApi.UserFederationMapper
+		wantProviderID string
+		want           keycloakapi.MultivaluedHashMapStringString
+	}{
+		{
+			name: "user attribute",
+			mapper: keycloakApi.UserFederationMapper{
+				Name: "email",
+				UserAttribute: &keycloakApi.UserAttributeLDAPMapper{
+					UserModelAttribute: "email",
+					LDAPAttribute:      "mail",
+					ReadOnly:           true,
+				},
+			},
+			wantProviderID: "user-attribute-ldap-mapper",
+			want: keycloakapi.MultivaluedHashMapStringString{
+				"user.model.attribute":        {"email"},
+				"ldap.attribute":              {"mail"},
+				"read.only":                   {"true"},
+				"always.read.value.from.ldap": {"false"},
+				"is.mandatory.in.ldap":        {"false"},
+			},
+		},
+		{
+			name: "role mapped to client roles",
+			mapper: keycloakApi.UserFederationMapper{
+				Name: "roles",
+				Role: &keycloakApi.RoleLDAPMapper{
+					LDAPMembershipSettings: keycloakApi.LDAPMembershipSettings{
+						MembershipLDAPAttribute:     "member",
+						MembershipAttributeType:     "DN",
+						MembershipUserLDAPAttribute: "uid",
+						Mode:                        "READ_ONLY",
+					},
+					RolesDN:               "ou=roles",
+					RoleNameLDAPAttribute: "cn",
+					RoleObjectClasses:     []string{"groupOfNames"},
+					ClientID:              "app",
+				},
+			},
+			wantProviderID: "role-ldap-mapper",
+			want: keycloakapi.MultivaluedHashMapStringString{
+				"membership.ldap.attribute":      {"member"},
+				"membership.attribute.type":      {"DN"},
+				"membership.user.ldap.attribute": {"uid"},
+				"mode":                           {"READ_ONLY"},
+				"roles.dn":                       {"ou=roles"},
+				"role.name.ldap.attribute":       {"cn"},
+				"role.object.classes":            {"groupOfNames"},
+				"use.realm.roles.mapping":        {"false"},
+				"client.id":                      {"app"},
+			},
+		},
+		{
+			name: "custom",
+			mapper: keycloakApi.UserFederationMapper{
+				Name: "cert",
+				Custom: &keycloakApi.CustomLDAPMapper{
+					ProviderID: "certificate-ldap-mapper",
+					Config:     map[string][]string{"ldap.attribute": {"userCertificate"}},
+				},
+			},
+			wantProviderID: "certificate-ldap-mapper",
+			want: keycloakapi.MultivaluedHashMapStringString{
+				"ldap.attribute": {"userCertificate"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantProviderID, mapperProviderID(&tt.mapper))
+			assert.Equal(t, tt.want, mapperConfig(&tt.mapper))
+		})
+	}
+}
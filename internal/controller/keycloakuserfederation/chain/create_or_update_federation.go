@@ -0,0 +1,136 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
+)
+
+// CreateOrUpdateFederation creates or updates a user federation provider in Keycloak.
+type CreateOrUpdateFederation struct {
+	k8sClient client.Client
+	kClient   *keycloakapi.KeycloakClient
+}
+
+func NewCreateOrUpdateFederation(k8sClient client.Client, kClient *keycloakapi.KeycloakClient) *CreateOrUpdateFederation {
+	return &CreateOrUpdateFederation{
+		k8sClient: k8sClient,
+		kClient:   kClient,
+	}
+}
+
+func (h *CreateOrUpdateFederation) Serve(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Creating or updating user federation")
+
+	bindCredential, err := getBindCredential(ctx, h.k8sClient, federation)
+	if err != nil {
+		return err
+	}
+
+	realm, _, err := h.kClient.Realms.GetRealm(ctx, realmName)
+	if err != nil {
+		return fmt.Errorf("unable to get realm: %w", err)
+	}
+
+	if realm.Id == nil || *realm.Id == "" {
+		return fmt.Errorf("realm ID is empty")
+	}
+
+	config := federationConfig(&federation.Spec, bindCredential)
+	repr := keycloakapi.ComponentRepresentation{
+		Name:         &federation.Spec.Name,
+		ProviderId:   ptr.To(providerID(federation)),
+		ProviderType: ptr.To(userStorageProviderType),
+		ParentId:     realm.Id,
+		Config:       &config,
+	}
+
+	configHash, err := drift.Hash(repr)
+	if err != nil {
+		return fmt.Errorf("unable to hash user federation: %w", err)
+	}
+
+	existing, err := FindFederation(ctx, h.kClient, federation, realmName)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		resp, err := h.kClient.RealmComponents.CreateComponent(ctx, realmName, repr)
+		if err != nil {
+			return fmt.Errorf("failed to create user federation: %w", err)
+		}
+
+		federation.Status.ID = keycloakapi.GetResourceIDFromResponse(resp)
+		federation.Status.ConfigHash = configHash
+
+		log.Info("User federation created")
+		events.Normal(ctx, federation, events.ReasonCreated, "User federation %s created", federation.Spec.Name)
+
+		return nil
+	}
+
+	federation.Status.ID = *existing.Id
+	repr.Id = existing.Id
+
+	diffs, err := drift.Diff(repr, existing)
+	if err != nil {
+		return fmt.Errorf("unable to compare user federation: %w", err)
+	}
+
+	// Keycloak doesn't return the bind credential, so its changes are detected by the hash of the applied configuration.
+	if len(diffs) == 0 && federation.Status.ConfigHash == configHash {
+		log.Info("User federation is up to date, skipping update")
+
+		return nil
+	}
+
+	if _, err := h.kClient.RealmComponents.UpdateComponent(ctx, realmName, federation.Status.ID, repr); err != nil {
+		return fmt.Errorf("failed to update user federation: %w", err)
+	}
+
+	federation.Status.ConfigHash = configHash
+
+	log.Info("User federation updated")
+	events.Normal(ctx, federation, events.ReasonUpdated, "User federation %s updated", federation.Spec.Name)
+
+	return nil
+}
+
+// FindFederation returns the user federation component by the name from the spec.
+// Returns nil if the component doesn't exist.
+func FindFederation(
+	ctx context.Context,
+	kClient *keycloakapi.KeycloakClient,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+) (*keycloakapi.ComponentRepresentation, error) {
+	components, _, err := kClient.RealmComponents.GetComponents(ctx, realmName, &keycloakapi.GetComponentsParams{
+		Name: &federation.Spec.Name,
+		Type: ptr.To(userStorageProviderType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user federation components: %w", err)
+	}
+
+	for i := range components {
+		if components[i].Id != nil && components[i].Name != nil && *components[i].Name == federation.Spec.Name {
+			return &components[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,227 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
+)
+
+const (
+	testFederationName = "ldap"
+	testFederationID   = "federation-id"
+	testRealmName      = "test-realm"
+	testRealmID        = "realm-id"
+	testNamespace      = "test-ns"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	return s
+}
+
+func baseFederation() *keycloakApi.KeycloakUserFederation {
+	return &keycloakApi.KeycloakUserFederation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "federation",
+			Namespace: testNamespace,
+		},
+		Spec: keycloakApi.KeycloakUserFederationSpec{
+			Name:       testFederationName,
+			ProviderID: providerLDAP,
+			LDAP: &keycloakApi.LDAPFederationSettings{
+				Connection: keycloakApi.LDAPConnection{
+					URL:      "ldap://ldap:389",
+					BindType: "simple",
+					BindDN:   "cn=admin",
+					BindCredential: &common.SourceRef{
+						SecretKeyRef: &common.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "ldap"},
+							Key:                  "password",
+						},
+					},
+				},
+				UsersDN: "ou=users",
+			},
+		},
+	}
+}
+
+func TestCreateOrUpdateFederation_Serve(t *testing.T) {
+	t.Parallel()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: testNamespace},
+		Data:       map[string][]byte{"password": []byte("bind-secret")},
+	}
+
+	tests := []struct {
+		name       string
+		components func(t *testing.T) *mocks.MockRealmComponentsClient
+		wantErr    require.ErrorAssertionFunc
+		wantID     string
+	}{
+		{
+			name: "create new federation",
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+
+				m.EXPECT().GetComponents(mock.Anything, testRealmName, mock.Anything).Return(nil, nil, nil)
+				m.EXPECT().CreateComponent(mock.Anything, testRealmName, mock.MatchedBy(
+					func(c keycloakapi.ComponentRepresentation) bool {
+						return *c.ParentId == testRealmID &&
+							*c.ProviderType == userStorageProviderType &&
+							(*c.Config)["bindCredential"][0] == "bind-secret"
+					})).
+					Return(&keycloakapi.Response{
+						HTTPResponse: &http.Response{
+							Header: http.Header{
+								"Location": []string{"http://localhost/admin/realms/test-realm/components/" + testFederationID},
+							},
+						},
+					}, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantID:  testFederationID,
+		},
+		{
+			name: "update existing federation",
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+
+				m.EXPECT().GetComponents(mock.Anything, testRealmName, mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{
+						{Id: ptr.To("other-id"), Name: ptr.To("other")},
+						{Id: ptr.To(testFederationID), Name: ptr.To(testFederationName)},
+					}, nil, nil)
+				m.EXPECT().UpdateComponent(mock.Anything, testRealmName, testFederationID, mock.MatchedBy(
+					func(c keycloakapi.ComponentRepresentation) bool {
+						return *c.Id == testFederationID
+					})).
+					Return(nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+			wantID:  testFederationID,
+		},
+		{
+			name: "update failed",
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+
+				m.EXPECT().GetComponents(mock.Anything, testRealmName, mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{
+						{Id: ptr.To(testFederationID), Name: ptr.To(testFederationName)},
+					}, nil, nil)
+				m.EXPECT().UpdateComponent(mock.Anything, testRealmName, testFederationID, mock.Anything).
+					Return(nil, errors.New("update error"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "failed to update user federation")
+			},
+			wantID: testFederationID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			realms := mocks.NewMockRealmClient(t)
+			realms.EXPECT().GetRealm(mock.Anything, testRealmName).
+				Return(&keycloakapi.RealmRepresentation{Id: ptr.To(testRealmID)}, nil, nil)
+
+			kClient := &keycloakapi.KeycloakClient{Realms: realms, RealmComponents: tt.components(t)}
+			k8sClient := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(secret).Build()
+
+			federation := baseFederation()
+
+			err := NewCreateOrUpdateFederation(k8sClient, kClient).Serve(context.Background(), federation, testRealmName)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantID, federation.Status.ID)
+		})
+	}
+}
+
+func TestCreateOrUpdateFederation_Serve_UpToDate(t *testing.T) {
+	t.Parallel()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: testNamespace},
+		Data:       map[string][]byte{"password": []byte("bind-secret")},
+	}
+
+	federation := baseFederation()
+
+	config := federationConfig(&federation.Spec, "bind-secret")
+	desired := keycloakapi.ComponentRepresentation{
+		Name:         ptr.To(testFederationName),
+		ProviderId:   ptr.To(providerLDAP),
+		ProviderType: ptr.To(userStorageProviderType),
+		ParentId:     ptr.To(testRealmID),
+		Config:       &config,
+	}
+
+	configHash, err := drift.Hash(desired)
+	require.NoError(t, err)
+
+	federation.Status.ConfigHash = configHash
+
+	liveConfig := federationConfig(&federation.Spec, drift.SecretMask)
+	liveConfig["lastSync"] = []string{"1700000000"}
+
+	live := desired
+	live.Id = ptr.To(testFederationID)
+	live.Config = &liveConfig
+
+	realms := mocks.NewMockRealmClient(t)
+	realms.EXPECT().GetRealm(mock.Anything, testRealmName).
+		Return(&keycloakapi.RealmRepresentation{Id: ptr.To(testRealmID)}, nil, nil)
+
+	components := mocks.NewMockRealmComponentsClient(t)
+	components.EXPECT().GetComponents(mock.Anything, testRealmName, mock.Anything).
+		Return([]keycloakapi.ComponentRepresentation{live}, nil, nil)
+
+	kClient := &keycloakapi.KeycloakClient{Realms: realms, RealmComponents: components}
+	k8sClient := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(secret).Build()
+
+	err = NewCreateOrUpdateFederation(k8sClient, kClient).Serve(context.Background(), federation, testRealmName)
+	require.NoError(t, err)
+	assert.Equal(t, testFederationID, federation.Status.ID)
+	assert.Equal(t, configHash, federation.Status.ConfigHash)
+}
+
+func TestCreateOrUpdateFederation_Serve_MissingBindCredential(t *testing.T) {
+	t.Parallel()
+
+	kClient := &keycloakapi.KeycloakClient{}
+	k8sClient := fake.NewClientBuilder().WithScheme(newScheme(t)).Build()
+
+	err := NewCreateOrUpdateFederation(k8sClient, kClient).Serve(context.Background(), baseFederation(), testRealmName)
+	require.ErrorContains(t, err, "unable to get bind credential")
+}
@@ -0,0 +1,209 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+// ProcessActions runs user synchronization and LDAP connection test requested with annotations.
+// Results are stored in the status, annotations are removed after the action is done.
+type ProcessActions struct {
+	k8sClient client.Client
+	kClient   *keycloakapi.KeycloakClient
+}
+
+func NewProcessActions(k8sClient client.Client, kClient *keycloakapi.KeycloakClient) *ProcessActions {
+	return &ProcessActions{
+		k8sClient: k8sClient,
+		kClient:   kClient,
+	}
+}
+
+func (h *ProcessActions) Serve(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+) error {
+	annotations := federation.GetAnnotations()
+
+	var processed []string
+
+	if val, ok := annotations[keycloakApi.UserFederationTestConnectionAnnotation]; ok {
+		if val == "true" {
+			if err := h.testConnection(ctx, federation, realmName); err != nil {
+				return err
+			}
+		}
+
+		processed = append(processed, keycloakApi.UserFederationTestConnectionAnnotation)
+	}
+
+	if val, ok := annotations[keycloakApi.UserFederationSyncAnnotation]; ok {
+		if err := h.syncUsers(ctx, federation, realmName, val); err != nil {
+			return err
+		}
+
+		processed = append(processed, keycloakApi.UserFederationSyncAnnotation)
+	}
+
+	if len(processed) == 0 {
+		return nil
+	}
+
+	return h.removeAnnotations(ctx, federation, processed)
+}
+
+func (h *ProcessActions) syncUsers(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName, syncType string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	var action string
+
+	switch syncType {
+	case keycloakApi.UserFederationSyncFull:
+		action = keycloakapi.UserStorageSyncFull
+	case keycloakApi.UserFederationSyncChanged:
+		action = keycloakapi.UserStorageSyncChanged
+	default:
+		log.Info("Unsupported user federation sync type, skipping", "type", syncType)
+
+		return nil
+	}
+
+	log.Info("Synchronizing users", "type", syncType)
+
+	syncResult := &keycloakApi.UserFederationSyncResult{Type: syncType}
+
+	result, _, err := h.kClient.UserStorage.SyncUsers(ctx, realmName, federation.Status.ID, action)
+	if err != nil {
+		if !keycloakapi.IsClientError(err) {
+			return fmt.Errorf("failed to synchronize users: %w", err)
+		}
+
+		syncResult.Message = err.Error()
+	} else {
+		syncResult.Added = result.Added
+		syncResult.Updated = result.Updated
+		syncResult.Removed = result.Removed
+		syncResult.Failed = result.Failed
+		syncResult.Message = result.Status
+	}
+
+	syncResult.Time = metav1.Now()
+	federation.Status.LastSync = syncResult
+
+	log.Info("Users synchronization finished", "result", syncResult.Message)
+
+	return nil
+}
+
+func (h *ProcessActions) testConnection(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Testing LDAP connection")
+
+	testResult := &keycloakApi.UserFederationConnectionTestResult{}
+
+	if federation.Spec.LDAP == nil {
+		testResult.Message = "connection test is supported only for ldap provider"
+	} else {
+		message, err := h.runLDAPTests(ctx, federation, realmName)
+		if err != nil {
+			return err
+		}
+
+		testResult.Success = message == ""
+		testResult.Message = message
+	}
+
+	testResult.Time = metav1.Now()
+	federation.Status.LastConnectionTest = testResult
+
+	log.Info("LDAP connection test finished", "success", testResult.Success)
+
+	return nil
+}
+
+// runLDAPTests tests connection and authentication against the LDAP server.
+// It returns a failure message if one of the tests fails.
+func (h *ProcessActions) runLDAPTests(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+) (string, error) {
+	bindCredential, err := getBindCredential(ctx, h.k8sClient, federation)
+	if err != nil {
+		return "", err
+	}
+
+	conn := federation.Spec.LDAP.Connection
+	test := keycloakapi.TestLdapConnectionRepresentation{
+		ConnectionUrl:    conn.URL,
+		AuthType:         conn.BindType,
+		BindDn:           conn.BindDN,
+		BindCredential:   bindCredential,
+		UseTruststoreSpi: conn.UseTruststoreSPI,
+		ComponentId:      federation.Status.ID,
+		StartTls:         strconv.FormatBool(conn.StartTLS),
+	}
+
+	if conn.ConnectionTimeout != nil {
+		test.ConnectionTimeout = strconv.Itoa(*conn.ConnectionTimeout)
+	}
+
+	actions := []string{keycloakapi.LDAPTestConnection}
+	if conn.BindType != "none" {
+		actions = append(actions, keycloakapi.LDAPTestAuthentication)
+	}
+
+	for _, action := range actions {
+		test.Action = action
+
+		if _, err := h.kClient.UserStorage.TestLDAPConnection(ctx, realmName, test); err != nil {
+			if !keycloakapi.IsClientError(err) {
+				return "", fmt.Errorf("failed to test LDAP connection: %w", err)
+			}
+
+			return fmt.Sprintf("%s failed: %s", action, err.Error()), nil
+		}
+	}
+
+	return "", nil
+}
+
+// removeAnnotations removes processed annotations from the resource.
+// Patch is applied to a copy, so the status changes made by the chain are preserved.
+func (h *ProcessActions) removeAnnotations(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	keys []string,
+) error {
+	patched := federation.DeepCopy()
+
+	for _, key := range keys {
+		delete(patched.Annotations, key)
+	}
+
+	if err := h.k8sClient.Patch(ctx, patched, client.MergeFrom(federation)); err != nil {
+		return fmt.Errorf("failed to remove processed annotations: %w", err)
+	}
+
+	federation.SetAnnotations(patched.GetAnnotations())
+	federation.SetResourceVersion(patched.GetResourceVersion())
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestProcessActions_Serve(t *testing.T) {
+	t.Parallel()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: testNamespace},
+		Data:       map[string][]byte{"password": []byte("bind-secret")},
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		userStorage func(t *testing.T) *mocks.MockUserStorageClient
+		wantErr     require.ErrorAssertionFunc
+		check       func(t *testing.T, federation *keycloakApi.KeycloakUserFederation)
+	}{
+		{
+			name:        "no annotations",
+			annotations: nil,
+			userStorage: func(t *testing.T) *mocks.MockUserStorageClient {
+				return mocks.NewMockUserStorageClient(t)
+			},
+			wantErr: require.NoError,
+			check: func(t *testing.T, federation *keycloakApi.KeycloakUserFederation) {
+				assert.Nil(t, federation.Status.LastSync)
+				assert.Nil(t, federation.Status.LastConnectionTest)
+			},
+		},
+		{
+			name: "full sync and successful connection test",
+			annotations: map[string]string{
+				keycloakApi.UserFederationSyncAnnotation:           keycloakApi.UserFederationSyncFull,
+				keycloakApi.UserFederationTestConnectionAnnotation: "true",
+				"other": "value",
+			},
+			userStorage: func(t *testing.T) *mocks.MockUserStorageClient {
+				m := mocks.NewMockUserStorageClient(t)
+
+				m.EXPECT().SyncUsers(mock.Anything, testRealmName, testFederationID, keycloakapi.UserStorageSyncFull).
+					Return(&keycloakapi.SynchronizationResult{Added: 2, Updated: 1, Failed: 1, Status: "2 imported users"}, nil, nil)
+				m.EXPECT().TestLDAPConnection(mock.Anything, testRealmName, mock.MatchedBy(
+					func(r keycloakapi.TestLdapConnectionRepresentation) bool {
+						return r.BindCredential == "bind-secret" && r.ComponentId == testFederationID
+					})).
+					Return(nil, nil).Times(2)
+
+				return m
+			},
+			wantErr: require.NoError,
+			check: func(t *testing.T, federation *keycloakApi.KeycloakUserFederation) {
+				require.NotNil(t, federation.Status.LastSync)
+				assert.Equal(t, keycloakApi.UserFederationSyncFull, federation.Status.LastSync.Type)
+				assert.Equal(t, int32(2), federation.Status.LastSync.Added)
+				assert.Equal(t, int32(1), federation.Status.LastSync.Updated)
+				assert.Equal(t, int32(1), federation.Status.LastSync.Failed)
+				assert.Equal(t, "2 imported users", federation.Status.LastSync.Message)
+
+				require.NotNil(t, federation.Status.LastConnectionTest)
+				assert.True(t, federation.Status.LastConnectionTest.Success)
+
+				assert.Equal(t, map[string]string{"other": "value"}, federation.GetAnnotations())
+			},
+		},
+		{
+			name: "failed authentication test",
+			annotations: map[string]string{
+				keycloakApi.UserFederationTestConnectionAnnotation: "true",
+			},
+			userStorage: func(t *testing.T) *mocks.MockUserStorageClient {
+				m := mocks.NewMockUserStorageClient(t)
+
+				m.EXPECT().TestLDAPConnection(mock.Anything, testRealmName, mock.MatchedBy(
+					func(r keycloakapi.TestLdapConnectionRepresentation) bool {
+						return r.Action == keycloakapi.LDAPTestConnection
+					})).
+					Return(nil, nil)
+				m.EXPECT().TestLDAPConnection(mock.Anything, testRealmName, mock.MatchedBy(
+					func(r keycloakapi.TestLdapConnectionRepresentation) bool {
+						return r.Action == keycloakapi.LDAPTestAuthentication
+					})).
+					Return(nil, &keycloakapi.ApiError{Code: 400, Message: "invalid credentials"})
+
+				return m
+			},
+			wantErr: require.NoError,
+			check: func(t *testing.T, federation *keycloakApi.KeycloakUserFederation) {
+				require.NotNil(t, federation.Status.LastConnectionTest)
+				assert.False(t, federation.Status.LastConnectionTest.Success)
+				assert.Contains(t, federation.Status.LastConnectionTest.Message, "testAuthentication failed")
+				assert.Empty(t, federation.GetAnnotations())
+			},
+		},
+		{
+			name: "sync server error keeps annotation",
+			annotations: map[string]string{
+				keycloakApi.UserFederationSyncAnnotation: keycloakApi.UserFederationSyncChanged,
+			},
+			userStorage: func(t *testing.T) *mocks.MockUserStorageClient {
+				m := mocks.NewMockUserStorageClient(t)
+
+				m.EXPECT().SyncUsers(mock.Anything, testRealmName, testFederationID, keycloakapi.UserStorageSyncChanged).
+					Return(nil, nil, errors.New("connection refused"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "failed to synchronize users")
+			},
+			check: func(t *testing.T, federation *keycloakApi.KeycloakUserFederation) {
+				assert.Contains(t, federation.GetAnnotations(), keycloakApi.UserFederationSyncAnnotation)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			federation := baseFederation()
+			federation.Annotations = tt.annotations
+			federation.Status.ID = testFederationID
+
+			k8sClient := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(secret, federation).Build()
+			kClient := &keycloakapi.KeycloakClient{UserStorage: tt.userStorage(t)}
+
+			err := NewProcessActions(k8sClient, kClient).Serve(context.Background(), federation, testRealmName)
+			tt.wantErr(t, err)
+			tt.check(t, federation)
+
+			stored := &keycloakApi.KeycloakUserFederation{}
+			require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+				Name:      federation.Name,
+				Namespace: federation.Namespace,
+			}, stored))
+			assert.Equal(t, len(federation.GetAnnotations()), len(stored.GetAnnotations()))
+		})
+	}
+}
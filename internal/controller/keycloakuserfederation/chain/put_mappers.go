@@ -0,0 +1,158 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
+)
+
+// PutMappers creates, updates and removes LDAP mappers of the user federation.
+// Only mappers listed in the spec or created by the operator before are managed,
+// mappers created by Keycloak by default are kept untouched.
+type PutMappers struct {
+	kClient *keycloakapi.KeycloakClient
+}
+
+func NewPutMappers(kClient *keycloakapi.KeycloakClient) *PutMappers {
+	return &PutMappers{kClient: kClient}
+}
+
+func (h *PutMappers) Serve(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if providerID(federation) != providerLDAP {
+		return nil
+	}
+
+	log.Info("Putting user federation mappers")
+
+	existing, _, err := h.kClient.RealmComponents.GetComponents(ctx, realmName, &keycloakapi.GetComponentsParams{
+		Parent: &federation.Status.ID,
+		Type:   ptr.To(ldapMapperProviderType),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get user federation mappers: %w", err)
+	}
+
+	existingByName := make(map[string]keycloakapi.ComponentRepresentation, len(existing))
+
+	for _, m := range existing {
+		if m.Name != nil && m.Id != nil {
+			existingByName[*m.Name] = m
+		}
+	}
+
+	managed := make([]string, 0, len(federation.Spec.Mappers))
+
+	for i := range federation.Spec.Mappers {
+		mapper := &federation.Spec.Mappers[i]
+
+		if err := h.putMapper(ctx, federation, realmName, mapper, existingByName); err != nil {
+			return err
+		}
+
+		managed = append(managed, mapper.Name)
+	}
+
+	for _, name := range federation.Status.Mappers {
+		if slices.Contains(managed, name) {
+			continue
+		}
+
+		current, ok := existingByName[name]
+		if !ok {
+			continue
+		}
+
+		if _, err := h.kClient.RealmComponents.DeleteComponent(ctx, realmName, *current.Id); err != nil &&
+			!keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("failed to delete user federation mapper %s: %w", name, err)
+		}
+
+		log.Info("User federation mapper deleted", "mapper", name)
+	}
+
+	if len(managed) == 0 {
+		managed = nil
+	}
+
+	federation.Status.Mappers = managed
+
+	return nil
+}
+
+func (h *PutMappers) putMapper(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+	mapper *keycloakApi.UserFederationMapper,
+	existingByName map[string]keycloakapi.ComponentRepresentation,
+) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("mapper", mapper.Name)
+
+	config := mapperConfig(mapper)
+	repr := keycloakapi.ComponentRepresentation{
+		Name:         &mapper.Name,
+		ProviderId:   ptr.To(mapperProviderID(mapper)),
+		ProviderType: ptr.To(ldapMapperProviderType),
+		ParentId:     &federation.Status.ID,
+		Config:       &config,
+	}
+
+	current, ok := existingByName[mapper.Name]
+
+	// Keycloak doesn't allow changing the mapper type, so the mapper is recreated.
+	if ok && current.ProviderId != nil && *current.ProviderId != *repr.ProviderId {
+		if _, err := h.kClient.RealmComponents.DeleteComponent(ctx, realmName, *current.Id); err != nil &&
+			!keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("failed to delete user federation mapper %s: %w", mapper.Name, err)
+		}
+
+		ok = false
+	}
+
+	if !ok {
+		if _, err := h.kClient.RealmComponents.CreateComponent(ctx, realmName, repr); err != nil {
+			return fmt.Errorf("failed to create user federation mapper %s: %w", mapper.Name, err)
+		}
+
+		log.Info("User federation mapper created")
+		events.Normal(ctx, federation, events.ReasonCreated, "User federation mapper %s created", mapper.Name)
+
+		return nil
+	}
+
+	repr.Id = current.Id
+
+	diffs, err := drift.Diff(repr, current)
+	if err != nil {
+		return fmt.Errorf("unable to compare user federation mapper %s: %w", mapper.Name, err)
+	}
+
+	if len(diffs) == 0 {
+		log.Info("User federation mapper is up to date, skipping update")
+
+		return nil
+	}
+
+	if _, err := h.kClient.RealmComponents.UpdateComponent(ctx, realmName, *current.Id, repr); err != nil {
+		return fmt.Errorf("failed to update user federation mapper %s: %w", mapper.Name, err)
+	}
+
+	log.Info("User federation mapper updated")
+	events.Normal(ctx, federation, events.ReasonUpdated, "User federation mapper %s updated", mapper.Name)
+
+	return nil
+}
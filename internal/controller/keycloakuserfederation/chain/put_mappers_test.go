@@ -0,0 +1,132 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestPutMappers_Serve(t *testing.T) {
+	t.Parallel()
+
+	federation := baseFederation()
+	federation.Status.ID = testFederationID
+	federation.Status.Mappers = []string{"email", "stale"}
+	federation.Spec.Mappers = []keycloakApi.UserFederationMapper{
+		{
+			Name: "email",
+			UserAttribute: &keycloakApi.UserAttributeLDAPMapper{
+				UserModelAttribute: "email",
+				LDAPAttribute:      "mail",
+			},
+		},
+		{
+			Name:          "default role",
+			HardcodedRole: &keycloakApi.HardcodedRoleLDAPMapper{Role: "offline_access"},
+		},
+		{
+			Name:     "username",
+			FullName: &keycloakApi.FullNameLDAPMapper{LDAPFullNameAttribute: "cn"},
+		},
+	}
+
+	components := mocks.NewMockRealmComponentsClient(t)
+
+	components.EXPECT().
+		GetComponents(mock.Anything, testRealmName, &keycloakapi.GetComponentsParams{
+			Parent: ptr.To(testFederationID),
+			Type:   ptr.To(ldapMapperProviderType),
+		}).
+		Return([]keycloakapi.ComponentRepresentation{
+			{Id: ptr.To("email-id"), Name: ptr.To("email"), ProviderId: ptr.To("user-attribute-ldap-mapper")},
+			{Id: ptr.To("username-id"), Name: ptr.To("username"), ProviderId: ptr.To("user-attribute-ldap-mapper")},
+			{Id: ptr.To("stale-id"), Name: ptr.To("stale"), ProviderId: ptr.To("user-attribute-ldap-mapper")},
+			{Id: ptr.To("default-id"), Name: ptr.To("first name"), ProviderId: ptr.To("user-attribute-ldap-mapper")},
+		}, nil, nil)
+
+	// Existing mapper of the same type is updated.
+	components.EXPECT().
+		UpdateComponent(mock.Anything, testRealmName, "email-id", mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+			return *c.Id == "email-id" && *c.ParentId == testFederationID && (*c.Config)["ldap.attribute"][0] == "mail"
+		})).
+		Return(nil, nil)
+
+	// New mapper is created.
+	components.EXPECT().
+		CreateComponent(mock.Anything, testRealmName, mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+			return *c.Name == "default role" && *c.ProviderId == "hardcoded-ldap-role-mapper"
+		})).
+		Return(nil, nil)
+
+	// Mapper with changed type is recreated.
+	components.EXPECT().DeleteComponent(mock.Anything, testRealmName, "username-id").Return(nil, nil)
+	components.EXPECT().
+		CreateComponent(mock.Anything, testRealmName, mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+			return *c.Name == "username" && *c.ProviderId == "full-name-ldap-mapper"
+		})).
+		Return(nil, nil)
+
+	// Mapper removed from the spec is deleted, default mappers are kept.
+	components.EXPECT().DeleteComponent(mock.Anything, testRealmName, "stale-id").Return(nil, nil)
+
+	err := NewPutMappers(&keycloakapi.KeycloakClient{RealmComponents: components}).
+		Serve(context.Background(), federation, testRealmName)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"email", "default role", "username"}, federation.Status.Mappers)
+}
+
+func TestPutMappers_Serve_UpToDate(t *testing.T) {
+	t.Parallel()
+
+	federation := baseFederation()
+	federation.Status.ID = testFederationID
+	federation.Spec.Mappers = []keycloakApi.UserFederationMapper{
+		{
+			Name: "email",
+			UserAttribute: &keycloakApi.UserAttributeLDAPMapper{
+				UserModelAttribute: "email",
+				LDAPAttribute:      "mail",
+			},
+		},
+	}
+
+	config := mapperConfig(&federation.Spec.Mappers[0])
+
+	components := mocks.NewMockRealmComponentsClient(t)
+
+	components.EXPECT().
+		GetComponents(mock.Anything, testRealmName, mock.Anything).
+		Return([]keycloakapi.ComponentRepresentation{
+			{
+				Id:           ptr.To("email-id"),
+				Name:         ptr.To("email"),
+				ProviderId:   ptr.To("user-attribute-ldap-mapper"),
+				ProviderType: ptr.To(ldapMapperProviderType),
+				ParentId:     ptr.To(testFederationID),
+				Config:       &config,
+			},
+		}, nil, nil)
+
+	err := NewPutMappers(&keycloakapi.KeycloakClient{RealmComponents: components}).
+		Serve(context.Background(), federation, testRealmName)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"email"}, federation.Status.Mappers)
+}
+
+func TestPutMappers_Serve_Kerberos(t *testing.T) {
+	t.Parallel()
+
+	federation := baseFederation()
+	federation.Spec.ProviderID = providerKerberos
+
+	err := NewPutMappers(&keycloakapi.KeycloakClient{}).Serve(context.Background(), federation, testRealmName)
+	require.NoError(t, err)
+}
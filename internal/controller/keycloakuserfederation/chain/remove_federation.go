@@ -0,0 +1,72 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
+)
+
+// RemoveFederation deletes a user federation provider from Keycloak.
+// Mappers of the provider are deleted by Keycloak together with the provider.
+type RemoveFederation struct {
+	kClient               *keycloakapi.KeycloakClient
+	defaultDeletionPolicy common.DeletionPolicy
+}
+
+func NewRemoveFederation(kClient *keycloakapi.KeycloakClient, defaultDeletionPolicy common.DeletionPolicy) *RemoveFederation {
+	return &RemoveFederation{kClient: kClient, defaultDeletionPolicy: defaultDeletionPolicy}
+}
+
+func (h *RemoveFederation) Serve(
+	ctx context.Context,
+	federation *keycloakApi.KeycloakUserFederation,
+	realmName string,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Start removing user federation")
+
+	if objectmeta.PreserveResourcesOnDeletion(federation, h.defaultDeletionPolicy) {
+		log.Info("Preserve resources on deletion, skipping")
+
+		return nil
+	}
+
+	federationID := federation.Status.ID
+
+	if federationID == "" {
+		existing, err := FindFederation(ctx, h.kClient, federation, realmName)
+		if err != nil {
+			return err
+		}
+
+		if existing == nil {
+			log.Info("User federation not found, skipping deletion")
+
+			return nil
+		}
+
+		federationID = *existing.Id
+	}
+
+	if _, err := h.kClient.RealmComponents.DeleteComponent(ctx, realmName, federationID); err != nil {
+		if keycloakapi.IsNotFound(err) {
+			log.Info("User federation not found, skipping deletion")
+
+			return nil
+		}
+
+		return fmt.Errorf("failed to delete user federation: %w", err)
+	}
+
+	log.Info("User federation deleted successfully")
+	events.Normal(ctx, federation, events.ReasonDeleted, "User federation %s deleted", federation.Spec.Name)
+
+	return nil
+}
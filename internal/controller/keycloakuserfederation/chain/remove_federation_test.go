@@ -0,0 +1,113 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/objectmeta"
+)
+
+func TestRemoveFederation_Serve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		statusID    string
+		annotations map[string]string
+		components  func(t *testing.T) *mocks.MockRealmComponentsClient
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:     "delete by status ID",
+			statusID: testFederationID,
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+				m.EXPECT().DeleteComponent(mock.Anything, testRealmName, testFederationID).Return(nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "delete by name",
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+				m.EXPECT().GetComponents(mock.Anything, testRealmName, mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{
+						{Id: ptr.To(testFederationID), Name: ptr.To(testFederationName)},
+					}, nil, nil)
+				m.EXPECT().DeleteComponent(mock.Anything, testRealmName, testFederationID).Return(nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "federation not found",
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+				m.EXPECT().GetComponents(mock.Anything, testRealmName, mock.Anything).Return(nil, nil, nil)
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:     "already deleted in keycloak",
+			statusID: testFederationID,
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+				m.EXPECT().DeleteComponent(mock.Anything, testRealmName, testFederationID).
+					Return(nil, &keycloakapi.ApiError{Code: 404})
+
+				return m
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:        "preserve resources on deletion",
+			statusID:    testFederationID,
+			annotations: map[string]string{objectmeta.PreserveResourcesOnDeletionAnnotation: "true"},
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				return mocks.NewMockRealmComponentsClient(t)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:     "delete failed",
+			statusID: testFederationID,
+			components: func(t *testing.T) *mocks.MockRealmComponentsClient {
+				m := mocks.NewMockRealmComponentsClient(t)
+				m.EXPECT().DeleteComponent(mock.Anything, testRealmName, testFederationID).
+					Return(nil, errors.New("delete error"))
+
+				return m
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "failed to delete user federation")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			federation := baseFederation()
+			federation.Status.ID = tt.statusID
+			federation.Annotations = tt.annotations
+
+			err := NewRemoveFederation(&keycloakapi.KeycloakClient{RealmComponents: tt.components(t)}, common.DeletionPolicyDelete).
+				Serve(context.Background(), federation, testRealmName)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package keycloakuserfederation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/adminevents"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/internal/controller/helper"
+	"github.com/epam/edp-keycloak-operator/internal/controller/keycloakuserfederation/chain"
+	"github.com/epam/edp-keycloak-operator/internal/controller/pause"
+	"github.com/epam/edp-keycloak-operator/internal/controller/refwatch"
+	"github.com/epam/edp-keycloak-operator/internal/controller/status"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+const successRequeueTime = time.Minute * 10
+
+type UserFederationHelper interface {
+	SetRealmOwnerRef(ctx context.Context, object helper.ObjectWithRealmRef) error
+	GetRealmNameFromRef(ctx context.Context, object helper.ObjectWithRealmRef) (string, error)
+	CreateKeycloakClientFromRealmRef(ctx context.Context, object helper.ObjectWithRealmRef) (*keycloakapi.KeycloakClient, error)
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+	DefaultDeletionPolicy() common.DeletionPolicy
+}
+
+type UserFederationReconciler struct {
+	client      client.Client
+	helper      UserFederationHelper
+	adminEvents *adminevents.Watcher
+}
+
+func NewUserFederationReconciler(k8sClient client.Client, controllerHelper UserFederationHelper) *UserFederationReconciler {
+	return &UserFederationReconciler{
+		client: k8sClient,
+		helper: controllerHelper,
+	}
+}
+
+// WithAdminEvents sets the watcher that enqueues objects changed outside of the operator.
+func (r *UserFederationReconciler) WithAdminEvents(watcher *adminevents.Watcher) *UserFederationReconciler {
+	r.adminEvents = watcher
+
+	return r
+}
+
+func (r *UserFederationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&keycloakApi.KeycloakUserFederation{})
+
+	if err := refwatch.Setup(
+		context.Background(),
+		mgr,
+		b,
+		&keycloakApi.KeycloakUserFederation{},
+		func() client.ObjectList { return &keycloakApi.KeycloakUserFederationList{} },
+		userFederationSecretRefs,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakUserFederation secret watches: %w", err)
+	}
+
+	if err := adminevents.Setup(
+		context.Background(),
+		mgr,
+		b,
+		r.adminEvents,
+		&keycloakApi.KeycloakUserFederation{},
+		func() client.ObjectList { return &keycloakApi.KeycloakUserFederationList{} },
+		userFederationAdminEventKeys,
+	); err != nil {
+		return fmt.Errorf("failed to setup KeycloakUserFederation admin events watch: %w", err)
+	}
+
+	if err := b.Complete(events.NewReconciler(mgr, status.NewMetricsReconciler(mgr.GetClient(), &keycloakApi.KeycloakUserFederation{}, pause.NewReconciler(mgr.GetClient(), &keycloakApi.KeycloakUserFederation{}, r, pause.WithObserveModeSkip(r.helper, common.FinalizerName))))); err != nil {
+		return fmt.Errorf("failed to setup KeycloakUserFederation controller: %w", err)
+	}
+
+	return nil
+}
+
+// userFederationSecretRefs returns Secrets and ConfigMaps with the LDAP bind credential.
+func userFederationSecretRefs(obj client.Object) refwatch.Refs {
+	var refs refwatch.Refs
+
+	if federation, ok := obj.(*keycloakApi.KeycloakUserFederation); ok && federation.Spec.LDAP != nil {
+		refs.AddSourceRef(federation.Namespace, federation.Spec.LDAP.Connection.BindCredential)
+	}
+
+	return refs
+}
+
+// userFederationAdminEventKeys returns the admin event resource key of the user federation component.
+func userFederationAdminEventKeys(obj client.Object) []string {
+	if federation, ok := obj.(*keycloakApi.KeycloakUserFederation); ok {
+		return []string{adminevents.Key(adminevents.ResourceComponents, federation.Status.ID)}
+	}
+
+	return nil
+}
+
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakuserfederations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakuserfederations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=v1.edp.epam.com,namespace=placeholder,resources=keycloakuserfederations/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=placeholder,resources=secrets;configmaps,verbs=get;list;watch
+
+// Reconcile is a loop for reconciling KeycloakUserFederation object.
+func (r *UserFederationReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Reconciling KeycloakUserFederation")
+
+	instance, kClient, realmName, err := r.initializeReconciliation(ctx, request)
+	if err != nil {
+		if errors.Is(err, helper.ErrKeycloakIsNotAvailable) {
+			return ctrl.Result{RequeueAfter: helper.RequeueOnKeycloakNotAvailablePeriod}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	if instance == nil {
+		return reconcile.Result{}, nil
+	}
+
+	if instance.GetDeletionTimestamp() != nil {
+		return r.handleDeletion(ctx, instance, kClient, realmName)
+	}
+
+	return r.handleReconciliation(ctx, instance, kClient, realmName)
+}
+
+func (r *UserFederationReconciler) initializeReconciliation(
+	ctx context.Context,
+	request reconcile.Request,
+) (*keycloakApi.KeycloakUserFederation, *keycloakapi.KeycloakClient, string, error) {
+	instance := &keycloakApi.KeycloakUserFederation{}
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return nil, nil, "", nil
+		}
+
+		return nil, nil, "", fmt.Errorf("failed to get KeycloakUserFederation: %w", err)
+	}
+
+	if err := r.helper.SetRealmOwnerRef(ctx, instance); err != nil {
+		return nil, nil, "", fmt.Errorf("unable to set realm owner ref: %w", err)
+	}
+
+	kClient, err := r.helper.CreateKeycloakClientFromRealmRef(ctx, instance)
+	if err != nil {
+		if errors.Is(err, helper.ErrKeycloakRealmNotFound) && instance.GetDeletionTimestamp() != nil {
+			stop, removeErr := helper.RemoveFinalizersOnRealmNotFound(ctx, r.client, instance, common.FinalizerName)
+			if removeErr != nil {
+				return nil, nil, "", removeErr
+			}
+
+			if stop {
+				return nil, nil, "", nil
+			}
+		}
+
+		return nil, nil, "", fmt.Errorf("failed to create Keycloak client: %w", err)
+	}
+
+	realmName, err := r.helper.GetRealmNameFromRef(ctx, instance)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("unable to get realm name from ref: %w", err)
+	}
+
+	return instance, kClient, realmName, nil
+}
+
+func (r *UserFederationReconciler) handleDeletion(
+	ctx context.Context,
+	instance *keycloakApi.KeycloakUserFederation,
+	kClient *keycloakapi.KeycloakClient,
+	realmName string,
+) (reconcile.Result, error) {
+	if controllerutil.ContainsFinalizer(instance, common.FinalizerName) {
+		if err := chain.NewRemoveFederation(kClient, r.helper.DefaultDeletionPolicy()).Serve(ctx, instance, realmName); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to remove user federation: %w", err)
+		}
+
+		controllerutil.RemoveFinalizer(instance, common.FinalizerName)
+
+		if err := r.client.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update KeycloakUserFederation after finalizer removal: %w", err)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *UserFederationReconciler) handleReconciliation(
+	ctx context.Context,
+	instance *keycloakApi.KeycloakUserFederation,
+	kClient *keycloakapi.KeycloakClient,
+	realmName string,
+) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if controllerutil.AddFinalizer(instance, common.FinalizerName) {
+		if err := r.client.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer to KeycloakUserFederation: %w", err)
+		}
+	}
+
+	oldStatus := instance.Status.DeepCopy()
+
+	if err := chain.MakeChain(r.client, kClient).Serve(ctx, instance, realmName); err != nil {
+		events.Error(ctx, instance, err)
+		status.SetFailed(instance, err)
+
+		log.Error(err, "An error has occurred while handling KeycloakUserFederation")
+
+		resultErr := fmt.Errorf("user federation chain processing failed: %w", err)
+		instance.Status.Value = resultErr.Error()
+
+		if statusErr := r.updateStatus(ctx, instance, oldStatus); statusErr != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to update KeycloakUserFederation status: %w", statusErr)
+		}
+
+		return reconcile.Result{}, resultErr
+	}
+
+	instance.Status.Value = common.StatusOK
+	status.SetReady(instance, "Reconciliation succeeded")
+
+	if err := r.updateStatus(ctx, instance, oldStatus); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: successRequeueTime}, nil
+}
+
+func (r *UserFederationReconciler) updateStatus(
+	ctx context.Context,
+	instance *keycloakApi.KeycloakUserFederation,
+	oldStatus *keycloakApi.KeycloakUserFederationStatus,
+) error {
+	if equality.Semantic.DeepEqual(&instance.Status, oldStatus) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		return fmt.Errorf("failed to update KeycloakUserFederation status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package keycloakuserfederation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	helpermock "github.com/epam/edp-keycloak-operator/internal/controller/helper/mocks"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	keycloakapimocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func newTestFederation() *keycloakApi.KeycloakUserFederation {
+	return &keycloakApi.KeycloakUserFederation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "federation",
+			Namespace: "default",
+		},
+		Spec: keycloakApi.KeycloakUserFederationSpec{
+			Name:       "ldap",
+			ProviderID: "ldap",
+			RealmRef: common.RealmRef{
+				Kind: keycloakApi.KeycloakRealmKind,
+				Name: "realm",
+			},
+			LDAP: &keycloakApi.LDAPFederationSettings{
+				Connection: keycloakApi.LDAPConnection{URL: "ldap://ldap:389"},
+				UsersDN:    "ou=users",
+			},
+			Mappers: []keycloakApi.UserFederationMapper{
+				{
+					Name: "email",
+					UserAttribute: &keycloakApi.UserAttributeLDAPMapper{
+						UserModelAttribute: "email",
+						LDAPAttribute:      "mail",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUserFederationReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	tests := []struct {
+		name       string
+		federation func() *keycloakApi.KeycloakUserFederation
+		helper     func(t *testing.T) *helpermock.MockControllerHelper
+		wantResult reconcile.Result
+		wantErr    require.ErrorAssertionFunc
+		check      func(t *testing.T, k8sClient client.Client)
+	}{
+		{
+			name:       "federation and mappers are created",
+			federation: newTestFederation,
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				h := helpermock.NewMockControllerHelper(t)
+				realms := keycloakapimocks.NewMockRealmClient(t)
+				components := keycloakapimocks.NewMockRealmComponentsClient(t)
+
+				h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil)
+				h.On("CreateKeycloakClientFromRealmRef", mock.Anything, mock.Anything).
+					Return(&keycloakapi.KeycloakClient{Realms: realms, RealmComponents: components}, nil)
+				h.On("GetRealmNameFromRef", mock.Anything, mock.Anything).Return("test-realm", nil)
+
+				realms.EXPECT().GetRealm(mock.Anything, "test-realm").
+					Return(&keycloakapi.RealmRepresentation{Id: ptr.To("realm-id")}, nil, nil)
+				components.EXPECT().GetComponents(mock.Anything, "test-realm", mock.MatchedBy(
+					func(p *keycloakapi.GetComponentsParams) bool { return p.Name != nil })).
+					Return(nil, nil, nil)
+				components.EXPECT().CreateComponent(mock.Anything, "test-realm", mock.MatchedBy(
+					func(c keycloakapi.ComponentRepresentation) bool { return *c.Name == "ldap" })).
+					Return(&keycloakapi.Response{
+						HTTPResponse: &http.Response{
+							Header: http.Header{
+								"Location": []string{"http://localhost/admin/realms/test-realm/components/federation-id"},
+							},
+						},
+					}, nil)
+				components.EXPECT().GetComponents(mock.Anything, "test-realm", mock.MatchedBy(
+					func(p *keycloakapi.GetComponentsParams) bool { return p.Parent != nil })).
+					Return(nil, nil, nil)
+				components.EXPECT().CreateComponent(mock.Anything, "test-realm", mock.MatchedBy(
+					func(c keycloakapi.ComponentRepresentation) bool { return *c.Name == "email" })).
+					Return(nil, nil)
+
+				return h
+			},
+			wantResult: reconcile.Result{RequeueAfter: 10 * time.Minute},
+			wantErr:    require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				federation := &keycloakApi.KeycloakUserFederation{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "federation", Namespace: "default",
+				}, federation))
+
+				assert.Equal(t, common.StatusOK, federation.Status.Value)
+				assert.Equal(t, "federation-id", federation.Status.ID)
+				assert.Equal(t, []string{"email"}, federation.Status.Mappers)
+				assert.Contains(t, federation.Finalizers, common.FinalizerName)
+			},
+		},
+		{
+			name:       "chain failed",
+			federation: newTestFederation,
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				h := helpermock.NewMockControllerHelper(t)
+				realms := keycloakapimocks.NewMockRealmClient(t)
+
+				h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil)
+				h.On("CreateKeycloakClientFromRealmRef", mock.Anything, mock.Anything).
+					Return(&keycloakapi.KeycloakClient{Realms: realms}, nil)
+				h.On("GetRealmNameFromRef", mock.Anything, mock.Anything).Return("test-realm", nil)
+
+				realms.EXPECT().GetRealm(mock.Anything, "test-realm").Return(nil, nil, errors.New("realm error"))
+
+				return h
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "realm error")
+			},
+			check: func(t *testing.T, k8sClient client.Client) {
+				federation := &keycloakApi.KeycloakUserFederation{}
+				require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "federation", Namespace: "default",
+				}, federation))
+
+				assert.Contains(t, federation.Status.Value, "realm error")
+			},
+		},
+		{
+			name: "federation is deleted",
+			federation: func() *keycloakApi.KeycloakUserFederation {
+				f := newTestFederation()
+				f.Finalizers = []string{common.FinalizerName}
+				f.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				f.Status.ID = "federation-id"
+
+				return f
+			},
+			helper: func(t *testing.T) *helpermock.MockControllerHelper {
+				h := helpermock.NewMockControllerHelper(t)
+				components := keycloakapimocks.NewMockRealmComponentsClient(t)
+
+				h.On("SetRealmOwnerRef", mock.Anything, mock.Anything).Return(nil)
+				h.On("CreateKeycloakClientFromRealmRef", mock.Anything, mock.Anything).
+					Return(&keycloakapi.KeycloakClient{RealmComponents: components}, nil)
+				h.On("GetRealmNameFromRef", mock.Anything, mock.Anything).Return("test-realm", nil)
+				h.On("DefaultDeletionPolicy").Return(common.DeletionPolicyDelete)
+
+				components.EXPECT().DeleteComponent(mock.Anything, "test-realm", "federation-id").Return(nil, nil)
+
+				return h
+			},
+			wantErr: require.NoError,
+			check: func(t *testing.T, k8sClient client.Client) {
+				federation := &keycloakApi.KeycloakUserFederation{}
+				err := k8sClient.Get(context.Background(), types.NamespacedName{
+					Name: "federation", Namespace: "default",
+				}, federation)
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.federation()).
+				WithStatusSubresource(&keycloakApi.KeycloakUserFederation{}).
+				Build()
+
+			r := NewUserFederationReconciler(k8sClient, tt.helper(t))
+
+			res, err := r.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: "federation", Namespace: "default"},
+			})
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantResult, res)
+			tt.check(t, k8sClient)
+		})
+	}
+}
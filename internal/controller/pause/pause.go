@@ -0,0 +1,304 @@
+// Package pause implements the pause and resync annotations shared by all controllers.
+// A paused resource is not reconciled until the edp.epam.com/paused annotation is removed.
+// A change of the edp.epam.com/resync annotation triggers immediate reconciliation,
+// the handled value is recorded in the resource status.
+// Resources that don't support drift detection are not reconciled in the observe reconcile mode,
+// their finalizers are removed on deletion without deleting the Keycloak resources.
+package pause
+
+import (
+	"context"
+	"fmt"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+)
+
+const (
+	// ConditionPaused indicates that reconciliation of the resource is paused.
+	ConditionPaused = "Paused"
+
+	// ReasonReconciliationPaused is set when the resource has the paused annotation.
+	ReasonReconciliationPaused = "ReconciliationPaused"
+
+	// ReasonReconciliationResumed is set when the paused annotation is removed from the resource.
+	ReasonReconciliationResumed = "ReconciliationResumed"
+
+	// ReasonObserveMode is set when the resource is not reconciled because the operator runs in the observe mode.
+	ReasonObserveMode = "ObserveMode"
+)
+
+// ReconcileModeGetter returns the reconcile mode of the object.
+// It is implemented by helper.Helper.
+type ReconcileModeGetter interface {
+	GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode
+}
+
+// defaultReconcileMode is used for objects that don't have the reconcile mode in the spec,
+// so the operator-wide reconcile mode applies to them.
+type defaultReconcileMode struct{}
+
+func (defaultReconcileMode) GetReconcileMode() common.ReconcileMode {
+	return ""
+}
+
+// IsPaused returns true if the object has the paused annotation.
+func IsPaused(obj client.Object) bool {
+	return obj.GetAnnotations()[common.PausedAnnotation] == "true"
+}
+
+// ResyncRequested returns the value of the resync annotation if it is not handled yet.
+func ResyncRequested(obj client.Object) (string, bool) {
+	token := obj.GetAnnotations()[common.ResyncAnnotation]
+	if token == "" {
+		return "", false
+	}
+
+	if o, ok := obj.(common.HasResyncStatus); ok && o.GetLastHandledResync() == token {
+		return "", false
+	}
+
+	return token, true
+}
+
+// Reconciler wraps the controller reconciler and honours the pause and resync annotations.
+type Reconciler struct {
+	client     client.Client
+	prototype  client.Object
+	next       reconcile.Reconciler
+	modes      ReconcileModeGetter
+	finalizers []string
+}
+
+// Option is an option of the Reconciler.
+type Option func(*Reconciler)
+
+// WithObserveModeSkip is an option to skip reconciliation of objects in the observe reconcile mode.
+// It is used by controllers that don't support drift detection, so they don't change Keycloak in the observe mode.
+// The given finalizers are removed from the deleted objects in the observe mode, so the deletion is not blocked.
+func WithObserveModeSkip(modes ReconcileModeGetter, finalizers ...string) Option {
+	return func(r *Reconciler) {
+		r.modes = modes
+		r.finalizers = finalizers
+	}
+}
+
+// NewReconciler returns a reconciler that skips reconciliation of paused objects of the prototype type
+// and records handled resync requests before delegating to the next reconciler.
+func NewReconciler(k8sClient client.Client, prototype client.Object, next reconcile.Reconciler, opts ...Option) *Reconciler {
+	r := &Reconciler{
+		client:    k8sClient,
+		prototype: prototype,
+		next:      next,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Reconcile skips reconciliation of paused objects and delegates reconciliation of other objects.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	obj, ok := r.prototype.DeepCopyObject().(client.Object)
+	if !ok {
+		return reconcile.Result{}, fmt.Errorf("unable to copy %T", r.prototype)
+	}
+
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return r.next.Reconcile(ctx, req)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("unable to get object: %w", err)
+	}
+
+	if IsPaused(obj) {
+		log.Info("Reconciliation is paused", "annotation", common.PausedAnnotation)
+
+		if err := r.setPausedCondition(ctx, obj, ReasonReconciliationPaused); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	if r.isObserved(obj) {
+		if !obj.GetDeletionTimestamp().IsZero() {
+			log.Info("Reconcile mode is observe, skipping keycloak resource deletion")
+
+			return reconcile.Result{}, r.removeFinalizers(ctx, obj)
+		}
+
+		log.Info("Reconciliation is skipped in observe mode")
+
+		if err := r.setPausedCondition(ctx, obj, ReasonObserveMode); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.setPausedCondition(ctx, obj, ""); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	token, resync := ResyncRequested(obj)
+	if resync {
+		log.Info("Resync requested", "annotation", common.ResyncAnnotation, "value", token)
+	}
+
+	result, err := r.next.Reconcile(ctx, req)
+	if err != nil || !resync {
+		return result, err
+	}
+
+	if err := r.recordResync(ctx, req, token); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return result, nil
+}
+
+// isObserved checks if the object is in the observe reconcile mode and must not be reconciled.
+func (r *Reconciler) isObserved(obj client.Object) bool {
+	if r.modes == nil {
+		return false
+	}
+
+	o, ok := obj.(common.HasReconcileMode)
+	if !ok {
+		o = defaultReconcileMode{}
+	}
+
+	return r.modes.GetReconcileMode(o) == common.ReconcileModeObserve
+}
+
+// removeFinalizers removes the finalizers of the controller from the object.
+func (r *Reconciler) removeFinalizers(ctx context.Context, obj client.Object) error {
+	removed := false
+	for _, f := range r.finalizers {
+		removed = controllerutil.RemoveFinalizer(obj, f) || removed
+	}
+
+	if !removed {
+		return nil
+	}
+
+	if err := r.client.Update(ctx, obj); err != nil {
+		return fmt.Errorf("unable to remove finalizers: %w", err)
+	}
+
+	return nil
+}
+
+// setPausedCondition sets the Paused condition with the given reason to the object status.
+// An empty reason means that the object is reconciled, in this case the condition is updated only if it was set before.
+func (r *Reconciler) setPausedCondition(ctx context.Context, obj client.Object, reason string) error {
+	o, ok := obj.(common.HasConditions)
+	if !ok {
+		return nil
+	}
+
+	paused := reason != ""
+
+	condition := metav1.Condition{
+		Type:               ConditionPaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            fmt.Sprintf("Reconciliation is paused by the %s annotation", common.PausedAnnotation),
+		ObservedGeneration: obj.GetGeneration(),
+	}
+
+	if reason == ReasonObserveMode {
+		condition.Message = "Reconciliation is skipped because the operator runs in the observe mode " +
+			"and drift detection is not supported for this resource"
+	}
+
+	if !paused {
+		if !meta.IsStatusConditionTrue(*o.GetConditions(), ConditionPaused) {
+			return nil
+		}
+
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonReconciliationResumed
+		condition.Message = "Reconciliation is resumed"
+	}
+
+	if !meta.SetStatusCondition(o.GetConditions(), condition) {
+		return nil
+	}
+
+	if err := r.client.Status().Update(ctx, obj); err != nil {
+		return fmt.Errorf("unable to update paused condition: %w", err)
+	}
+
+	if paused {
+		events.Normal(ctx, obj, events.ReasonPaused, condition.Message)
+	}
+
+	return nil
+}
+
+// recordResync stores the handled value of the resync annotation in the object status.
+func (r *Reconciler) recordResync(ctx context.Context, req reconcile.Request, token string) error {
+	obj, ok := r.prototype.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unable to copy %T", r.prototype)
+	}
+
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	o, ok := obj.(common.HasResyncStatus)
+	if !ok || o.GetLastHandledResync() == token {
+		return nil
+	}
+
+	o.SetLastHandledResync(token)
+
+	if err := r.client.Status().Update(ctx, obj); err != nil {
+		return fmt.Errorf("unable to record handled resync: %w", err)
+	}
+
+	return nil
+}
+
+// AnnotationsChanged returns a predicate that passes updates that change the pause or resync annotations.
+// It should be combined with controller predicates that filter out metadata updates.
+func AnnotationsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			for _, key := range []string{common.PausedAnnotation, common.ResyncAnnotation} {
+				if e.ObjectOld.GetAnnotations()[key] != e.ObjectNew.GetAnnotations()[key] {
+					return true
+				}
+			}
+
+			return false
+		},
+	}
+}
@@ -0,0 +1,253 @@
+package pause
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+// operatorReconcileMode returns the operator-wide reconcile mode for objects without their own mode.
+type operatorReconcileMode common.ReconcileMode
+
+func (m operatorReconcileMode) GetReconcileMode(object common.HasReconcileMode) common.ReconcileMode {
+	if mode := object.GetReconcileMode(); mode != "" {
+		return mode
+	}
+
+	return common.ReconcileMode(m)
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		reconcileMode     common.ReconcileMode
+		annotations       map[string]string
+		conditions        []metav1.Condition
+		lastHandledResync string
+		nextErr           error
+		wantErr           require.ErrorAssertionFunc
+		wantNextCalled    bool
+		wantPaused        metav1.ConditionStatus
+		wantHandledResync string
+	}{
+		{
+			name:           "paused object is not reconciled",
+			annotations:    map[string]string{common.PausedAnnotation: "true"},
+			wantErr:        require.NoError,
+			wantNextCalled: false,
+			wantPaused:     metav1.ConditionTrue,
+		},
+		{
+			name:           "resumed object is reconciled",
+			conditions:     []metav1.Condition{{Type: ConditionPaused, Status: metav1.ConditionTrue, Reason: ReasonReconciliationPaused}},
+			wantErr:        require.NoError,
+			wantNextCalled: true,
+			wantPaused:     metav1.ConditionFalse,
+		},
+		{
+			name:           "object is not reconciled in observe mode",
+			reconcileMode:  common.ReconcileModeObserve,
+			wantErr:        require.NoError,
+			wantNextCalled: false,
+			wantPaused:     metav1.ConditionTrue,
+		},
+		{
+			name:           "object is reconciled after observe mode is disabled",
+			reconcileMode:  common.ReconcileModeApply,
+			conditions:     []metav1.Condition{{Type: ConditionPaused, Status: metav1.ConditionTrue, Reason: ReasonObserveMode}},
+			wantErr:        require.NoError,
+			wantNextCalled: true,
+			wantPaused:     metav1.ConditionFalse,
+		},
+		{
+			name:           "object without annotations is reconciled",
+			wantErr:        require.NoError,
+			wantNextCalled: true,
+		},
+		{
+			name:              "resync is recorded",
+			annotations:       map[string]string{common.ResyncAnnotation: "2"},
+			lastHandledResync: "1",
+			wantErr:           require.NoError,
+			wantNextCalled:    true,
+			wantHandledResync: "2",
+		},
+		{
+			name:              "resync is not recorded if reconciliation failed",
+			annotations:       map[string]string{common.ResyncAnnotation: "2"},
+			lastHandledResync: "1",
+			nextErr:           errors.New("reconcile error"),
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "reconcile error")
+			},
+			wantNextCalled:    true,
+			wantHandledResync: "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+			role := &keycloakApi.KeycloakRealmRole{
+				ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "default", Annotations: tt.annotations},
+				Status: keycloakApi.KeycloakRealmRoleStatus{
+					Conditions:        tt.conditions,
+					LastHandledResync: tt.lastHandledResync,
+				},
+			}
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(role).
+				WithStatusSubresource(role).
+				Build()
+
+			nextCalled := false
+			next := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				nextCalled = true
+
+				return reconcile.Result{}, tt.nextErr
+			})
+
+			r := NewReconciler(
+				k8sClient,
+				&keycloakApi.KeycloakRealmRole{},
+				next,
+				WithObserveModeSkip(operatorReconcileMode(tt.reconcileMode)),
+			)
+
+			_, err := r.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: "default", Name: "role"},
+			})
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantNextCalled, nextCalled)
+
+			got := &keycloakApi.KeycloakRealmRole{}
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(role), got))
+			assert.Equal(t, tt.wantHandledResync, got.Status.LastHandledResync)
+
+			condition := meta.FindStatusCondition(got.Status.Conditions, ConditionPaused)
+			if tt.wantPaused == "" {
+				assert.Nil(t, condition)
+
+				return
+			}
+
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.wantPaused, condition.Status)
+		})
+	}
+}
+
+func TestReconciler_Reconcile_NotFound(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+	nextCalled := false
+	next := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+		nextCalled = true
+
+		return reconcile.Result{}, nil
+	})
+
+	r := NewReconciler(fake.NewClientBuilder().WithScheme(scheme).Build(), &keycloakApi.KeycloakRealmRole{}, next)
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "role"},
+	})
+	require.NoError(t, err)
+	assert.True(t, nextCalled)
+}
+
+func TestReconciler_Reconcile_DeletedInObserveMode(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+	role := &keycloakApi.KeycloakRealmRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "role",
+			Namespace:         "default",
+			DeletionTimestamp: &metav1.Time{Time: metav1.Now().Time},
+			Finalizers:        []string{"role.finalizer", "other.finalizer"},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(role).
+		WithStatusSubresource(role).
+		Build()
+
+	nextCalled := false
+	next := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+		nextCalled = true
+
+		return reconcile.Result{}, nil
+	})
+
+	r := NewReconciler(
+		k8sClient,
+		&keycloakApi.KeycloakRealmRole{},
+		next,
+		WithObserveModeSkip(operatorReconcileMode(common.ReconcileModeObserve), "role.finalizer"),
+	)
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "role"},
+	})
+	require.NoError(t, err)
+	assert.False(t, nextCalled)
+
+	got := &keycloakApi.KeycloakRealmRole{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(role), got))
+	assert.Equal(t, []string{"other.finalizer"}, got.Finalizers)
+	assert.Nil(t, meta.FindStatusCondition(got.Status.Conditions, ConditionPaused))
+}
+
+func TestAnnotationsChanged(t *testing.T) {
+	t.Parallel()
+
+	role := func(annotations map[string]string) *keycloakApi.KeycloakRealmRole {
+		return &keycloakApi.KeycloakRealmRole{ObjectMeta: metav1.ObjectMeta{Name: "role", Annotations: annotations}}
+	}
+
+	p := AnnotationsChanged()
+
+	assert.True(t, p.Update(event.UpdateEvent{
+		ObjectOld: role(nil),
+		ObjectNew: role(map[string]string{common.PausedAnnotation: "true"}),
+	}))
+	assert.True(t, p.Update(event.UpdateEvent{
+		ObjectOld: role(map[string]string{common.ResyncAnnotation: "1"}),
+		ObjectNew: role(map[string]string{common.ResyncAnnotation: "2"}),
+	}))
+	assert.False(t, p.Update(event.UpdateEvent{
+		ObjectOld: role(map[string]string{"another": "1"}),
+		ObjectNew: role(map[string]string{"another": "2"}),
+	}))
+	assert.False(t, p.Create(event.CreateEvent{Object: role(nil)}))
+}
@@ -0,0 +1,224 @@
+// Package refwatch tracks references from custom resources to Secrets and ConfigMaps.
+// Custom resources are indexed by the Secrets and ConfigMaps they reference,
+// so they are reconciled as soon as the referenced data changes instead of on the next periodic resync.
+package refwatch
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	"github.com/epam/edp-keycloak-operator/pkg/secretref"
+)
+
+const (
+	// SecretIndexField is the name of the field index of referenced Secrets.
+	SecretIndexField = "refwatch.secret"
+
+	// ConfigMapIndexField is the name of the field index of referenced ConfigMaps.
+	ConfigMapIndexField = "refwatch.configmap"
+)
+
+// Refs holds Secrets and ConfigMaps referenced by the object.
+type Refs struct {
+	Secrets    []types.NamespacedName
+	ConfigMaps []types.NamespacedName
+}
+
+// RefsFunc returns Secrets and ConfigMaps referenced by the object.
+type RefsFunc func(obj client.Object) Refs
+
+// AddSecret adds the Secret to the references.
+func (r *Refs) AddSecret(namespace, name string) {
+	if name != "" {
+		r.Secrets = append(r.Secrets, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+}
+
+// AddConfigMap adds the ConfigMap to the references.
+func (r *Refs) AddConfigMap(namespace, name string) {
+	if name != "" {
+		r.ConfigMaps = append(r.ConfigMaps, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+}
+
+// AddSourceRef adds the Secret or ConfigMap referenced by the SourceRef.
+func (r *Refs) AddSourceRef(namespace string, ref *common.SourceRef) {
+	if ref == nil {
+		return
+	}
+
+	if ref.SecretKeyRef != nil {
+		r.AddSecret(namespace, ref.SecretKeyRef.Name)
+	}
+
+	if ref.ConfigMapKeyRef != nil {
+		r.AddConfigMap(namespace, ref.ConfigMapKeyRef.Name)
+	}
+}
+
+// AddSourceRefOrVal adds the Secret or ConfigMap referenced by the SourceRefOrVal.
+func (r *Refs) AddSourceRefOrVal(namespace string, ref *common.SourceRefOrVal) {
+	if ref != nil {
+		r.AddSourceRef(namespace, &ref.SourceRef)
+	}
+}
+
+// AddAuth adds Secrets and ConfigMaps referenced by the Keycloak authentication configuration.
+func (r *Refs) AddAuth(namespace string, auth *common.AuthSpec) {
+	if auth == nil {
+		return
+	}
+
+	if auth.PasswordGrant != nil {
+		r.AddSourceRefOrVal(namespace, &auth.PasswordGrant.Username)
+		r.AddSecret(namespace, auth.PasswordGrant.PasswordRef.Name)
+	}
+
+	if auth.ClientCredentials != nil {
+		r.AddSourceRefOrVal(namespace, &auth.ClientCredentials.ClientID)
+		r.AddSecret(namespace, auth.ClientCredentials.ClientSecretRef.Name)
+	}
+}
+
+// AddSMTP adds Secrets and ConfigMaps referenced by the realm email configuration.
+func (r *Refs) AddSMTP(namespace string, smtp *common.SMTP) {
+	if smtp == nil || smtp.Connection.Authentication == nil {
+		return
+	}
+
+	r.AddSourceRefOrVal(namespace, &smtp.Connection.Authentication.Username)
+	r.AddSourceRef(namespace, &smtp.Connection.Authentication.Password)
+}
+
+// AddSecretRefValue adds the Secret referenced by the value in format '$secretName:secretKey'.
+func (r *Refs) AddSecretRefValue(namespace, val string) {
+	if name, _, ok := secretref.ParseSecretRef(val); ok {
+		r.AddSecret(namespace, name)
+	}
+}
+
+// Setup registers field indexes of Secrets and ConfigMaps referenced by the dependent objects
+// and watches of Secrets and ConfigMaps. Dependent objects are enqueued when the referenced data changes.
+func Setup(
+	ctx context.Context,
+	mgr ctrl.Manager,
+	b *builder.Builder,
+	dependent client.Object,
+	newList func() client.ObjectList,
+	refs RefsFunc,
+) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, dependent, SecretIndexField, SecretIndexFunc(refs)); err != nil {
+		return fmt.Errorf("unable to index secret references: %w", err)
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, dependent, ConfigMapIndexField, ConfigMapIndexFunc(refs)); err != nil {
+		return fmt.Errorf("unable to index configmap references: %w", err)
+	}
+
+	b.Watches(
+		&corev1.Secret{},
+		EnqueueDependents(mgr.GetClient(), newList, SecretIndexField),
+		builder.WithPredicates(DataChanged()),
+	).Watches(
+		&corev1.ConfigMap{},
+		EnqueueDependents(mgr.GetClient(), newList, ConfigMapIndexField),
+		builder.WithPredicates(DataChanged()),
+	)
+
+	return nil
+}
+
+// SecretIndexFunc returns a field index function of Secrets referenced by the object.
+func SecretIndexFunc(refs RefsFunc) client.IndexerFunc {
+	return func(obj client.Object) []string {
+		return indexValues(refs(obj).Secrets)
+	}
+}
+
+// ConfigMapIndexFunc returns a field index function of ConfigMaps referenced by the object.
+func ConfigMapIndexFunc(refs RefsFunc) client.IndexerFunc {
+	return func(obj client.Object) []string {
+		return indexValues(refs(obj).ConfigMaps)
+	}
+}
+
+func indexValues(refs []types.NamespacedName) []string {
+	keys := make([]string, 0, len(refs))
+
+	for _, ref := range refs {
+		keys = append(keys, ref.String())
+	}
+
+	slices.Sort(keys)
+
+	return slices.Compact(keys)
+}
+
+// EnqueueDependents returns an event handler that enqueues objects of the list type
+// that reference the changed Secret or ConfigMap by the given index field.
+func EnqueueDependents(k8sClient client.Client, newList func() client.ObjectList, field string) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}.String()
+
+		list := newList()
+		if err := k8sClient.List(ctx, list, client.MatchingFields{field: key}); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Unable to list objects referencing changed object", "object", key)
+
+			return nil
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(items))
+
+		for _, item := range items {
+			if o, ok := item.(client.Object); ok {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()},
+				})
+			}
+		}
+
+		return requests
+	})
+}
+
+// DataChanged returns a predicate that passes creation and deletion events
+// and updates that change data of the Secret or ConfigMap.
+func DataChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			switch oldObj := e.ObjectOld.(type) {
+			case *corev1.Secret:
+				newObj, ok := e.ObjectNew.(*corev1.Secret)
+
+				return !ok || !reflect.DeepEqual(oldObj.Data, newObj.Data)
+			case *corev1.ConfigMap:
+				newObj, ok := e.ObjectNew.(*corev1.ConfigMap)
+
+				return !ok ||
+					!reflect.DeepEqual(oldObj.Data, newObj.Data) ||
+					!reflect.DeepEqual(oldObj.BinaryData, newObj.BinaryData)
+			default:
+				return true
+			}
+		},
+	}
+}
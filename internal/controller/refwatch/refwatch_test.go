@@ -0,0 +1,167 @@
+package refwatch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+func testRefs(obj client.Object) Refs {
+	var refs Refs
+
+	if idp, ok := obj.(*keycloakApi.KeycloakRealmIdentityProvider); ok {
+		for _, v := range idp.Spec.Config {
+			refs.AddSecretRefValue(idp.Namespace, v)
+		}
+	}
+
+	return refs
+}
+
+func newTestIDP(name string, config map[string]string) *keycloakApi.KeycloakRealmIdentityProvider {
+	return &keycloakApi.KeycloakRealmIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: keycloakApi.KeycloakRealmIdentityProviderSpec{
+			Alias:  name,
+			Config: config,
+		},
+	}
+}
+
+func TestRefs(t *testing.T) {
+	t.Parallel()
+
+	var refs Refs
+
+	refs.AddSecret("ns", "")
+	refs.AddAuth("ns", &common.AuthSpec{
+		PasswordGrant: &common.PasswordGrantConfig{
+			Username: common.SourceRefOrVal{
+				SourceRef: common.SourceRef{
+					ConfigMapKeyRef: &common.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "admin-config"},
+						Key:                  "username",
+					},
+				},
+			},
+			PasswordRef: common.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "admin-secret"},
+				Key:                  "password",
+			},
+		},
+	})
+	refs.AddSMTP("ns", &common.SMTP{
+		Connection: common.EmailConnection{
+			Authentication: &common.EmailAuthentication{
+				Username: common.SourceRefOrVal{Value: "user"},
+				Password: common.SourceRef{
+					SecretKeyRef: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "smtp"},
+						Key:                  "password",
+					},
+				},
+			},
+		},
+	})
+	refs.AddSourceRef("ns", nil)
+	refs.AddSecretRefValue("ns", "${vault.secret}")
+	refs.AddSecretRefValue("ns", "$idp-secret:clientSecret")
+
+	assert.Equal(t, []types.NamespacedName{
+		{Namespace: "ns", Name: "admin-secret"},
+		{Namespace: "ns", Name: "smtp"},
+		{Namespace: "ns", Name: "idp-secret"},
+	}, refs.Secrets)
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns", Name: "admin-config"}}, refs.ConfigMaps)
+}
+
+func TestSecretIndexFunc(t *testing.T) {
+	t.Parallel()
+
+	got := SecretIndexFunc(testRefs)(newTestIDP("idp", map[string]string{
+		"clientId":     "$idp-secret:clientId",
+		"clientSecret": "$idp-secret:clientSecret",
+		"authorizeUrl": "https://example.com",
+	}))
+	assert.Equal(t, []string{"default/idp-secret"}, got)
+
+	got = ConfigMapIndexFunc(testRefs)(newTestIDP("idp", map[string]string{"clientSecret": "$idp-secret:clientSecret"}))
+	assert.Empty(t, got)
+}
+
+func TestEnqueueDependents(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newTestIDP("idp-a", map[string]string{"clientSecret": "$idp-secret:clientSecret"}),
+			newTestIDP("idp-b", map[string]string{"clientSecret": "$another-secret:clientSecret"}),
+			newTestIDP("idp-c", map[string]string{"clientSecret": "${vault.idp-secret}"}),
+		).
+		WithIndex(&keycloakApi.KeycloakRealmIdentityProvider{}, SecretIndexField, SecretIndexFunc(testRefs)).
+		Build()
+
+	h := EnqueueDependents(
+		k8sClient,
+		func() client.ObjectList { return &keycloakApi.KeycloakRealmIdentityProviderList{} },
+		SecretIndexField,
+	)
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+
+	defer q.ShutDown()
+
+	h.Update(context.Background(), event.UpdateEvent{
+		ObjectOld: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "idp-secret", Namespace: "default"}},
+		ObjectNew: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "idp-secret", Namespace: "default"}},
+	}, q)
+
+	require.Equal(t, 1, q.Len())
+
+	item, _ := q.Get()
+	assert.Equal(t, types.NamespacedName{Namespace: "default", Name: "idp-a"}, item.NamespacedName)
+}
+
+func TestDataChanged(t *testing.T) {
+	t.Parallel()
+
+	p := DataChanged()
+
+	secret := func(value, label string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Labels: map[string]string{"label": label}},
+			Data:       map[string][]byte{"key": []byte(value)},
+		}
+	}
+
+	configMap := func(value string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config"},
+			Data:       map[string]string{"key": value},
+		}
+	}
+
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: secret("old", "a"), ObjectNew: secret("new", "a")}))
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: secret("old", "a"), ObjectNew: secret("old", "b")}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: configMap("old"), ObjectNew: configMap("new")}))
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: configMap("old"), ObjectNew: configMap("old")}))
+	assert.True(t, p.Create(event.CreateEvent{Object: secret("new", "a")}))
+	assert.True(t, p.Delete(event.DeleteEvent{Object: secret("old", "a")}))
+}
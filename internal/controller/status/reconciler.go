@@ -0,0 +1,73 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
+)
+
+type hasFailureCount interface {
+	GetFailureCount() int64
+}
+
+// MetricsReconciler wraps the controller reconciler and reports the resource status as Prometheus metrics.
+type MetricsReconciler struct {
+	client    client.Client
+	prototype client.Object
+	kind      string
+	next      reconcile.Reconciler
+}
+
+// NewMetricsReconciler returns a reconciler that reports readiness, failure count and
+// last successful sync time of objects of the prototype type after the next reconciler completes.
+func NewMetricsReconciler(k8sClient client.Client, prototype client.Object, next reconcile.Reconciler) *MetricsReconciler {
+	return &MetricsReconciler{
+		client:    k8sClient,
+		prototype: prototype,
+		kind:      metrics.KindOf(prototype),
+		next:      next,
+	}
+}
+
+// Reconcile delegates reconciliation and records metrics from the reconciled object status.
+func (r *MetricsReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.next.Reconcile(ctx, req)
+
+	obj, ok := r.prototype.DeepCopyObject().(client.Object)
+	if !ok {
+		return res, fmt.Errorf("unable to copy %T", r.prototype)
+	}
+
+	if getErr := r.client.Get(ctx, req.NamespacedName, obj); getErr != nil {
+		if k8sErrors.IsNotFound(getErr) {
+			metrics.DeleteResource(r.kind, req.Namespace, req.Name)
+		}
+
+		return res, err
+	}
+
+	ready := false
+	if o, ok := obj.(common.HasConditions); ok {
+		ready = meta.IsStatusConditionTrue(*o.GetConditions(), ConditionReady)
+	}
+
+	metrics.SetResourceReady(r.kind, req.Namespace, req.Name, ready)
+
+	if o, ok := obj.(hasFailureCount); ok {
+		metrics.SetResourceFailureCount(r.kind, req.Namespace, req.Name, o.GetFailureCount())
+	}
+
+	if ready && err == nil {
+		metrics.SetResourceLastSuccessfulSync(r.kind, req.Namespace, req.Name, time.Now())
+	}
+
+	return res, err
+}
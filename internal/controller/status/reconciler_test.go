@@ -0,0 +1,107 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/metrics"
+)
+
+func TestMetricsReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		ready        metav1.ConditionStatus
+		failureCount int64
+		nextErr      error
+		wantReady    float64
+		wantSynced   bool
+	}{
+		{
+			name:       "ready-role",
+			ready:      metav1.ConditionTrue,
+			wantReady:  1,
+			wantSynced: true,
+		},
+		{
+			name:         "failed-role",
+			ready:        metav1.ConditionFalse,
+			failureCount: 3,
+			nextErr:      errors.New("unable to create role"),
+			wantReady:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+			role := &keycloakApi.KeycloakRealmRole{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.name, Namespace: "default"},
+				Status: keycloakApi.KeycloakRealmRoleStatus{
+					FailureCount: tt.failureCount,
+					Conditions: []metav1.Condition{
+						{Type: ConditionReady, Status: tt.ready, Reason: ReasonReconciliationSucceeded},
+					},
+				},
+			}
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(role).Build()
+
+			next := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, tt.nextErr
+			})
+
+			_, err := NewMetricsReconciler(k8sClient, &keycloakApi.KeycloakRealmRole{}, next).
+				Reconcile(context.Background(), reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: "default", Name: tt.name},
+				})
+			assert.Equal(t, tt.nextErr, err)
+
+			assert.Equal(t, tt.wantReady, testutil.ToFloat64(
+				metrics.ResourceReady.WithLabelValues(keycloakApi.KeycloakRealmRoleKind, "default", tt.name)))
+			assert.Equal(t, float64(tt.failureCount), testutil.ToFloat64(
+				metrics.ResourceFailureCount.WithLabelValues(keycloakApi.KeycloakRealmRoleKind, "default", tt.name)))
+
+			lastSync := testutil.ToFloat64(
+				metrics.ResourceLastSuccessfulSync.WithLabelValues(keycloakApi.KeycloakRealmRoleKind, "default", tt.name))
+			assert.Equal(t, tt.wantSynced, lastSync > 0)
+		})
+	}
+}
+
+func TestMetricsReconciler_Reconcile_NotFound(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, keycloakApi.AddToScheme(scheme))
+
+	metrics.SetResourceReady(keycloakApi.KeycloakRealmRoleKind, "default", "deleted-role", true)
+
+	next := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+		return reconcile.Result{}, nil
+	})
+
+	_, err := NewMetricsReconciler(fake.NewClientBuilder().WithScheme(scheme).Build(), &keycloakApi.KeycloakRealmRole{}, next).
+		Reconcile(context.Background(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: "default", Name: "deleted-role"},
+		})
+	require.NoError(t, err)
+
+	assert.False(t, metrics.ResourceReady.DeleteLabelValues(keycloakApi.KeycloakRealmRoleKind, "default", "deleted-role"))
+}
@@ -0,0 +1,82 @@
+// Package status sets the Ready condition, chain step conditions and observed generation
+// shared by all custom resources managed by the operator.
+package status
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+)
+
+const (
+	// ConditionReady indicates the overall readiness of the resource.
+	// It summarizes all chain step conditions.
+	ConditionReady = "Ready"
+
+	// ReasonReconciliationSucceeded is set when the resource is reconciled.
+	ReasonReconciliationSucceeded = events.ReasonReconciliationSucceeded
+
+	// ReasonStepSucceeded is set when the chain step is completed.
+	ReasonStepSucceeded = "Synced"
+)
+
+// Object is a custom resource with status conditions and observed generation.
+type Object interface {
+	client.Object
+	common.HasConditions
+	common.HasObservedGeneration
+}
+
+// SetReady sets the Ready condition to True and updates the observed generation.
+func SetReady(obj Object, message string) {
+	setReady(obj, metav1.ConditionTrue, ReasonReconciliationSucceeded, message)
+}
+
+// SetNotReady sets the Ready condition to False with the given reason and updates the observed generation.
+func SetNotReady(obj Object, reason, message string) {
+	setReady(obj, metav1.ConditionFalse, reason, message)
+}
+
+// SetFailed sets the Ready condition to False with the reason derived from the reconciliation error.
+func SetFailed(obj Object, err error) {
+	SetNotReady(obj, events.ReasonForError(err), err.Error())
+}
+
+// SetStep sets the chain step condition to True if the step succeeded, or to False with the step error.
+// Empty condition type is ignored, so chains can skip handlers without a dedicated condition.
+func SetStep(obj Object, conditionType string, err error) {
+	if conditionType == "" {
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonStepSucceeded,
+		Message:            "Step completed successfully",
+		ObservedGeneration: obj.GetGeneration(),
+	}
+
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = events.ReasonForError(err)
+		condition.Message = err.Error()
+	}
+
+	meta.SetStatusCondition(obj.GetConditions(), condition)
+}
+
+func setReady(obj Object, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(obj.GetConditions(), metav1.Condition{
+		Type:               ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: obj.GetGeneration(),
+	})
+
+	obj.SetObservedGeneration(obj.GetGeneration())
+}
@@ -0,0 +1,115 @@
+package status
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/internal/controller/events"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+func newRole() *keycloakApi.KeycloakRealmRole {
+	return &keycloakApi.KeycloakRealmRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "default", Generation: 3},
+	}
+}
+
+func TestSetReady(t *testing.T) {
+	t.Parallel()
+
+	role := newRole()
+
+	SetReady(role, "Reconciliation succeeded")
+
+	condition := meta.FindStatusCondition(role.Status.Conditions, ConditionReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonReconciliationSucceeded, condition.Reason)
+	assert.Equal(t, int64(3), condition.ObservedGeneration)
+	assert.Equal(t, int64(3), role.Status.ObservedGeneration)
+}
+
+func TestSetFailed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantReason string
+	}{
+		{
+			name:       "keycloak api error",
+			err:        fmt.Errorf("unable to create role: %w", &keycloakapi.ApiError{Code: http.StatusInternalServerError}),
+			wantReason: events.ReasonKeycloakAPIError,
+		},
+		{
+			name:       "other error",
+			err:        errors.New("unable to get realm"),
+			wantReason: events.ReasonReconciliationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			role := newRole()
+			SetReady(role, "Reconciliation succeeded")
+
+			SetFailed(role, tt.err)
+
+			condition := meta.FindStatusCondition(role.Status.Conditions, ConditionReady)
+			require.NotNil(t, condition)
+			assert.Equal(t, metav1.ConditionFalse, condition.Status)
+			assert.Equal(t, tt.wantReason, condition.Reason)
+			assert.Equal(t, tt.err.Error(), condition.Message)
+			assert.Equal(t, int64(3), role.Status.ObservedGeneration)
+		})
+	}
+}
+
+func TestSetNotReady(t *testing.T) {
+	t.Parallel()
+
+	role := newRole()
+
+	SetNotReady(role, "DependencyNotReady", "Waiting for KeycloakRealmRole composite")
+
+	condition := meta.FindStatusCondition(role.Status.Conditions, ConditionReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, "DependencyNotReady", condition.Reason)
+}
+
+func TestSetStep(t *testing.T) {
+	t.Parallel()
+
+	role := newRole()
+
+	SetStep(role, "RoleSynced", nil)
+	SetStep(role, "CompositesSynced", errors.New("composite role not found"))
+	SetStep(role, "", errors.New("ignored"))
+
+	require.Len(t, role.Status.Conditions, 2)
+
+	synced := meta.FindStatusCondition(role.Status.Conditions, "RoleSynced")
+	require.NotNil(t, synced)
+	assert.Equal(t, metav1.ConditionTrue, synced.Status)
+	assert.Equal(t, ReasonStepSucceeded, synced.Reason)
+
+	failed := meta.FindStatusCondition(role.Status.Conditions, "CompositesSynced")
+	require.NotNil(t, failed)
+	assert.Equal(t, metav1.ConditionFalse, failed.Status)
+	assert.Equal(t, events.ReasonReconciliationFailed, failed.Reason)
+	assert.Equal(t, "composite role not found", failed.Message)
+
+	assert.Zero(t, role.Status.ObservedGeneration, "step conditions don't update observed generation")
+}
@@ -0,0 +1,35 @@
+// Package metrics provides custom Prometheus metrics exposed by the operator.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// DriftedFields reports the number of fields that differ between the resource spec and Keycloak
+// for resources reconciled in observe mode.
+var DriftedFields = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "keycloak_operator_drifted_fields",
+		Help: "Number of fields that differ between the custom resource spec and the live Keycloak object.",
+	},
+	[]string{"kind", "namespace", "name"},
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(DriftedFields)
+}
+
+// SetDriftedFields sets the number of drifted fields for the resource.
+func SetDriftedFields(kind, namespace, name string, count int) {
+	if !ResourceMetricsEnabled() {
+		return
+	}
+
+	DriftedFields.WithLabelValues(kind, namespace, name).Set(float64(count))
+}
+
+// DeleteDriftedFields removes the drift metric of the resource.
+func DeleteDriftedFields(kind, namespace, name string) {
+	DriftedFields.DeleteLabelValues(kind, namespace, name)
+}
@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// OperationCreated is the operation label value for objects created in Keycloak.
+	OperationCreated = "created"
+
+	// OperationUpdated is the operation label value for objects updated in Keycloak.
+	OperationUpdated = "updated"
+
+	// OperationDeleted is the operation label value for objects deleted from Keycloak.
+	OperationDeleted = "deleted"
+)
+
+var resourceLabels = []string{"kind", "namespace", "name"}
+
+// ResourceReady reports whether the Ready condition of the custom resource is True.
+var ResourceReady = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "keycloak_operator_resource_ready",
+		Help: "Whether the custom resource is ready (1) or not (0).",
+	},
+	resourceLabels,
+)
+
+// ResourceFailureCount mirrors the failure count in the custom resource status.
+var ResourceFailureCount = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "keycloak_operator_resource_failure_count",
+		Help: "Number of consecutive failed reconciliations of the custom resource.",
+	},
+	resourceLabels,
+)
+
+// ResourceLastSuccessfulSync reports the time of the last successful reconciliation of the custom resource.
+var ResourceLastSuccessfulSync = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "keycloak_operator_resource_last_successful_sync_timestamp_seconds",
+		Help: "Unix time of the last successful reconciliation of the custom resource.",
+	},
+	resourceLabels,
+)
+
+// KeycloakObjectOperations counts Keycloak objects created, updated and deleted by the operator.
+var KeycloakObjectOperations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "keycloak_operator_keycloak_object_operations_total",
+		Help: "Number of Keycloak objects created, updated and deleted by the operator per custom resource kind.",
+	},
+	[]string{"kind", "operation"},
+)
+
+// resourceMetricsEnabled controls whether metrics labeled with the custom resource name are reported.
+var resourceMetricsEnabled atomic.Bool
+
+func init() {
+	resourceMetricsEnabled.Store(true)
+
+	ctrlmetrics.Registry.MustRegister(
+		ResourceReady,
+		ResourceFailureCount,
+		ResourceLastSuccessfulSync,
+		KeycloakObjectOperations,
+	)
+}
+
+// SetResourceMetricsEnabled enables or disables metrics labeled with the custom resource name.
+// Disabling them limits the cardinality of the metrics to the number of custom resource kinds.
+func SetResourceMetricsEnabled(enabled bool) {
+	resourceMetricsEnabled.Store(enabled)
+}
+
+// ResourceMetricsEnabled returns true if metrics labeled with the custom resource name are reported.
+func ResourceMetricsEnabled() bool {
+	return resourceMetricsEnabled.Load()
+}
+
+// SetResourceReady sets the readiness of the resource.
+func SetResourceReady(kind, namespace, name string, ready bool) {
+	if !ResourceMetricsEnabled() {
+		return
+	}
+
+	value := 0.0
+	if ready {
+		value = 1
+	}
+
+	ResourceReady.WithLabelValues(kind, namespace, name).Set(value)
+}
+
+// SetResourceFailureCount sets the failure count of the resource.
+func SetResourceFailureCount(kind, namespace, name string, count int64) {
+	if !ResourceMetricsEnabled() {
+		return
+	}
+
+	ResourceFailureCount.WithLabelValues(kind, namespace, name).Set(float64(count))
+}
+
+// SetResourceLastSuccessfulSync sets the time of the last successful reconciliation of the resource.
+func SetResourceLastSuccessfulSync(kind, namespace, name string, t time.Time) {
+	if !ResourceMetricsEnabled() {
+		return
+	}
+
+	ResourceLastSuccessfulSync.WithLabelValues(kind, namespace, name).Set(float64(t.Unix()))
+}
+
+// DeleteResource removes all metrics of the deleted resource.
+func DeleteResource(kind, namespace, name string) {
+	ResourceReady.DeleteLabelValues(kind, namespace, name)
+	ResourceFailureCount.DeleteLabelValues(kind, namespace, name)
+	ResourceLastSuccessfulSync.DeleteLabelValues(kind, namespace, name)
+	DeleteDriftedFields(kind, namespace, name)
+}
+
+// IncKeycloakObjectOperations increments the number of Keycloak objects created, updated or deleted for the kind.
+func IncKeycloakObjectOperations(kind, operation string) {
+	KeycloakObjectOperations.WithLabelValues(kind, operation).Inc()
+}
+
+// KindOf returns the kind of the custom resource.
+// The type name is used if the object doesn't have the kind set, as for objects read by the typed client.
+func KindOf(obj runtime.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
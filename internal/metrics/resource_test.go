@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+)
+
+func TestResourceMetrics(t *testing.T) {
+	SetResourceReady("KeycloakRealmRole", "default", "role", true)
+	SetResourceFailureCount("KeycloakRealmRole", "default", "role", 2)
+	SetResourceLastSuccessfulSync("KeycloakRealmRole", "default", "role", time.Unix(100, 0))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(ResourceReady.WithLabelValues("KeycloakRealmRole", "default", "role")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(ResourceFailureCount.WithLabelValues("KeycloakRealmRole", "default", "role")))
+	assert.Equal(t, 100.0, testutil.ToFloat64(ResourceLastSuccessfulSync.WithLabelValues("KeycloakRealmRole", "default", "role")))
+
+	DeleteResource("KeycloakRealmRole", "default", "role")
+
+	assert.Zero(t, testutil.CollectAndCount(ResourceReady))
+	assert.Zero(t, testutil.CollectAndCount(ResourceFailureCount))
+	assert.Zero(t, testutil.CollectAndCount(ResourceLastSuccessfulSync))
+}
+
+func TestResourceMetrics_Disabled(t *testing.T) {
+	SetResourceMetricsEnabled(false)
+	t.Cleanup(func() { SetResourceMetricsEnabled(true) })
+
+	SetResourceReady("KeycloakRealmRole", "default", "disabled", true)
+	SetDriftedFields("KeycloakRealm", "default", "disabled", 3)
+
+	assert.False(t, ResourceReady.DeleteLabelValues("KeycloakRealmRole", "default", "disabled"))
+	assert.False(t, DriftedFields.DeleteLabelValues("KeycloakRealm", "default", "disabled"))
+}
+
+func TestIncKeycloakObjectOperations(t *testing.T) {
+	IncKeycloakObjectOperations("KeycloakClient", OperationCreated)
+	IncKeycloakObjectOperations("KeycloakClient", OperationCreated)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(KeycloakObjectOperations.WithLabelValues("KeycloakClient", OperationCreated)))
+}
+
+func TestKindOf(t *testing.T) {
+	assert.Equal(t, "KeycloakRealmRole", KindOf(&keycloakApi.KeycloakRealmRole{}))
+
+	role := &keycloakApi.KeycloakRealmRole{}
+	role.Kind = "Custom"
+
+	assert.Equal(t, "Custom", KindOf(role))
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// WritePerformed is the result label value for updates sent to Keycloak.
+	WritePerformed = "performed"
+
+	// WriteSkipped is the result label value for updates skipped because Keycloak already matches the desired state.
+	WriteSkipped = "skipped"
+)
+
+// KeycloakWrites counts updates of Keycloak objects sent by the operator
+// and updates skipped because the live object already matches the desired state.
+var KeycloakWrites = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "keycloak_operator_keycloak_writes_total",
+		Help: "Number of updates of Keycloak objects performed or skipped by the operator per custom resource kind.",
+	},
+	[]string{"kind", "result"},
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(KeycloakWrites)
+}
+
+// RecordWrite records whether the update of the Keycloak object for the kind was performed or skipped.
+func RecordWrite(kind string, performed bool) {
+	result := WriteSkipped
+	if performed {
+		result = WritePerformed
+	}
+
+	KeycloakWrites.WithLabelValues(kind, result).Inc()
+}
@@ -0,0 +1,17 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordWrite(t *testing.T) {
+	RecordWrite("KeycloakRealmGroup", true)
+	RecordWrite("KeycloakRealmGroup", false)
+	RecordWrite("KeycloakRealmGroup", false)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(KeycloakWrites.WithLabelValues("KeycloakRealmGroup", WritePerformed)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(KeycloakWrites.WithLabelValues("KeycloakRealmGroup", WriteSkipped)))
+}
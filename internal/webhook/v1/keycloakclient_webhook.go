@@ -2,14 +2,18 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"text/template"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
 // log is for logging in this package.
@@ -19,6 +23,7 @@ var keycloakclientlog = logf.Log.WithName("keycloakclient-resource")
 func SetupKeycloakClientWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&keycloakApi.KeycloakClient{}).
 		WithDefaulter(&KeycloakClientCustomDefaulter{}).
+		WithValidator(&KeycloakClientCustomValidator{}).
 		Complete()
 }
 
@@ -142,3 +147,185 @@ func (r *KeycloakClientCustomDefaulter) migrateServiceAccountAttributes(keycloak
 
 	return false
 }
+
+// +kubebuilder:webhook:path=/validate-v1-edp-epam-com-v1-keycloakclient,mutating=false,failurePolicy=fail,sideEffects=None,groups=v1.edp.epam.com,resources=keycloakclients,verbs=create;update,versions=v1,name=vkeycloakclient-v1.kb.io,admissionReviewVersions=v1
+
+// KeycloakClientCustomValidator validates the KeycloakClient resource on create and update.
+type KeycloakClientCustomValidator struct{}
+
+var _ webhook.CustomValidator = &KeycloakClientCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type KeycloakClient.
+func (v *KeycloakClientCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	keycloakclient, ok := obj.(*keycloakApi.KeycloakClient)
+	if !ok {
+		return nil, fmt.Errorf("expected a KeycloakClient object but got %T", obj)
+	}
+
+	keycloakclientlog.Info("Validation for KeycloakClient upon creation", "name", keycloakclient.GetName())
+
+	return nil, validateKeycloakClient(keycloakclient)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type KeycloakClient.
+func (v *KeycloakClientCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	keycloakclient, ok := newObj.(*keycloakApi.KeycloakClient)
+	if !ok {
+		return nil, fmt.Errorf("expected a KeycloakClient object but got %T", newObj)
+	}
+
+	keycloakclientlog.Info("Validation for KeycloakClient upon update", "name", keycloakclient.GetName())
+
+	// Clients that predate the validation must still be deletable.
+	if !keycloakclient.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+
+	return nil, validateKeycloakClient(keycloakclient)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type KeycloakClient.
+func (v *KeycloakClientCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateKeycloakClient checks the settings of the KeycloakClient that can't be expressed with the CRD schema.
+func validateKeycloakClient(keycloakClient *keycloakApi.KeycloakClient) error {
+	if err := validateClientAuthentication(keycloakClient); err != nil {
+		return err
+	}
+
+	if err := validateSAML(keycloakClient); err != nil {
+		return err
+	}
+
+	if err := validateAuthorization(keycloakClient); err != nil {
+		return err
+	}
+
+	return validateRedirectFrom(keycloakClient)
+}
+
+// validateClientAuthentication checks that the client authentication has the key material
+// required by its authenticator type and nothing that the type doesn't use.
+func validateClientAuthentication(keycloakClient *keycloakApi.KeycloakClient) error {
+	auth := keycloakClient.Spec.ClientAuthentication
+	if auth == nil {
+		return nil
+	}
+
+	if keycloakClient.Spec.Public {
+		return errors.New("spec.clientAuthentication can not be set for public client")
+	}
+
+	if auth.Certificate != nil && (auth.Certificate.SecretKeyRef == nil) == (auth.Certificate.ConfigMapKeyRef == nil) {
+		return errors.New("spec.clientAuthentication.certificate must have exactly one of secretKeyRef or configMapKeyRef")
+	}
+
+	switch auth.Type {
+	case keycloakApi.ClientAuthenticatorJWT:
+		if (auth.JWKSURL == "") == (auth.Certificate == nil) {
+			return fmt.Errorf("exactly one of spec.clientAuthentication.jwksUrl or certificate is required for %s", auth.Type)
+		}
+
+		if auth.SubjectDN != "" {
+			return fmt.Errorf("spec.clientAuthentication.subjectDn is not supported for %s", auth.Type)
+		}
+	case keycloakApi.ClientAuthenticatorSecretJWT:
+		if auth.JWKSURL != "" || auth.Certificate != nil || auth.SubjectDN != "" {
+			return fmt.Errorf("spec.clientAuthentication.jwksUrl, certificate and subjectDn are not supported for %s", auth.Type)
+		}
+	case keycloakApi.ClientAuthenticatorX509:
+		if auth.SubjectDN == "" {
+			return fmt.Errorf("spec.clientAuthentication.subjectDn is required for %s", auth.Type)
+		}
+
+		if auth.JWKSURL != "" || auth.Certificate != nil || auth.SigningAlgorithm != "" {
+			return fmt.Errorf("spec.clientAuthentication.jwksUrl, certificate and signingAlgorithm are not supported for %s", auth.Type)
+		}
+	default:
+		return fmt.Errorf("unsupported spec.clientAuthentication.type %q", auth.Type)
+	}
+
+	return nil
+}
+
+// validateSAML checks that the SAML settings are set only for SAML client
+// and that SAML client doesn't use the settings supported only by OpenID Connect.
+func validateSAML(keycloakClient *keycloakApi.KeycloakClient) error {
+	spec := &keycloakClient.Spec
+	isSAML := spec.Protocol != nil && *spec.Protocol == keycloakapi.ProtocolSAML
+
+	if spec.SAML != nil && !isSAML {
+		return fmt.Errorf("spec.saml can be set only if spec.protocol is %s", keycloakapi.ProtocolSAML)
+	}
+
+	if !isSAML {
+		return nil
+	}
+
+	oidcOnlyFields := []struct {
+		name string
+		set  bool
+	}{
+		{name: "public", set: spec.Public},
+		{name: "bearerOnly", set: spec.BearerOnly},
+		{name: "directAccess", set: spec.DirectAccess},
+		{name: "implicitFlowEnabled", set: spec.ImplicitFlowEnabled},
+		{name: "serviceAccount", set: spec.ServiceAccount != nil && spec.ServiceAccount.Enabled},
+		{name: "authorizationServicesEnabled", set: spec.AuthorizationServicesEnabled},
+		{name: "authorization", set: spec.Authorization != nil},
+		{name: "clientAuthentication", set: spec.ClientAuthentication != nil},
+		{name: "secretRotation", set: spec.SecretRotation != nil},
+		{name: "connectionSecret", set: spec.ConnectionSecret != nil},
+	}
+
+	for _, f := range oidcOnlyFields {
+		if f.set {
+			return fmt.Errorf("spec.%s is not supported for %s client", f.name, keycloakapi.ProtocolSAML)
+		}
+	}
+
+	if spec.SAML != nil && spec.SAML.Metadata != nil &&
+		(spec.SAML.Metadata.SecretKeyRef == nil) == (spec.SAML.Metadata.ConfigMapKeyRef == nil) {
+		return errors.New("spec.saml.metadata must have exactly one of secretKeyRef or configMapKeyRef")
+	}
+
+	return nil
+}
+
+// validateAuthorization checks that the authorization settings are either imported from the ConfigMap or defined inline.
+func validateAuthorization(keycloakClient *keycloakApi.KeycloakClient) error {
+	authorization := keycloakClient.Spec.Authorization
+	if authorization == nil {
+		return nil
+	}
+
+	for _, test := range authorization.Tests {
+		if test.User == "" && len(test.Roles) == 0 {
+			return fmt.Errorf("spec.authorization.tests %s: user or roles must be specified", test.Name)
+		}
+	}
+
+	if authorization.FromConfigMap == nil {
+		return nil
+	}
+
+	if len(authorization.Scopes) > 0 || len(authorization.Policies) > 0 ||
+		len(authorization.Permissions) > 0 || len(authorization.Resources) > 0 {
+		return errors.New("spec.authorization.fromConfigMap can not be combined with scopes, policies, permissions or resources")
+	}
+
+	return nil
+}
+
+// validateRedirectFrom checks that the path suffixes of the redirect sources are valid templates.
+func validateRedirectFrom(keycloakClient *keycloakApi.KeycloakClient) error {
+	for _, source := range keycloakClient.Spec.RedirectFrom {
+		if _, err := template.New(source.Name).Parse(source.PathSuffix); err != nil {
+			return fmt.Errorf("spec.redirectFrom %s %s: pathSuffix is not a valid template: %w", source.Kind, source.Name, err)
+		}
+	}
+
+	return nil
+}
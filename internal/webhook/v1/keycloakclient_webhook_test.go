@@ -1,13 +1,18 @@
 package v1
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/epam/edp-keycloak-operator/api/common"
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
 )
 
 var _ = Describe("KeycloakClient Webhook", func() {
@@ -428,4 +433,240 @@ var _ = Describe("KeycloakClient Webhook", func() {
 			Expect(createdClient.Spec.ServiceAccount.AttributesV2).Should(Equal(saAttributesV2))
 		})
 	})
+
+	Context("When validating KeycloakClient client authentication", func() {
+		newClient := func(auth *keycloakApi.ClientAuthentication) *keycloakApi.KeycloakClient {
+			return &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-client-auth",
+					Namespace: testNamespace,
+				},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:             testClientId,
+					ClientAuthentication: auth,
+				},
+			}
+		}
+
+		certRef := &common.SourceRef{
+			SecretKeyRef: &common.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "client-cert"},
+				Key:                  "tls.crt",
+			},
+		}
+
+		It("Should allow client-jwt with JWKS URL", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.ClientAuthentication{
+				Type:    keycloakApi.ClientAuthenticatorJWT,
+				JWKSURL: "https://app.example.com/jwks",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should allow client-jwt with certificate", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.ClientAuthentication{
+				Type:        keycloakApi.ClientAuthenticatorJWT,
+				Certificate: certRef,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny client-jwt with both JWKS URL and certificate", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.ClientAuthentication{
+				Type:        keycloakApi.ClientAuthenticatorJWT,
+				JWKSURL:     "https://app.example.com/jwks",
+				Certificate: certRef,
+			}))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one of spec.clientAuthentication.jwksUrl or certificate"))
+		})
+
+		It("Should deny client-secret-jwt with key material", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.ClientAuthentication{
+				Type:    keycloakApi.ClientAuthenticatorSecretJWT,
+				JWKSURL: "https://app.example.com/jwks",
+			}))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should allow client-x509 with subject DN in Java regular expression syntax", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.ClientAuthentication{
+				Type:      keycloakApi.ClientAuthenticatorX509,
+				SubjectDN: "(?<=CN=)app(?=,|$)",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny client authentication for public client", func() {
+			v := &KeycloakClientCustomValidator{}
+			kc := newClient(&keycloakApi.ClientAuthentication{
+				Type:      keycloakApi.ClientAuthenticatorX509,
+				SubjectDN: "CN=app",
+			})
+			kc.Spec.Public = true
+
+			_, err := v.ValidateUpdate(context.Background(), &keycloakApi.KeycloakClient{}, kc)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When validating KeycloakClient SAML settings", func() {
+		newClient := func(protocol string, saml *keycloakApi.SAMLSettings) *keycloakApi.KeycloakClient {
+			return &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-client-saml",
+					Namespace: testNamespace,
+				},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: testClientId,
+					Protocol: ptr.To(protocol),
+					SAML:     saml,
+				},
+			}
+		}
+
+		It("Should allow SAML settings for SAML client", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(keycloakapi.ProtocolSAML, &keycloakApi.SAMLSettings{
+				NameIDFormat: "email",
+				Signing: &keycloakApi.SAMLSigning{
+					SignDocuments: true,
+					KeyPair:       &keycloakApi.SAMLKeyPair{SecretName: "saml-signing"},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny SAML settings for OpenID Connect client", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient("openid-connect", &keycloakApi.SAMLSettings{}))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.saml can be set only if spec.protocol is saml"))
+		})
+
+		It("Should deny OpenID Connect only fields for SAML client", func() {
+			v := &KeycloakClientCustomValidator{}
+			kc := newClient(keycloakapi.ProtocolSAML, nil)
+			kc.Spec.DirectAccess = true
+
+			_, err := v.ValidateUpdate(context.Background(), &keycloakApi.KeycloakClient{}, kc)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.directAccess is not supported for saml client"))
+		})
+
+		It("Should deny metadata with both ConfigMap and Secret references", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(keycloakapi.ProtocolSAML, &keycloakApi.SAMLSettings{
+				Metadata: &common.SourceRef{
+					ConfigMapKeyRef: &common.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "sp-metadata"},
+						Key:                  "metadata.xml",
+					},
+					SecretKeyRef: &common.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "sp-metadata"},
+						Key:                  "metadata.xml",
+					},
+				},
+			}))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When validating KeycloakClient authorization import", func() {
+		fromConfigMap := &common.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "authz-export"},
+			Key:                  "authz.json",
+		}
+
+		newClient := func(authorization *keycloakApi.Authorization) *keycloakApi.KeycloakClient {
+			return &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-client-authz-import",
+					Namespace: testNamespace,
+				},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId:                     testClientId,
+					AuthorizationServicesEnabled: true,
+					Authorization:                authorization,
+				},
+			}
+		}
+
+		It("Should allow authorization imported from ConfigMap", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.Authorization{
+				FromConfigMap: fromConfigMap,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny authorization imported from ConfigMap with inline policies", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.Authorization{
+				FromConfigMap: fromConfigMap,
+				Policies: []keycloakApi.Policy{
+					{Name: "user-policy", Type: keycloakApi.PolicyTypeUser},
+				},
+			}))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.authorization.fromConfigMap can not be combined"))
+		})
+
+		It("Should allow authorization tests for imported authorization", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.Authorization{
+				FromConfigMap: fromConfigMap,
+				Tests: []keycloakApi.AuthorizationTest{
+					{Name: "admin", Roles: []string{"admin"}, Resource: "documents", Expected: keycloakApi.AuthorizationDecisionPermit},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny authorization test without user and roles", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient(&keycloakApi.Authorization{
+				Tests: []keycloakApi.AuthorizationTest{
+					{Name: "anonymous", Resource: "documents", Expected: keycloakApi.AuthorizationDecisionDeny},
+				},
+			}))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("user or roles must be specified"))
+		})
+	})
+
+	Context("When validating KeycloakClient redirect sources", func() {
+		newClient := func(pathSuffix string) *keycloakApi.KeycloakClient {
+			return &keycloakApi.KeycloakClient{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-client-redirect-from",
+					Namespace: testNamespace,
+				},
+				Spec: keycloakApi.KeycloakClientSpec{
+					ClientId: testClientId,
+					RedirectFrom: []keycloakApi.RedirectSource{
+						{Kind: keycloakApi.RedirectSourceIngress, Name: "app", PathSuffix: pathSuffix},
+					},
+				},
+			}
+		}
+
+		It("Should allow valid path suffix template", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient("{{ .Path }}/oauth2/callback"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny invalid path suffix template", func() {
+			v := &KeycloakClientCustomValidator{}
+			_, err := v.ValidateCreate(context.Background(), newClient("{{ .Path"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("pathSuffix is not a valid template"))
+		})
+	})
 })
@@ -20,6 +20,10 @@ type (
 	DecisionStrategy                 = generated.DecisionStrategy
 	Logic                            = generated.Logic
 	AuthenticationFlowRepresentation = generated.AuthenticationFlowRepresentation
+	ResourceServerRepresentation     = generated.ResourceServerRepresentation
+	PolicyEvaluationRequest          = generated.PolicyEvaluationRequest
+	PolicyEvaluationResponse         = generated.PolicyEvaluationResponse
+	DecisionEffect                   = generated.DecisionEffect
 
 	GetAuthzScopesParams      = generated.GetAdminRealmsRealmClientsClientUuidAuthzResourceServerScopeParams
 	GetAuthzResourcesParams   = generated.GetAdminRealmsRealmClientsClientUuidAuthzResourceServerResourceParams
@@ -28,6 +32,12 @@ type (
 	PostAuthzResourcesParams  = generated.PostAdminRealmsRealmClientsClientUuidAuthzResourceServerResourceParams
 )
 
+// Values for DecisionEffect
+const (
+	DecisionEffectPermit = generated.PERMIT
+	DecisionEffectDeny   = generated.DENY
+)
+
 type authorizationClient struct {
 	client generated.ClientWithResponsesInterface
 	kc     *KeycloakClient
@@ -484,6 +494,132 @@ func (a *authorizationClient) DeletePermission(
 	return resp, err
 }
 
+// Policy associations - custom HTTP (not in OpenAPI spec), used for both policies and permissions
+
+func (a *authorizationClient) GetPolicyAssociatedPolicies(
+	ctx context.Context,
+	realm string,
+	clientUUID string,
+	policyID string,
+) ([]PolicyRepresentation, *Response, error) {
+	var policies []PolicyRepresentation
+
+	resp, err := a.getPolicyAssociations(ctx, realm, clientUUID, policyID, "associatedPolicies", &policies)
+
+	return policies, resp, err
+}
+
+func (a *authorizationClient) GetPolicyResources(
+	ctx context.Context,
+	realm string,
+	clientUUID string,
+	policyID string,
+) ([]ResourceRepresentation, *Response, error) {
+	var resources []ResourceRepresentation
+
+	resp, err := a.getPolicyAssociations(ctx, realm, clientUUID, policyID, "resources", &resources)
+
+	return resources, resp, err
+}
+
+func (a *authorizationClient) GetPolicyScopes(
+	ctx context.Context,
+	realm string,
+	clientUUID string,
+	policyID string,
+) ([]ScopeRepresentation, *Response, error) {
+	var scopes []ScopeRepresentation
+
+	resp, err := a.getPolicyAssociations(ctx, realm, clientUUID, policyID, "scopes", &scopes)
+
+	return scopes, resp, err
+}
+
+func (a *authorizationClient) getPolicyAssociations(
+	ctx context.Context,
+	realm string,
+	clientUUID string,
+	policyID string,
+	association string,
+	out any,
+) (*Response, error) {
+	reqURL := fmt.Sprintf(
+		"%s/admin/realms/%s/clients/%s/authz/resource-server/policy/%s/%s",
+		a.kc.baseUrl, url.PathEscape(realm), url.PathEscape(clientUUID), url.PathEscape(policyID), association,
+	)
+
+	_, resp, err := a.doJSONRequest(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(resp.Body) == 0 {
+		return resp, nil
+	}
+
+	if err := json.Unmarshal(resp.Body, out); err != nil {
+		return resp, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Resource server
+
+func (a *authorizationClient) ImportResourceServer(
+	ctx context.Context,
+	realm string,
+	clientUUID string,
+	resourceServer ResourceServerRepresentation,
+) (*Response, error) {
+	res, err := a.client.PostAdminRealmsRealmClientsClientUuidAuthzResourceServerImportWithResponse(
+		ctx, realm, clientUUID, resourceServer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Evaluation
+
+func (a *authorizationClient) EvaluatePolicies(
+	ctx context.Context,
+	realm string,
+	clientUUID string,
+	request PolicyEvaluationRequest,
+) (*PolicyEvaluationResponse, *Response, error) {
+	res, err := a.client.PostAdminRealmsRealmClientsClientUuidAuthzResourceServerPolicyEvaluateWithResponse(
+		ctx, realm, clientUUID, request,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res == nil {
+		return nil, nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return nil, response, err
+	}
+
+	return res.JSON200, response, nil
+}
+
 // doJSONRequest is a helper for custom HTTP calls not available in the generated client.
 func (a *authorizationClient) doJSONRequest(
 	ctx context.Context,
@@ -485,6 +485,12 @@ func TestAuthorizationClient_PolicyTypes(t *testing.T) {
 		require.Equal(t, keycloakapi.DecisionStrategy("UNANIMOUS"), got.DecisionStrategy)
 		require.Equal(t, keycloakapi.Logic("POSITIVE"), got.Logic)
 
+		// Associated policies are returned by a separate endpoint
+		associated, _, err := kc.Authorization.GetPolicyAssociatedPolicies(ctx, realmName, clientUUID, *created.Id)
+		require.NoError(t, err)
+		require.Len(t, associated, 1)
+		require.Equal(t, seedPolicy.Id, associated[0].Id)
+
 		body.Name = name + "-updated"
 		_, err = kc.Authorization.UpdatePolicy(ctx, realmName, clientUUID, "aggregate", *created.Id, body)
 		require.NoError(t, err)
@@ -721,6 +727,19 @@ func TestAuthorizationClient_PermissionCRUD(t *testing.T) {
 	require.NoError(t, err)
 	require.Greater(t, len(permissions), baseline, "permission count should have increased")
 
+	// Associations of the permission
+	associatedPolicies, _, err := kc.Authorization.GetPolicyAssociatedPolicies(ctx, realmName, clientUUID, permID)
+	require.NoError(t, err)
+	require.Empty(t, associatedPolicies)
+
+	permResources, _, err := kc.Authorization.GetPolicyResources(ctx, realmName, clientUUID, permID)
+	require.NoError(t, err)
+	require.Empty(t, permResources)
+
+	permScopes, _, err := kc.Authorization.GetPolicyScopes(ctx, realmName, clientUUID, permID)
+	require.NoError(t, err)
+	require.Empty(t, permScopes)
+
 	// UpdatePermission
 	updatedName := permName + "-updated"
 	perm.Name = &updatedName
@@ -856,3 +875,174 @@ func TestAuthorizationClient_GetAndUpdateScope(t *testing.T) {
 
 	require.Equal(t, updatedName, *updated.Name)
 }
+
+func TestAuthorizationClient_ImportResourceServer(t *testing.T) {
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+	t.Parallel()
+
+	kc, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	realmName := fmt.Sprintf("test-realm-authz-import-%d", time.Now().UnixNano())
+	enabled := true
+
+	t.Cleanup(func() {
+		_, _ = kc.Realms.DeleteRealm(context.Background(), realmName)
+	})
+
+	_, err = kc.Realms.CreateRealm(ctx, keycloakapi.RealmRepresentation{
+		Realm:   &realmName,
+		Enabled: &enabled,
+	})
+	require.NoError(t, err)
+
+	clientUUID := createAuthzClient(t, kc, ctx, realmName)
+
+	export := `{
+		"policyEnforcementMode": "PERMISSIVE",
+		"decisionStrategy": "AFFIRMATIVE",
+		"scopes": [{"name": "imported-scope"}],
+		"resources": [{"name": "imported-resource", "scopes": [{"name": "imported-scope"}]}],
+		"policies": [
+			{
+				"name": "imported-regex-policy",
+				"type": "regex",
+				"logic": "POSITIVE",
+				"decisionStrategy": "UNANIMOUS",
+				"config": {"targetClaim": "department", "pattern": "^engineering$"}
+			}
+		]
+	}`
+
+	var resourceServer keycloakapi.ResourceServerRepresentation
+
+	require.NoError(t, json.Unmarshal([]byte(export), &resourceServer))
+
+	_, err = kc.Authorization.ImportResourceServer(ctx, realmName, clientUUID, resourceServer)
+	require.NoError(t, err)
+
+	scopes, _, err := kc.Authorization.GetScopes(ctx, realmName, clientUUID)
+	require.NoError(t, err)
+
+	scopeNames := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		scopeNames = append(scopeNames, *s.Name)
+	}
+
+	require.Contains(t, scopeNames, "imported-scope")
+
+	policies, _, err := kc.Authorization.GetPolicies(ctx, realmName, clientUUID)
+	require.NoError(t, err)
+
+	policyNames := make([]string, 0, len(policies))
+	for _, p := range policies {
+		policyNames = append(policyNames, *p.Name)
+	}
+
+	require.Contains(t, policyNames, "imported-regex-policy")
+}
+
+func TestAuthorizationClient_EvaluatePolicies(t *testing.T) {
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+	t.Parallel()
+
+	kc, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	realmName := fmt.Sprintf("test-realm-authz-evaluate-%d", time.Now().UnixNano())
+	enabled := true
+
+	t.Cleanup(func() {
+		_, _ = kc.Realms.DeleteRealm(context.Background(), realmName)
+	})
+
+	_, err = kc.Realms.CreateRealm(ctx, keycloakapi.RealmRepresentation{
+		Realm:   &realmName,
+		Enabled: &enabled,
+	})
+	require.NoError(t, err)
+
+	for _, roleName := range []string{"editor", "viewer"} {
+		_, err = kc.Roles.CreateRealmRole(ctx, realmName, keycloakapi.RoleRepresentation{Name: &roleName})
+		require.NoError(t, err)
+	}
+
+	clientUUID := createAuthzClient(t, kc, ctx, realmName)
+
+	export := `{
+		"policyEnforcementMode": "ENFORCING",
+		"decisionStrategy": "UNANIMOUS",
+		"scopes": [{"name": "edit"}],
+		"resources": [{"name": "documents", "scopes": [{"name": "edit"}]}],
+		"policies": [
+			{
+				"name": "editor-policy",
+				"type": "role",
+				"logic": "POSITIVE",
+				"config": {"roles": "[{\"id\":\"editor\",\"required\":true}]"}
+			},
+			{
+				"name": "documents-permission",
+				"type": "resource",
+				"logic": "POSITIVE",
+				"decisionStrategy": "UNANIMOUS",
+				"config": {"resources": "[\"documents\"]", "applyPolicies": "[\"editor-policy\"]"}
+			}
+		]
+	}`
+
+	var resourceServer keycloakapi.ResourceServerRepresentation
+
+	require.NoError(t, json.Unmarshal([]byte(export), &resourceServer))
+
+	_, err = kc.Authorization.ImportResourceServer(ctx, realmName, clientUUID, resourceServer)
+	require.NoError(t, err)
+
+	resources, _, err := kc.Authorization.GetResources(ctx, realmName, clientUUID)
+	require.NoError(t, err)
+
+	var documentsID *string
+
+	for _, r := range resources {
+		if r.Name != nil && *r.Name == "documents" {
+			documentsID = r.UnderscoreId
+		}
+	}
+
+	require.NotNil(t, documentsID)
+
+	scopeName := "edit"
+
+	evaluate := func(role string) keycloakapi.DecisionEffect {
+		t.Helper()
+
+		res, _, err := kc.Authorization.EvaluatePolicies(ctx, realmName, clientUUID, keycloakapi.PolicyEvaluationRequest{
+			RoleIds: &[]string{role},
+			Resources: &[]keycloakapi.ResourceRepresentation{{
+				UnderscoreId: documentsID,
+				Scopes:       &[]keycloakapi.ScopeRepresentation{{Name: &scopeName}},
+			}},
+			Context: &map[string]map[string]string{"attributes": {}},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.NotNil(t, res.Status)
+
+		return *res.Status
+	}
+
+	require.Equal(t, keycloakapi.DecisionEffectPermit, evaluate("editor"))
+	require.Equal(t, keycloakapi.DecisionEffectDeny, evaluate("viewer"))
+}
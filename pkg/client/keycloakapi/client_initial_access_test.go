@@ -0,0 +1,63 @@
+package keycloakapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/testutils"
+)
+
+func TestClientInitialAccessClient_CRUD(t *testing.T) {
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+	t.Parallel()
+
+	c, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	realmName := fmt.Sprintf("test-realm-cia-%d", time.Now().UnixNano())
+
+	t.Cleanup(func() {
+		_, _ = c.Realms.DeleteRealm(context.Background(), realmName)
+	})
+
+	_, err = c.Realms.CreateRealm(ctx, keycloakapi.RealmRepresentation{
+		Realm:   &realmName,
+		Enabled: ptr.To(true),
+	})
+	require.NoError(t, err)
+
+	created, resp, err := c.ClientInitialAccess.CreateInitialAccessToken(ctx, realmName, keycloakapi.ClientInitialAccessCreatePresentation{
+		Count:      ptr.To(int32(2)),
+		Expiration: ptr.To(int32(3600)),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.NotNil(t, created.Id)
+	require.NotEmpty(t, ptr.Deref(created.Token, ""))
+
+	tokens, _, err := c.ClientInitialAccess.GetInitialAccessTokens(ctx, realmName)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, *created.Id, *tokens[0].Id)
+	require.Equal(t, int32(2), ptr.Deref(tokens[0].RemainingCount, 0))
+
+	_, err = c.ClientInitialAccess.DeleteInitialAccessToken(ctx, realmName, *created.Id)
+	require.NoError(t, err)
+
+	tokens, _, err = c.ClientInitialAccess.GetInitialAccessTokens(ctx, realmName)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
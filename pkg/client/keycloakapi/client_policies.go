@@ -7,10 +7,13 @@ import (
 )
 
 type (
-	ClientPoliciesRepresentation = generated.ClientPoliciesRepresentation
-	ClientProfilesRepresentation = generated.ClientProfilesRepresentation
-	GetClientPoliciesParams      = generated.GetAdminRealmsRealmClientPoliciesPoliciesParams
-	GetClientProfilesParams      = generated.GetAdminRealmsRealmClientPoliciesProfilesParams
+	ClientPoliciesRepresentation       = generated.ClientPoliciesRepresentation
+	ClientPolicyRepresentation         = generated.ClientPolicyRepresentation
+	ClientProfilesRepresentation       = generated.ClientProfilesRepresentation
+	ClientProfileRepresentation        = generated.ClientProfileRepresentation
+	ClientPolicyExecutorRepresentation = generated.ClientPolicyExecutorRepresentation
+	GetClientPoliciesParams            = generated.GetAdminRealmsRealmClientPoliciesPoliciesParams
+	GetClientProfilesParams            = generated.GetAdminRealmsRealmClientPoliciesProfilesParams
 )
 
 // ClientPoliciesClient defines operations for managing Keycloak client policies and profiles.
@@ -747,6 +747,28 @@ func (c *clientsClient) RegenerateClientSecret(
 	return res.JSON200, response, nil
 }
 
+func (c *clientsClient) InvalidateRotatedClientSecret(
+	ctx context.Context,
+	realm, clientUUID string,
+) (*Response, error) {
+	res, err := c.client.DeleteAdminRealmsRealmClientsClientUuidClientSecretRotatedWithResponse(ctx, realm, clientUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
 func (c *clientsClient) GetClientSessions(
 	ctx context.Context,
 	realm, clientUUID string,
@@ -799,3 +821,141 @@ func (c *clientsClient) GetClientInstallationProvider(
 
 	return res.Body, response, nil
 }
+
+func (c *clientsClient) GetClientScopeMappings(
+	ctx context.Context,
+	realm, clientUUID string,
+) (*MappingsRepresentation, *Response, error) {
+	res, err := c.client.GetAdminRealmsRealmClientsClientUuidScopeMappingsWithResponse(ctx, realm, clientUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res == nil {
+		return nil, nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return nil, response, err
+	}
+
+	return res.JSON200, response, nil
+}
+
+func (c *clientsClient) AddClientRealmScopeMappings(
+	ctx context.Context,
+	realm, clientUUID string,
+	roles []RoleRepresentation,
+) (*Response, error) {
+	res, err := c.client.PostAdminRealmsRealmClientsClientUuidScopeMappingsRealmWithResponse(ctx, realm, clientUUID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (c *clientsClient) DeleteClientRealmScopeMappings(
+	ctx context.Context,
+	realm, clientUUID string,
+	roles []RoleRepresentation,
+) (*Response, error) {
+	res, err := c.client.DeleteAdminRealmsRealmClientsClientUuidScopeMappingsRealmWithResponse(ctx, realm, clientUUID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (c *clientsClient) AddClientRoleScopeMappings(
+	ctx context.Context,
+	realm, clientUUID, roleClientUUID string,
+	roles []RoleRepresentation,
+) (*Response, error) {
+	res, err := c.client.PostAdminRealmsRealmClientsClientUuidScopeMappingsClientsClientWithResponse(
+		ctx, realm, clientUUID, roleClientUUID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (c *clientsClient) DeleteClientRoleScopeMappings(
+	ctx context.Context,
+	realm, clientUUID, roleClientUUID string,
+	roles []RoleRepresentation,
+) (*Response, error) {
+	res, err := c.client.DeleteAdminRealmsRealmClientsClientUuidScopeMappingsClientsClientWithResponse(
+		ctx, realm, clientUUID, roleClientUUID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+func (c *clientsClient) ConvertClientDescription(
+	ctx context.Context,
+	realm, description string,
+) (*ClientRepresentation, *Response, error) {
+	res, err := c.client.PostAdminRealmsRealmClientDescriptionConverterWithTextBodyWithResponse(ctx, realm, description)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res == nil {
+		return nil, nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return nil, response, err
+	}
+
+	return res.JSON200, response, nil
+}
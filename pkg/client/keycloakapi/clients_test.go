@@ -1425,6 +1425,11 @@ func TestClientsClient_RegenerateClientSecret(t *testing.T) {
 	require.NotNil(t, regenerated)
 	require.NotNil(t, regenerated.Value)
 	require.NotEqual(t, originalValue, *regenerated.Value, "secret should change after regeneration")
+
+	// Invalidate the rotated secret.
+	resp, err = c.Clients.InvalidateRotatedClientSecret(ctx, realmName, clientUUID)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
 }
 
 func TestClientsClient_GetClientSessions(t *testing.T) {
@@ -1517,3 +1522,131 @@ func TestClientsClient_GetClientInstallationProvider(t *testing.T) {
 	require.NotNil(t, resp)
 	require.NotEmpty(t, data, "installation JSON should not be empty")
 }
+
+func TestClientsClient_ScopeMappings(t *testing.T) {
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+	t.Parallel()
+
+	c, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	realmName := fmt.Sprintf("test-realm-scope-mappings-%d", time.Now().UnixNano())
+	enabled := true
+
+	t.Cleanup(func() {
+		_, _ = c.Realms.DeleteRealm(context.Background(), realmName)
+	})
+
+	_, err = c.Realms.CreateRealm(ctx, keycloakapi.RealmRepresentation{
+		Realm:   &realmName,
+		Enabled: &enabled,
+	})
+	require.NoError(t, err)
+
+	realmRoleName := "scope-realm-role"
+	_, err = c.Roles.CreateRealmRole(ctx, realmName, keycloakapi.RoleRepresentation{Name: &realmRoleName})
+	require.NoError(t, err)
+
+	realmRole, _, err := c.Roles.GetRealmRole(ctx, realmName, realmRoleName)
+	require.NoError(t, err)
+
+	clientID := fmt.Sprintf("scope-client-%d", time.Now().UnixNano())
+	fullScopeAllowed := false
+
+	resp, err := c.Clients.CreateClient(ctx, realmName, keycloakapi.ClientRepresentation{
+		ClientId:         &clientID,
+		Enabled:          &enabled,
+		FullScopeAllowed: &fullScopeAllowed,
+	})
+	require.NoError(t, err)
+
+	clientUUID := keycloakapi.GetResourceIDFromResponse(resp)
+
+	roleName := testClientRoleName
+	_, err = c.Clients.CreateClientRole(ctx, realmName, clientUUID, keycloakapi.RoleRepresentation{Name: &roleName})
+	require.NoError(t, err)
+
+	clientRole, _, err := c.Clients.GetClientRole(ctx, realmName, clientUUID, roleName)
+	require.NoError(t, err)
+
+	// Add scope mappings.
+	_, err = c.Clients.AddClientRealmScopeMappings(ctx, realmName, clientUUID, []keycloakapi.RoleRepresentation{*realmRole})
+	require.NoError(t, err)
+
+	_, err = c.Clients.AddClientRoleScopeMappings(ctx, realmName, clientUUID, clientUUID, []keycloakapi.RoleRepresentation{*clientRole})
+	require.NoError(t, err)
+
+	mappings, _, err := c.Clients.GetClientScopeMappings(ctx, realmName, clientUUID)
+	require.NoError(t, err)
+	require.NotNil(t, mappings)
+	require.NotNil(t, mappings.RealmMappings)
+	require.Len(t, *mappings.RealmMappings, 1)
+	require.Equal(t, realmRoleName, *(*mappings.RealmMappings)[0].Name)
+	require.NotNil(t, mappings.ClientMappings)
+	require.Contains(t, *mappings.ClientMappings, clientID)
+
+	// Delete scope mappings.
+	_, err = c.Clients.DeleteClientRealmScopeMappings(ctx, realmName, clientUUID, []keycloakapi.RoleRepresentation{*realmRole})
+	require.NoError(t, err)
+
+	_, err = c.Clients.DeleteClientRoleScopeMappings(ctx, realmName, clientUUID, clientUUID, []keycloakapi.RoleRepresentation{*clientRole})
+	require.NoError(t, err)
+
+	mappings, _, err = c.Clients.GetClientScopeMappings(ctx, realmName, clientUUID)
+	require.NoError(t, err)
+
+	if mappings.RealmMappings != nil {
+		require.Empty(t, *mappings.RealmMappings)
+	}
+
+	if mappings.ClientMappings != nil {
+		require.NotContains(t, *mappings.ClientMappings, clientID)
+	}
+}
+
+func TestClientsClient_ConvertClientDescription(t *testing.T) {
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+	t.Parallel()
+
+	c, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	realmName := fmt.Sprintf("test-realm-convert-%d", time.Now().UnixNano())
+	enabled := true
+
+	t.Cleanup(func() {
+		_, _ = c.Realms.DeleteRealm(context.Background(), realmName)
+	})
+
+	_, err = c.Realms.CreateRealm(ctx, keycloakapi.RealmRepresentation{
+		Realm:   &realmName,
+		Enabled: &enabled,
+	})
+	require.NoError(t, err)
+
+	metadata := `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://app.example.com/saml">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://app.example.com/saml/acs" index="0"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`
+
+	client, _, err := c.Clients.ConvertClientDescription(ctx, realmName, metadata)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	require.NotNil(t, client.ClientId)
+	require.Equal(t, "https://app.example.com/saml", *client.ClientId)
+	require.NotNil(t, client.Protocol)
+	require.Equal(t, keycloakapi.ProtocolSAML, *client.Protocol)
+}
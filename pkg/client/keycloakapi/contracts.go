@@ -143,6 +143,9 @@ type RealmClient interface {
 	// PostRealmLocalization sets or merges localization strings for a realm locale (POST /admin/realms/{realm}/localization/{locale}).
 	// Keycloak ignores localizationTexts on realm update; runtime message bundles must use this API per locale.
 	PostRealmLocalization(ctx context.Context, realm, locale string, texts map[string]string) (*Response, error)
+	// PartialExportRealm exports the realm configuration, optionally including clients, groups and roles.
+	// Secret values are masked by Keycloak in the exported representation.
+	PartialExportRealm(ctx context.Context, realm string, params *PartialExportRealmParams) (*RealmRepresentation, *Response, error)
 }
 
 // GroupsClient defines operations for managing Keycloak groups including CRUD,
@@ -264,6 +267,8 @@ type ClientsClient interface {
 	GetClientSecret(ctx context.Context, realm, clientUUID string) (*CredentialRepresentation, *Response, error)
 	// RegenerateClientSecret rotates the client secret and returns the new credential.
 	RegenerateClientSecret(ctx context.Context, realm, clientUUID string) (*CredentialRepresentation, *Response, error)
+	// InvalidateRotatedClientSecret invalidates the previous secret kept by the client secret rotation policy.
+	InvalidateRotatedClientSecret(ctx context.Context, realm, clientUUID string) (*Response, error)
 	// GetClientSessions returns active user sessions for a client.
 	GetClientSessions(
 		ctx context.Context, realm, clientUUID string, params *GetClientSessionsParams,
@@ -329,6 +334,28 @@ type ClientsClient interface {
 	UpdateClientManagementPermissions(
 		ctx context.Context, realm, clientUUID string, permissions ManagementPermissionReference,
 	) (*ManagementPermissionReference, *Response, error)
+	// GetClientScopeMappings returns the realm and client roles in the scope of a client.
+	GetClientScopeMappings(ctx context.Context, realm, clientUUID string) (*MappingsRepresentation, *Response, error)
+	// AddClientRealmScopeMappings adds realm roles to the scope of a client.
+	AddClientRealmScopeMappings(
+		ctx context.Context, realm, clientUUID string, roles []RoleRepresentation,
+	) (*Response, error)
+	// DeleteClientRealmScopeMappings removes realm roles from the scope of a client.
+	DeleteClientRealmScopeMappings(
+		ctx context.Context, realm, clientUUID string, roles []RoleRepresentation,
+	) (*Response, error)
+	// AddClientRoleScopeMappings adds roles of another client to the scope of a client;
+	// roleClientUUID is the Keycloak UUID of the client that owns the roles.
+	AddClientRoleScopeMappings(
+		ctx context.Context, realm, clientUUID, roleClientUUID string, roles []RoleRepresentation,
+	) (*Response, error)
+	// DeleteClientRoleScopeMappings removes roles of another client from the scope of a client;
+	// roleClientUUID is the Keycloak UUID of the client that owns the roles.
+	DeleteClientRoleScopeMappings(
+		ctx context.Context, realm, clientUUID, roleClientUUID string, roles []RoleRepresentation,
+	) (*Response, error)
+	// ConvertClientDescription converts a client description, for example, SAML SP metadata, to a client representation.
+	ConvertClientDescription(ctx context.Context, realm, description string) (*ClientRepresentation, *Response, error)
 	// GetClientInstallationProvider returns the installation configuration for a client
 	// using the specified provider (e.g., "keycloak-oidc-keycloak-json", "saml-idp-descriptor").
 	GetClientInstallationProvider(
@@ -436,6 +463,28 @@ type AuthorizationClient interface {
 	) (*Response, error)
 	// DeletePermission deletes an authorization permission by ID.
 	DeletePermission(ctx context.Context, realm, clientUUID, permID string) (*Response, error)
+	// Policy associations
+	// GetPolicyAssociatedPolicies returns policies associated with an aggregate policy or a permission.
+	GetPolicyAssociatedPolicies(
+		ctx context.Context, realm, clientUUID, policyID string,
+	) ([]PolicyRepresentation, *Response, error)
+	// GetPolicyResources returns resources of a permission.
+	GetPolicyResources(
+		ctx context.Context, realm, clientUUID, policyID string,
+	) ([]ResourceRepresentation, *Response, error)
+	// GetPolicyScopes returns scopes of a permission.
+	GetPolicyScopes(ctx context.Context, realm, clientUUID, policyID string) ([]ScopeRepresentation, *Response, error)
+	// Resource server
+	// ImportResourceServer imports the authorization settings exported from a resource server.
+	// Existing scopes, resources, policies and permissions are updated by name, others are created.
+	ImportResourceServer(
+		ctx context.Context, realm, clientUUID string, resourceServer ResourceServerRepresentation,
+	) (*Response, error)
+	// Evaluation
+	// EvaluatePolicies evaluates the permissions of the resource server for the identity and resources in the request.
+	EvaluatePolicies(
+		ctx context.Context, realm, clientUUID string, request PolicyEvaluationRequest,
+	) (*PolicyEvaluationResponse, *Response, error)
 }
 
 // ServerInfoClient provides access to Keycloak server metadata and feature flags.
@@ -465,6 +514,29 @@ type RealmComponentsClient interface {
 	DeleteComponent(ctx context.Context, realm, componentID string) (*Response, error)
 }
 
+// ClientInitialAccessClient defines operations for managing initial access tokens
+// used for dynamic client registration.
+type ClientInitialAccessClient interface {
+	// GetInitialAccessTokens returns initial access tokens of a realm. Token values are not included.
+	GetInitialAccessTokens(ctx context.Context, realm string) ([]ClientInitialAccessPresentation, *Response, error)
+	// CreateInitialAccessToken creates a new initial access token. The token value is returned only on creation.
+	CreateInitialAccessToken(
+		ctx context.Context, realm string, token ClientInitialAccessCreatePresentation,
+	) (*ClientInitialAccessPresentation, *Response, error)
+	// DeleteInitialAccessToken deletes an initial access token by its ID.
+	DeleteInitialAccessToken(ctx context.Context, realm, tokenID string) (*Response, error)
+}
+
+// UserStorageClient defines operations on user federation providers
+// (e.g., LDAP) that are not covered by the realm components API.
+type UserStorageClient interface {
+	// SyncUsers triggers synchronization of users from the federation provider.
+	// Action is either UserStorageSyncFull or UserStorageSyncChanged.
+	SyncUsers(ctx context.Context, realm, componentID, action string) (*SynchronizationResult, *Response, error)
+	// TestLDAPConnection checks connection or authentication against an LDAP server.
+	TestLDAPConnection(ctx context.Context, realm string, test TestLdapConnectionRepresentation) (*Response, error)
+}
+
 // OrganizationsClient defines operations for managing Keycloak organizations
 // including CRUD, identity provider linking, and member management.
 type OrganizationsClient interface {
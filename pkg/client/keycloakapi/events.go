@@ -7,10 +7,11 @@ import (
 )
 
 type (
-	EventRepresentation      = generated.EventRepresentation
-	AdminEventRepresentation = generated.AdminEventRepresentation
-	GetEventsParams          = generated.GetAdminRealmsRealmEventsParams
-	GetAdminEventsParams     = generated.GetAdminRealmsRealmAdminEventsParams
+	EventRepresentation       = generated.EventRepresentation
+	AdminEventRepresentation  = generated.AdminEventRepresentation
+	AuthDetailsRepresentation = generated.AuthDetailsRepresentation
+	GetEventsParams           = generated.GetAdminRealmsRealmEventsParams
+	GetAdminEventsParams      = generated.GetAdminRealmsRealmAdminEventsParams
 )
 
 // EventsClient defines operations for querying and managing Keycloak realm events
@@ -56,6 +56,8 @@ type KeycloakClient struct {
 	Events              EventsClient
 	Sessions            SessionsClient
 	ClientPolicies      ClientPoliciesClient
+	UserStorage         UserStorageClient
+	ClientInitialAccess ClientInitialAccessClient
 }
 
 type ClientCredentials struct {
@@ -380,6 +382,8 @@ func NewKeycloakClient(ctx context.Context, baseURL, clientId string, opts ...Cl
 	keycloakClient.Events = &eventsClient{client: generatedClient}
 	keycloakClient.Sessions = &sessionsClient{client: generatedClient}
 	keycloakClient.ClientPolicies = &clientPoliciesClient{client: generatedClient}
+	keycloakClient.UserStorage = &userStorageClient{kc: keycloakClient}
+	keycloakClient.ClientInitialAccess = &clientInitialAccessClient{client: generatedClient}
 
 	return keycloakClient, nil
 }
@@ -506,6 +510,40 @@ func (keycloakClient *KeycloakClient) Refresh(ctx context.Context) error {
 	return nil
 }
 
+// AuthURL returns the base URL of Keycloak used for authentication, for example, https://keycloak.example.com.
+func (keycloakClient *KeycloakClient) AuthURL() string {
+	return keycloakClient.authUrl
+}
+
+// TokenSubject returns the subject of the current access token, that is, the ID of the user
+// or the service account user the client is authenticated as.
+// It returns an empty string if the client hasn't logged in yet or the token isn't a JWT.
+func (keycloakClient *KeycloakClient) TokenSubject() string {
+	if keycloakClient.clientCredentials == nil {
+		return ""
+	}
+
+	keycloakClient.mu.Lock()
+	accessToken := keycloakClient.clientCredentials.AccessToken
+	keycloakClient.mu.Unlock()
+
+	if accessToken == "" {
+		return ""
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, claims); err != nil {
+		return ""
+	}
+
+	sub, err := claims.GetSubject()
+	if err != nil {
+		return ""
+	}
+
+	return sub
+}
+
 func (keycloakClient *KeycloakClient) getAuthenticationFormData(
 	ctx context.Context,
 ) (url.Values, error) {
@@ -2477,3 +2477,31 @@ func createTestClientCert(t *testing.T, caCert *x509.Certificate, caPrivateKey *
 
 	return string(clientPEM), string(clientPrivKeyPEM)
 }
+
+func TestKeycloakClient_TokenSubject(t *testing.T) {
+	t.Parallel()
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "operator-user-id"}).
+		SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{name: "jwt access token", token: signed, want: "operator-user-id"},
+		{name: "opaque access token", token: "opaque-token", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewKeycloakClient(context.Background(), "http://localhost", testClientID, WithAccessToken(tt.token))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, client.TokenSubject())
+		})
+	}
+}
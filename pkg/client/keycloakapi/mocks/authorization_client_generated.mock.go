@@ -714,6 +714,94 @@ func (_c *MockAuthorizationClient_DeleteScope_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// EvaluatePolicies provides a mock function for the type MockAuthorizationClient
+func (_mock *MockAuthorizationClient) EvaluatePolicies(ctx context.Context, realm string, clientUUID string, request keycloakapi.PolicyEvaluationRequest) (*keycloakapi.PolicyEvaluationResponse, *keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluatePolicies")
+	}
+
+	var r0 *keycloakapi.PolicyEvaluationResponse
+	var r1 *keycloakapi.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, keycloakapi.PolicyEvaluationRequest) (*keycloakapi.PolicyEvaluationResponse, *keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, keycloakapi.PolicyEvaluationRequest) *keycloakapi.PolicyEvaluationResponse); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.PolicyEvaluationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, keycloakapi.PolicyEvaluationRequest) *keycloakapi.Response); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, keycloakapi.PolicyEvaluationRequest) error); ok {
+		r2 = returnFunc(ctx, realm, clientUUID, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAuthorizationClient_EvaluatePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluatePolicies'
+type MockAuthorizationClient_EvaluatePolicies_Call struct {
+	*mock.Call
+}
+
+// EvaluatePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - request keycloakapi.PolicyEvaluationRequest
+func (_e *MockAuthorizationClient_Expecter) EvaluatePolicies(ctx interface{}, realm interface{}, clientUUID interface{}, request interface{}) *MockAuthorizationClient_EvaluatePolicies_Call {
+	return &MockAuthorizationClient_EvaluatePolicies_Call{Call: _e.mock.On("EvaluatePolicies", ctx, realm, clientUUID, request)}
+}
+
+func (_c *MockAuthorizationClient_EvaluatePolicies_Call) Run(run func(ctx context.Context, realm string, clientUUID string, request keycloakapi.PolicyEvaluationRequest)) *MockAuthorizationClient_EvaluatePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 keycloakapi.PolicyEvaluationRequest
+		if args[3] != nil {
+			arg3 = args[3].(keycloakapi.PolicyEvaluationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationClient_EvaluatePolicies_Call) Return(policyEvaluationResponse *keycloakapi.PolicyEvaluationResponse, response *keycloakapi.Response, err error) *MockAuthorizationClient_EvaluatePolicies_Call {
+	_c.Call.Return(policyEvaluationResponse, response, err)
+	return _c
+}
+
+func (_c *MockAuthorizationClient_EvaluatePolicies_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, request keycloakapi.PolicyEvaluationRequest) (*keycloakapi.PolicyEvaluationResponse, *keycloakapi.Response, error)) *MockAuthorizationClient_EvaluatePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPermissions provides a mock function for the type MockAuthorizationClient
 func (_mock *MockAuthorizationClient) GetPermissions(ctx context.Context, realm string, clientUUID string) ([]keycloakapi.AbstractPolicyRepresentation, *keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID)
@@ -964,6 +1052,270 @@ func (_c *MockAuthorizationClient_GetPolicy_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetPolicyAssociatedPolicies provides a mock function for the type MockAuthorizationClient
+func (_mock *MockAuthorizationClient) GetPolicyAssociatedPolicies(ctx context.Context, realm string, clientUUID string, policyID string) ([]keycloakapi.PolicyRepresentation, *keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, policyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyAssociatedPolicies")
+	}
+
+	var r0 []keycloakapi.PolicyRepresentation
+	var r1 *keycloakapi.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]keycloakapi.PolicyRepresentation, *keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, policyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []keycloakapi.PolicyRepresentation); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keycloakapi.PolicyRepresentation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) *keycloakapi.Response); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
+		r2 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAuthorizationClient_GetPolicyAssociatedPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicyAssociatedPolicies'
+type MockAuthorizationClient_GetPolicyAssociatedPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicyAssociatedPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - policyID string
+func (_e *MockAuthorizationClient_Expecter) GetPolicyAssociatedPolicies(ctx interface{}, realm interface{}, clientUUID interface{}, policyID interface{}) *MockAuthorizationClient_GetPolicyAssociatedPolicies_Call {
+	return &MockAuthorizationClient_GetPolicyAssociatedPolicies_Call{Call: _e.mock.On("GetPolicyAssociatedPolicies", ctx, realm, clientUUID, policyID)}
+}
+
+func (_c *MockAuthorizationClient_GetPolicyAssociatedPolicies_Call) Run(run func(ctx context.Context, realm string, clientUUID string, policyID string)) *MockAuthorizationClient_GetPolicyAssociatedPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationClient_GetPolicyAssociatedPolicies_Call) Return(vs []keycloakapi.PolicyRepresentation, response *keycloakapi.Response, err error) *MockAuthorizationClient_GetPolicyAssociatedPolicies_Call {
+	_c.Call.Return(vs, response, err)
+	return _c
+}
+
+func (_c *MockAuthorizationClient_GetPolicyAssociatedPolicies_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, policyID string) ([]keycloakapi.PolicyRepresentation, *keycloakapi.Response, error)) *MockAuthorizationClient_GetPolicyAssociatedPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicyResources provides a mock function for the type MockAuthorizationClient
+func (_mock *MockAuthorizationClient) GetPolicyResources(ctx context.Context, realm string, clientUUID string, policyID string) ([]keycloakapi.ResourceRepresentation, *keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, policyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyResources")
+	}
+
+	var r0 []keycloakapi.ResourceRepresentation
+	var r1 *keycloakapi.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]keycloakapi.ResourceRepresentation, *keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, policyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []keycloakapi.ResourceRepresentation); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keycloakapi.ResourceRepresentation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) *keycloakapi.Response); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
+		r2 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAuthorizationClient_GetPolicyResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicyResources'
+type MockAuthorizationClient_GetPolicyResources_Call struct {
+	*mock.Call
+}
+
+// GetPolicyResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - policyID string
+func (_e *MockAuthorizationClient_Expecter) GetPolicyResources(ctx interface{}, realm interface{}, clientUUID interface{}, policyID interface{}) *MockAuthorizationClient_GetPolicyResources_Call {
+	return &MockAuthorizationClient_GetPolicyResources_Call{Call: _e.mock.On("GetPolicyResources", ctx, realm, clientUUID, policyID)}
+}
+
+func (_c *MockAuthorizationClient_GetPolicyResources_Call) Run(run func(ctx context.Context, realm string, clientUUID string, policyID string)) *MockAuthorizationClient_GetPolicyResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationClient_GetPolicyResources_Call) Return(vs []keycloakapi.ResourceRepresentation, response *keycloakapi.Response, err error) *MockAuthorizationClient_GetPolicyResources_Call {
+	_c.Call.Return(vs, response, err)
+	return _c
+}
+
+func (_c *MockAuthorizationClient_GetPolicyResources_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, policyID string) ([]keycloakapi.ResourceRepresentation, *keycloakapi.Response, error)) *MockAuthorizationClient_GetPolicyResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicyScopes provides a mock function for the type MockAuthorizationClient
+func (_mock *MockAuthorizationClient) GetPolicyScopes(ctx context.Context, realm string, clientUUID string, policyID string) ([]keycloakapi.ScopeRepresentation, *keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, policyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyScopes")
+	}
+
+	var r0 []keycloakapi.ScopeRepresentation
+	var r1 *keycloakapi.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]keycloakapi.ScopeRepresentation, *keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, policyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []keycloakapi.ScopeRepresentation); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keycloakapi.ScopeRepresentation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) *keycloakapi.Response); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
+		r2 = returnFunc(ctx, realm, clientUUID, policyID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAuthorizationClient_GetPolicyScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicyScopes'
+type MockAuthorizationClient_GetPolicyScopes_Call struct {
+	*mock.Call
+}
+
+// GetPolicyScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - policyID string
+func (_e *MockAuthorizationClient_Expecter) GetPolicyScopes(ctx interface{}, realm interface{}, clientUUID interface{}, policyID interface{}) *MockAuthorizationClient_GetPolicyScopes_Call {
+	return &MockAuthorizationClient_GetPolicyScopes_Call{Call: _e.mock.On("GetPolicyScopes", ctx, realm, clientUUID, policyID)}
+}
+
+func (_c *MockAuthorizationClient_GetPolicyScopes_Call) Run(run func(ctx context.Context, realm string, clientUUID string, policyID string)) *MockAuthorizationClient_GetPolicyScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationClient_GetPolicyScopes_Call) Return(vs []keycloakapi.ScopeRepresentation, response *keycloakapi.Response, err error) *MockAuthorizationClient_GetPolicyScopes_Call {
+	_c.Call.Return(vs, response, err)
+	return _c
+}
+
+func (_c *MockAuthorizationClient_GetPolicyScopes_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, policyID string) ([]keycloakapi.ScopeRepresentation, *keycloakapi.Response, error)) *MockAuthorizationClient_GetPolicyScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResource provides a mock function for the type MockAuthorizationClient
 func (_mock *MockAuthorizationClient) GetResource(ctx context.Context, realm string, clientUUID string, resourceID string) (*keycloakapi.ResourceRepresentation, *keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID, resourceID)
@@ -1304,6 +1656,86 @@ func (_c *MockAuthorizationClient_GetScopes_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// ImportResourceServer provides a mock function for the type MockAuthorizationClient
+func (_mock *MockAuthorizationClient) ImportResourceServer(ctx context.Context, realm string, clientUUID string, resourceServer keycloakapi.ResourceServerRepresentation) (*keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, resourceServer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportResourceServer")
+	}
+
+	var r0 *keycloakapi.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, keycloakapi.ResourceServerRepresentation) (*keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, resourceServer)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, keycloakapi.ResourceServerRepresentation) *keycloakapi.Response); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, resourceServer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, keycloakapi.ResourceServerRepresentation) error); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, resourceServer)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthorizationClient_ImportResourceServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportResourceServer'
+type MockAuthorizationClient_ImportResourceServer_Call struct {
+	*mock.Call
+}
+
+// ImportResourceServer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - resourceServer keycloakapi.ResourceServerRepresentation
+func (_e *MockAuthorizationClient_Expecter) ImportResourceServer(ctx interface{}, realm interface{}, clientUUID interface{}, resourceServer interface{}) *MockAuthorizationClient_ImportResourceServer_Call {
+	return &MockAuthorizationClient_ImportResourceServer_Call{Call: _e.mock.On("ImportResourceServer", ctx, realm, clientUUID, resourceServer)}
+}
+
+func (_c *MockAuthorizationClient_ImportResourceServer_Call) Run(run func(ctx context.Context, realm string, clientUUID string, resourceServer keycloakapi.ResourceServerRepresentation)) *MockAuthorizationClient_ImportResourceServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 keycloakapi.ResourceServerRepresentation
+		if args[3] != nil {
+			arg3 = args[3].(keycloakapi.ResourceServerRepresentation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthorizationClient_ImportResourceServer_Call) Return(response *keycloakapi.Response, err error) *MockAuthorizationClient_ImportResourceServer_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockAuthorizationClient_ImportResourceServer_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, resourceServer keycloakapi.ResourceServerRepresentation) (*keycloakapi.Response, error)) *MockAuthorizationClient_ImportResourceServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePermission provides a mock function for the type MockAuthorizationClient
 func (_mock *MockAuthorizationClient) UpdatePermission(ctx context.Context, realm string, clientUUID string, permType string, permID string, perm keycloakapi.PolicyRepresentation) (*keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID, permType, permID, perm)
@@ -38,6 +38,86 @@ func (_m *MockClientsClient) EXPECT() *MockClientsClient_Expecter {
 	return &MockClientsClient_Expecter{mock: &_m.Mock}
 }
 
+// AddClientRealmScopeMappings provides a mock function for the type MockClientsClient
+func (_mock *MockClientsClient) AddClientRealmScopeMappings(ctx context.Context, realm string, clientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddClientRealmScopeMappings")
+	}
+
+	var r0 *keycloakapi.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []keycloakapi.RoleRepresentation) *keycloakapi.Response); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []keycloakapi.RoleRepresentation) error); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientsClient_AddClientRealmScopeMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddClientRealmScopeMappings'
+type MockClientsClient_AddClientRealmScopeMappings_Call struct {
+	*mock.Call
+}
+
+// AddClientRealmScopeMappings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - roles []keycloakapi.RoleRepresentation
+func (_e *MockClientsClient_Expecter) AddClientRealmScopeMappings(ctx interface{}, realm interface{}, clientUUID interface{}, roles interface{}) *MockClientsClient_AddClientRealmScopeMappings_Call {
+	return &MockClientsClient_AddClientRealmScopeMappings_Call{Call: _e.mock.On("AddClientRealmScopeMappings", ctx, realm, clientUUID, roles)}
+}
+
+func (_c *MockClientsClient_AddClientRealmScopeMappings_Call) Run(run func(ctx context.Context, realm string, clientUUID string, roles []keycloakapi.RoleRepresentation)) *MockClientsClient_AddClientRealmScopeMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []keycloakapi.RoleRepresentation
+		if args[3] != nil {
+			arg3 = args[3].([]keycloakapi.RoleRepresentation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientsClient_AddClientRealmScopeMappings_Call) Return(response *keycloakapi.Response, err error) *MockClientsClient_AddClientRealmScopeMappings_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockClientsClient_AddClientRealmScopeMappings_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)) *MockClientsClient_AddClientRealmScopeMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddClientRoleComposites provides a mock function for the type MockClientsClient
 func (_mock *MockClientsClient) AddClientRoleComposites(ctx context.Context, realm string, clientUUID string, roleName string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID, roleName, roles)
@@ -124,6 +204,92 @@ func (_c *MockClientsClient_AddClientRoleComposites_Call) RunAndReturn(run func(
 	return _c
 }
 
+// AddClientRoleScopeMappings provides a mock function for the type MockClientsClient
+func (_mock *MockClientsClient) AddClientRoleScopeMappings(ctx context.Context, realm string, clientUUID string, roleClientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, roleClientUUID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddClientRoleScopeMappings")
+	}
+
+	var r0 *keycloakapi.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, roleClientUUID, roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []keycloakapi.RoleRepresentation) *keycloakapi.Response); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, roleClientUUID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, []keycloakapi.RoleRepresentation) error); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, roleClientUUID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientsClient_AddClientRoleScopeMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddClientRoleScopeMappings'
+type MockClientsClient_AddClientRoleScopeMappings_Call struct {
+	*mock.Call
+}
+
+// AddClientRoleScopeMappings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - roleClientUUID string
+//   - roles []keycloakapi.RoleRepresentation
+func (_e *MockClientsClient_Expecter) AddClientRoleScopeMappings(ctx interface{}, realm interface{}, clientUUID interface{}, roleClientUUID interface{}, roles interface{}) *MockClientsClient_AddClientRoleScopeMappings_Call {
+	return &MockClientsClient_AddClientRoleScopeMappings_Call{Call: _e.mock.On("AddClientRoleScopeMappings", ctx, realm, clientUUID, roleClientUUID, roles)}
+}
+
+func (_c *MockClientsClient_AddClientRoleScopeMappings_Call) Run(run func(ctx context.Context, realm string, clientUUID string, roleClientUUID string, roles []keycloakapi.RoleRepresentation)) *MockClientsClient_AddClientRoleScopeMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []keycloakapi.RoleRepresentation
+		if args[4] != nil {
+			arg4 = args[4].([]keycloakapi.RoleRepresentation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientsClient_AddClientRoleScopeMappings_Call) Return(response *keycloakapi.Response, err error) *MockClientsClient_AddClientRoleScopeMappings_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockClientsClient_AddClientRoleScopeMappings_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, roleClientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)) *MockClientsClient_AddClientRoleScopeMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddDefaultClientScope provides a mock function for the type MockClientsClient
 func (_mock *MockClientsClient) AddDefaultClientScope(ctx context.Context, realm string, clientUUID string, scopeID string) (*keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID, scopeID)
@@ -284,6 +450,88 @@ func (_c *MockClientsClient_AddOptionalClientScope_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ConvertClientDescription provides a mock function for the type MockClientsClient
+func (_mock *MockClientsClient) ConvertClientDescription(ctx context.Context, realm string, description string) (*keycloakapi.ClientRepresentation, *keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertClientDescription")
+	}
+
+	var r0 *keycloakapi.ClientRepresentation
+	var r1 *keycloakapi.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*keycloakapi.ClientRepresentation, *keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, description)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *keycloakapi.ClientRepresentation); ok {
+		r0 = returnFunc(ctx, realm, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.ClientRepresentation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *keycloakapi.Response); ok {
+		r1 = returnFunc(ctx, realm, description)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, realm, description)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockClientsClient_ConvertClientDescription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertClientDescription'
+type MockClientsClient_ConvertClientDescription_Call struct {
+	*mock.Call
+}
+
+// ConvertClientDescription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - description string
+func (_e *MockClientsClient_Expecter) ConvertClientDescription(ctx interface{}, realm interface{}, description interface{}) *MockClientsClient_ConvertClientDescription_Call {
+	return &MockClientsClient_ConvertClientDescription_Call{Call: _e.mock.On("ConvertClientDescription", ctx, realm, description)}
+}
+
+func (_c *MockClientsClient_ConvertClientDescription_Call) Run(run func(ctx context.Context, realm string, description string)) *MockClientsClient_ConvertClientDescription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientsClient_ConvertClientDescription_Call) Return(clientRepresentation *keycloakapi.ClientRepresentation, response *keycloakapi.Response, err error) *MockClientsClient_ConvertClientDescription_Call {
+	_c.Call.Return(clientRepresentation, response, err)
+	return _c
+}
+
+func (_c *MockClientsClient_ConvertClientDescription_Call) RunAndReturn(run func(ctx context.Context, realm string, description string) (*keycloakapi.ClientRepresentation, *keycloakapi.Response, error)) *MockClientsClient_ConvertClientDescription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateClient provides a mock function for the type MockClientsClient
 func (_mock *MockClientsClient) CreateClient(ctx context.Context, realm string, client keycloakapi.ClientRepresentation) (*keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, client)
@@ -672,6 +920,86 @@ func (_c *MockClientsClient_DeleteClientProtocolMapper_Call) RunAndReturn(run fu
 	return _c
 }
 
+// DeleteClientRealmScopeMappings provides a mock function for the type MockClientsClient
+func (_mock *MockClientsClient) DeleteClientRealmScopeMappings(ctx context.Context, realm string, clientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClientRealmScopeMappings")
+	}
+
+	var r0 *keycloakapi.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []keycloakapi.RoleRepresentation) *keycloakapi.Response); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []keycloakapi.RoleRepresentation) error); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientsClient_DeleteClientRealmScopeMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClientRealmScopeMappings'
+type MockClientsClient_DeleteClientRealmScopeMappings_Call struct {
+	*mock.Call
+}
+
+// DeleteClientRealmScopeMappings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - roles []keycloakapi.RoleRepresentation
+func (_e *MockClientsClient_Expecter) DeleteClientRealmScopeMappings(ctx interface{}, realm interface{}, clientUUID interface{}, roles interface{}) *MockClientsClient_DeleteClientRealmScopeMappings_Call {
+	return &MockClientsClient_DeleteClientRealmScopeMappings_Call{Call: _e.mock.On("DeleteClientRealmScopeMappings", ctx, realm, clientUUID, roles)}
+}
+
+func (_c *MockClientsClient_DeleteClientRealmScopeMappings_Call) Run(run func(ctx context.Context, realm string, clientUUID string, roles []keycloakapi.RoleRepresentation)) *MockClientsClient_DeleteClientRealmScopeMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []keycloakapi.RoleRepresentation
+		if args[3] != nil {
+			arg3 = args[3].([]keycloakapi.RoleRepresentation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientsClient_DeleteClientRealmScopeMappings_Call) Return(response *keycloakapi.Response, err error) *MockClientsClient_DeleteClientRealmScopeMappings_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockClientsClient_DeleteClientRealmScopeMappings_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)) *MockClientsClient_DeleteClientRealmScopeMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteClientRole provides a mock function for the type MockClientsClient
 func (_mock *MockClientsClient) DeleteClientRole(ctx context.Context, realm string, clientID string, roleName string) (*keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientID, roleName)
@@ -838,6 +1166,92 @@ func (_c *MockClientsClient_DeleteClientRoleComposites_Call) RunAndReturn(run fu
 	return _c
 }
 
+// DeleteClientRoleScopeMappings provides a mock function for the type MockClientsClient
+func (_mock *MockClientsClient) DeleteClientRoleScopeMappings(ctx context.Context, realm string, clientUUID string, roleClientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID, roleClientUUID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClientRoleScopeMappings")
+	}
+
+	var r0 *keycloakapi.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID, roleClientUUID, roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []keycloakapi.RoleRepresentation) *keycloakapi.Response); ok {
+		r0 = returnFunc(ctx, realm, clientUUID, roleClientUUID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, []keycloakapi.RoleRepresentation) error); ok {
+		r1 = returnFunc(ctx, realm, clientUUID, roleClientUUID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientsClient_DeleteClientRoleScopeMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClientRoleScopeMappings'
+type MockClientsClient_DeleteClientRoleScopeMappings_Call struct {
+	*mock.Call
+}
+
+// DeleteClientRoleScopeMappings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+//   - roleClientUUID string
+//   - roles []keycloakapi.RoleRepresentation
+func (_e *MockClientsClient_Expecter) DeleteClientRoleScopeMappings(ctx interface{}, realm interface{}, clientUUID interface{}, roleClientUUID interface{}, roles interface{}) *MockClientsClient_DeleteClientRoleScopeMappings_Call {
+	return &MockClientsClient_DeleteClientRoleScopeMappings_Call{Call: _e.mock.On("DeleteClientRoleScopeMappings", ctx, realm, clientUUID, roleClientUUID, roles)}
+}
+
+func (_c *MockClientsClient_DeleteClientRoleScopeMappings_Call) Run(run func(ctx context.Context, realm string, clientUUID string, roleClientUUID string, roles []keycloakapi.RoleRepresentation)) *MockClientsClient_DeleteClientRoleScopeMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []keycloakapi.RoleRepresentation
+		if args[4] != nil {
+			arg4 = args[4].([]keycloakapi.RoleRepresentation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientsClient_DeleteClientRoleScopeMappings_Call) Return(response *keycloakapi.Response, err error) *MockClientsClient_DeleteClientRoleScopeMappings_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockClientsClient_DeleteClientRoleScopeMappings_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string, roleClientUUID string, roles []keycloakapi.RoleRepresentation) (*keycloakapi.Response, error)) *MockClientsClient_DeleteClientRoleScopeMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClient provides a mock function for the type MockClientsClient
 func (_mock *MockClientsClient) GetClient(ctx context.Context, realm string, clientUUID string) (*keycloakapi.ClientRepresentation, *keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID)
@@ -1518,6 +1932,88 @@ func (_c *MockClientsClient_GetClientRoles_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetClientScopeMappings provides a mock function for the type MockClientsClient
+func (_mock *MockClientsClient) GetClientScopeMappings(ctx context.Context, realm string, clientUUID string) (*keycloakapi.MappingsRepresentation, *keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientScopeMappings")
+	}
+
+	var r0 *keycloakapi.MappingsRepresentation
+	var r1 *keycloakapi.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*keycloakapi.MappingsRepresentation, *keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *keycloakapi.MappingsRepresentation); ok {
+		r0 = returnFunc(ctx, realm, clientUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.MappingsRepresentation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *keycloakapi.Response); ok {
+		r1 = returnFunc(ctx, realm, clientUUID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, realm, clientUUID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockClientsClient_GetClientScopeMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClientScopeMappings'
+type MockClientsClient_GetClientScopeMappings_Call struct {
+	*mock.Call
+}
+
+// GetClientScopeMappings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+func (_e *MockClientsClient_Expecter) GetClientScopeMappings(ctx interface{}, realm interface{}, clientUUID interface{}) *MockClientsClient_GetClientScopeMappings_Call {
+	return &MockClientsClient_GetClientScopeMappings_Call{Call: _e.mock.On("GetClientScopeMappings", ctx, realm, clientUUID)}
+}
+
+func (_c *MockClientsClient_GetClientScopeMappings_Call) Run(run func(ctx context.Context, realm string, clientUUID string)) *MockClientsClient_GetClientScopeMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientsClient_GetClientScopeMappings_Call) Return(mappingsRepresentation *keycloakapi.MappingsRepresentation, response *keycloakapi.Response, err error) *MockClientsClient_GetClientScopeMappings_Call {
+	_c.Call.Return(mappingsRepresentation, response, err)
+	return _c
+}
+
+func (_c *MockClientsClient_GetClientScopeMappings_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string) (*keycloakapi.MappingsRepresentation, *keycloakapi.Response, error)) *MockClientsClient_GetClientScopeMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClientSecret provides a mock function for the type MockClientsClient
 func (_mock *MockClientsClient) GetClientSecret(ctx context.Context, realm string, clientUUID string) (*keycloakapi.CredentialRepresentation, *keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID)
@@ -2164,6 +2660,80 @@ func (_c *MockClientsClient_GetServiceAccountUser_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// InvalidateRotatedClientSecret provides a mock function for the type MockClientsClient
+func (_mock *MockClientsClient) InvalidateRotatedClientSecret(ctx context.Context, realm string, clientUUID string) (*keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, clientUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateRotatedClientSecret")
+	}
+
+	var r0 *keycloakapi.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, clientUUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *keycloakapi.Response); ok {
+		r0 = returnFunc(ctx, realm, clientUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, realm, clientUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientsClient_InvalidateRotatedClientSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateRotatedClientSecret'
+type MockClientsClient_InvalidateRotatedClientSecret_Call struct {
+	*mock.Call
+}
+
+// InvalidateRotatedClientSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - clientUUID string
+func (_e *MockClientsClient_Expecter) InvalidateRotatedClientSecret(ctx interface{}, realm interface{}, clientUUID interface{}) *MockClientsClient_InvalidateRotatedClientSecret_Call {
+	return &MockClientsClient_InvalidateRotatedClientSecret_Call{Call: _e.mock.On("InvalidateRotatedClientSecret", ctx, realm, clientUUID)}
+}
+
+func (_c *MockClientsClient_InvalidateRotatedClientSecret_Call) Run(run func(ctx context.Context, realm string, clientUUID string)) *MockClientsClient_InvalidateRotatedClientSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientsClient_InvalidateRotatedClientSecret_Call) Return(response *keycloakapi.Response, err error) *MockClientsClient_InvalidateRotatedClientSecret_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockClientsClient_InvalidateRotatedClientSecret_Call) RunAndReturn(run func(ctx context.Context, realm string, clientUUID string) (*keycloakapi.Response, error)) *MockClientsClient_InvalidateRotatedClientSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegenerateClientSecret provides a mock function for the type MockClientsClient
 func (_mock *MockClientsClient) RegenerateClientSecret(ctx context.Context, realm string, clientUUID string) (*keycloakapi.CredentialRepresentation, *keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, clientUUID)
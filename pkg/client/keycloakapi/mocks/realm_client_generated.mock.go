@@ -554,6 +554,88 @@ func (_c *MockRealmClient_GetRealms_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// PartialExportRealm provides a mock function for the type MockRealmClient
+func (_mock *MockRealmClient) PartialExportRealm(ctx context.Context, realm string, params *keycloakapi.PartialExportRealmParams) (*keycloakapi.RealmRepresentation, *keycloakapi.Response, error) {
+	ret := _mock.Called(ctx, realm, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PartialExportRealm")
+	}
+
+	var r0 *keycloakapi.RealmRepresentation
+	var r1 *keycloakapi.Response
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *keycloakapi.PartialExportRealmParams) (*keycloakapi.RealmRepresentation, *keycloakapi.Response, error)); ok {
+		return returnFunc(ctx, realm, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *keycloakapi.PartialExportRealmParams) *keycloakapi.RealmRepresentation); ok {
+		r0 = returnFunc(ctx, realm, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keycloakapi.RealmRepresentation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *keycloakapi.PartialExportRealmParams) *keycloakapi.Response); ok {
+		r1 = returnFunc(ctx, realm, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*keycloakapi.Response)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, *keycloakapi.PartialExportRealmParams) error); ok {
+		r2 = returnFunc(ctx, realm, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRealmClient_PartialExportRealm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PartialExportRealm'
+type MockRealmClient_PartialExportRealm_Call struct {
+	*mock.Call
+}
+
+// PartialExportRealm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - realm string
+//   - params *keycloakapi.PartialExportRealmParams
+func (_e *MockRealmClient_Expecter) PartialExportRealm(ctx interface{}, realm interface{}, params interface{}) *MockRealmClient_PartialExportRealm_Call {
+	return &MockRealmClient_PartialExportRealm_Call{Call: _e.mock.On("PartialExportRealm", ctx, realm, params)}
+}
+
+func (_c *MockRealmClient_PartialExportRealm_Call) Run(run func(ctx context.Context, realm string, params *keycloakapi.PartialExportRealmParams)) *MockRealmClient_PartialExportRealm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *keycloakapi.PartialExportRealmParams
+		if args[2] != nil {
+			arg2 = args[2].(*keycloakapi.PartialExportRealmParams)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRealmClient_PartialExportRealm_Call) Return(v *keycloakapi.RealmRepresentation, response *keycloakapi.Response, err error) *MockRealmClient_PartialExportRealm_Call {
+	_c.Call.Return(v, response, err)
+	return _c
+}
+
+func (_c *MockRealmClient_PartialExportRealm_Call) RunAndReturn(run func(ctx context.Context, realm string, params *keycloakapi.PartialExportRealmParams) (*keycloakapi.RealmRepresentation, *keycloakapi.Response, error)) *MockRealmClient_PartialExportRealm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PostRealmLocalization provides a mock function for the type MockRealmClient
 func (_mock *MockRealmClient) PostRealmLocalization(ctx context.Context, realm string, locale string, texts map[string]string) (*keycloakapi.Response, error) {
 	ret := _mock.Called(ctx, realm, locale, texts)
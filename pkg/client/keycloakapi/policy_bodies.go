@@ -22,6 +22,18 @@ type ClientPolicyBody struct {
 	Clients []string `json:"clients"`
 }
 
+// ClientScopeDefinition represents a client scope reference inside a client scope policy.
+type ClientScopeDefinition struct {
+	ID       string `json:"id"`
+	Required bool   `json:"required"`
+}
+
+// ClientScopePolicyBody is the request body for a client scope policy.
+type ClientScopePolicyBody struct {
+	PolicyBodyBase
+	ClientScopes []ClientScopeDefinition `json:"clientScopes"`
+}
+
 // GroupDefinition represents a group reference inside a group policy.
 type GroupDefinition struct {
 	ID             string `json:"id"`
@@ -35,6 +47,20 @@ type GroupPolicyBody struct {
 	GroupsClaim string            `json:"groupsClaim,omitempty"`
 }
 
+// JSPolicyBody is the request body for a JavaScript policy deployed to Keycloak.
+// The script is identified by the policy type, so the body holds only the common fields.
+type JSPolicyBody struct {
+	PolicyBodyBase
+}
+
+// RegexPolicyBody is the request body for a regex policy.
+type RegexPolicyBody struct {
+	PolicyBodyBase
+	TargetClaim             string `json:"targetClaim"`
+	Pattern                 string `json:"pattern"`
+	TargetContextAttributes bool   `json:"targetContextAttributes"`
+}
+
 // RoleDefinition represents a role reference inside a role policy.
 type RoleDefinition struct {
 	ID       string `json:"id"`
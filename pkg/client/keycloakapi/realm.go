@@ -15,6 +15,7 @@ type RealmEventsConfigRepresentation = generated.RealmEventsConfigRepresentation
 type KeysMetadataRepresentation = generated.KeysMetadataRepresentation
 type GetRealmLocalizationParams = generated.GetAdminRealmsRealmLocalizationLocaleParams
 type BruteForceStrategy = generated.BruteForceStrategy
+type PartialExportRealmParams = generated.PostAdminRealmsRealmPartialExportParams
 
 // Values for BruteForceStrategy
 const (
@@ -203,6 +204,29 @@ func (c *realmClient) GetRealmKeys(
 	return res.JSON200, response, nil
 }
 
+func (c *realmClient) PartialExportRealm(
+	ctx context.Context,
+	realm string,
+	params *PartialExportRealmParams,
+) (*RealmRepresentation, *Response, error) {
+	res, err := c.client.PostAdminRealmsRealmPartialExportWithResponse(ctx, realm, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res == nil {
+		return nil, nil, ErrNilResponse
+	}
+
+	response := &Response{HTTPResponse: res.HTTPResponse, Body: res.Body}
+
+	if err := checkResponseError(res.HTTPResponse, res.Body); err != nil {
+		return nil, response, err
+	}
+
+	return res.JSON200, response, nil
+}
+
 func (c *realmClient) GetRealmLocalization(
 	ctx context.Context,
 	realm, locale string,
@@ -424,3 +424,59 @@ func TestRealmClient_PostRealmLocalization(t *testing.T) {
 	require.NotNil(t, got)
 	assert.Equal(t, "customTestValue", got["customTestKey"])
 }
+
+func TestRealmClient_PartialExportRealm(t *testing.T) {
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+	t.Parallel()
+
+	c, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	realmName := fmt.Sprintf("test-realm-export-%d", time.Now().UnixNano())
+
+	t.Cleanup(func() {
+		_, _ = c.Realms.DeleteRealm(context.Background(), realmName)
+	})
+
+	_, err = c.Realms.CreateRealm(ctx, keycloakapi.RealmRepresentation{
+		Realm:   &realmName,
+		Enabled: ptr.To(true),
+	})
+	require.NoError(t, err)
+
+	exported, resp, err := c.Realms.PartialExportRealm(ctx, realmName, &keycloakapi.PartialExportRealmParams{
+		ExportClients:        ptr.To(true),
+		ExportGroupsAndRoles: ptr.To(true),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.NotNil(t, exported)
+	require.NotNil(t, exported.Realm)
+	assert.Equal(t, realmName, *exported.Realm)
+	require.NotNil(t, exported.Clients)
+	assert.NotEmpty(t, *exported.Clients)
+}
+
+func TestRealmClient_PartialExportRealm_RealmNotFound(t *testing.T) {
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+	t.Parallel()
+
+	c, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	_, _, err = c.Realms.PartialExportRealm(context.Background(), "non-existent-realm-export", nil)
+	require.Error(t, err)
+	assert.True(t, keycloakapi.IsNotFound(err))
+}
@@ -0,0 +1,139 @@
+package keycloakapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// UserStorageSyncFull triggers synchronization of all users from the federation provider.
+	UserStorageSyncFull = "triggerFullSync"
+	// UserStorageSyncChanged triggers synchronization of users changed since the last sync.
+	UserStorageSyncChanged = "triggerChangedUsersSync"
+
+	// LDAPTestConnection checks that Keycloak can connect to the LDAP server.
+	LDAPTestConnection = "testConnection"
+	// LDAPTestAuthentication checks that Keycloak can bind to the LDAP server with the given credentials.
+	LDAPTestAuthentication = "testAuthentication"
+)
+
+// SynchronizationResult is the result of a user storage synchronization.
+// The generated client does not include the user-storage endpoints, so the type is declared here.
+type SynchronizationResult struct {
+	Ignored bool   `json:"ignored,omitempty"`
+	Added   int32  `json:"added,omitempty"`
+	Updated int32  `json:"updated,omitempty"`
+	Removed int32  `json:"removed,omitempty"`
+	Failed  int32  `json:"failed,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+// TestLdapConnectionRepresentation is the request body of the testLDAPConnection endpoint.
+type TestLdapConnectionRepresentation struct {
+	Action            string `json:"action"`
+	ConnectionUrl     string `json:"connectionUrl,omitempty"`
+	AuthType          string `json:"authType,omitempty"`
+	BindDn            string `json:"bindDn,omitempty"`
+	BindCredential    string `json:"bindCredential,omitempty"`
+	UseTruststoreSpi  string `json:"useTruststoreSpi,omitempty"`
+	ConnectionTimeout string `json:"connectionTimeout,omitempty"`
+	ComponentId       string `json:"componentId,omitempty"`
+	StartTls          string `json:"startTls,omitempty"`
+}
+
+type userStorageClient struct {
+	kc *KeycloakClient
+}
+
+var _ UserStorageClient = (*userStorageClient)(nil)
+
+func (c *userStorageClient) SyncUsers(
+	ctx context.Context,
+	realm, componentID, action string,
+) (*SynchronizationResult, *Response, error) {
+	reqURL := fmt.Sprintf(
+		"%s/admin/realms/%s/user-storage/%s/sync?action=%s",
+		c.kc.baseUrl, url.PathEscape(realm), url.PathEscape(componentID), url.QueryEscape(action),
+	)
+
+	respBody, response, err := c.doJSONRequest(ctx, http.MethodPost, reqURL, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	result := &SynchronizationResult{}
+
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return nil, response, fmt.Errorf("failed to unmarshal synchronization result: %w", err)
+		}
+	}
+
+	return result, response, nil
+}
+
+func (c *userStorageClient) TestLDAPConnection(
+	ctx context.Context,
+	realm string,
+	test TestLdapConnectionRepresentation,
+) (*Response, error) {
+	reqURL := fmt.Sprintf("%s/admin/realms/%s/testLDAPConnection", c.kc.baseUrl, url.PathEscape(realm))
+
+	_, response, err := c.doJSONRequest(ctx, http.MethodPost, reqURL, test)
+
+	return response, err
+}
+
+func (c *userStorageClient) doJSONRequest(
+	ctx context.Context,
+	method string,
+	reqURL string,
+	body any,
+) ([]byte, *Response, error) {
+	var bodyReader io.Reader
+
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := (&keycloakDoer{kc: c.kc}).Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	response := &Response{HTTPResponse: resp, Body: respBody}
+
+	if err := checkResponseError(resp, respBody); err != nil {
+		return nil, response, err
+	}
+
+	return respBody, response, nil
+}
@@ -0,0 +1,65 @@
+package keycloakapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/testutils"
+)
+
+func newUserStorageTestRealm(t *testing.T, suffix string) (*keycloakapi.KeycloakClient, string) {
+	t.Helper()
+
+	keycloakURL := testutils.GetKeycloakURLOrSkip(t)
+
+	c, err := keycloakapi.NewKeycloakClient(
+		context.Background(),
+		keycloakURL,
+		keycloakapi.DefaultAdminClientID,
+		keycloakapi.WithPasswordGrant(keycloakapi.DefaultAdminUsername, keycloakapi.DefaultAdminPassword),
+	)
+	require.NoError(t, err)
+
+	realmName := fmt.Sprintf("test-realm-us-%s-%d", suffix, time.Now().UnixNano())
+
+	t.Cleanup(func() {
+		_, _ = c.Realms.DeleteRealm(context.Background(), realmName)
+	})
+
+	_, err = c.Realms.CreateRealm(context.Background(), keycloakapi.RealmRepresentation{
+		Realm:   &realmName,
+		Enabled: ptr.To(true),
+	})
+	require.NoError(t, err)
+
+	return c, realmName
+}
+
+func TestUserStorageClient_TestLDAPConnection_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	c, realmName := newUserStorageTestRealm(t, "test-conn")
+
+	resp, err := c.UserStorage.TestLDAPConnection(context.Background(), realmName, keycloakapi.TestLdapConnectionRepresentation{
+		Action:            keycloakapi.LDAPTestConnection,
+		ConnectionUrl:     "ldap://127.0.0.1:1",
+		ConnectionTimeout: "1000",
+	})
+	require.Error(t, err)
+	require.NotNil(t, resp)
+}
+
+func TestUserStorageClient_SyncUsers_NotFound(t *testing.T) {
+	t.Parallel()
+
+	c, realmName := newUserStorageTestRealm(t, "sync")
+
+	_, _, err := c.UserStorage.SyncUsers(context.Background(), realmName, "non-existent-id", keycloakapi.UserStorageSyncFull)
+	require.Error(t, err)
+}
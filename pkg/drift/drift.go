@@ -0,0 +1,264 @@
+// Package drift compares the desired state of a Keycloak resource with the live one
+// and reports differences in a human-readable form.
+// It is also used to skip updates of Keycloak resources that already match the desired state.
+package drift
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxMessageLength limits the length of the diff message to keep it readable in conditions.
+const maxMessageLength = 4096
+
+// SecretMask is the value Keycloak returns instead of secrets, like identity provider client secrets.
+const SecretMask = "**********"
+
+// Diff returns a sorted list of human-readable differences between desired and live representations.
+// Only fields that are set in the desired representation are compared, so fields not managed by
+// the operator are ignored. Missing live values are treated as equal to zero desired values,
+// because Keycloak omits empty fields from responses.
+// Masked live values are treated as equal to desired values, as Keycloak doesn't return secrets.
+func Diff(desired, live any) ([]string, error) {
+	d, err := toGeneric(desired)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert desired state: %w", err)
+	}
+
+	l, err := toGeneric(live)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert live state: %w", err)
+	}
+
+	var diffs []string
+
+	compare("", d, l, &diffs)
+	sort.Strings(diffs)
+
+	return diffs, nil
+}
+
+// Normalize returns the normalized JSON form of the representation.
+// It is used to take a snapshot of the live representation before it is modified,
+// the snapshot can be compared with the modified representation with Equal.
+func Normalize(v any) (any, error) {
+	out, err := toGeneric(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return prune(out), nil
+}
+
+// Equal returns true if both representations are equal in their normalized JSON form.
+// Unset, null and empty values are equivalent, as Keycloak omits them from responses.
+// Unlike Diff, all fields of both representations are compared, including the order of slice elements.
+func Equal(desired, live any) (bool, error) {
+	d, err := Normalize(desired)
+	if err != nil {
+		return false, fmt.Errorf("unable to convert desired state: %w", err)
+	}
+
+	l, err := Normalize(live)
+	if err != nil {
+		return false, fmt.Errorf("unable to convert live state: %w", err)
+	}
+
+	return reflect.DeepEqual(d, l), nil
+}
+
+// Hash returns a hash of the normalized JSON form of the representation.
+// It is used to detect changes of values that Keycloak doesn't return, like secrets.
+func Hash(v any) (string, error) {
+	n, err := Normalize(v)
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := json.Marshal(n)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// prune removes null and empty values from the generic JSON value.
+func prune(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+
+		for key, item := range val {
+			if item = prune(item); !isEmpty(item) {
+				out[key] = item
+			}
+		}
+
+		return out
+	case []any:
+		out := make([]any, 0, len(val))
+
+		for _, item := range val {
+			out = append(out, prune(item))
+		}
+
+		return out
+	default:
+		return v
+	}
+}
+
+func isEmpty(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case map[string]any:
+		return len(val) == 0
+	case []any:
+		return len(val) == 0
+	default:
+		return false
+	}
+}
+
+// FormatMessage joins differences into a single message suitable for a condition.
+func FormatMessage(diffs []string) string {
+	msg := strings.Join(diffs, "; ")
+
+	if len(msg) > maxMessageLength {
+		msg = msg[:maxMessageLength] + "..."
+	}
+
+	return msg
+}
+
+func toGeneric(v any) (any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func compare(path string, desired, live any, diffs *[]string) {
+	switch d := desired.(type) {
+	case nil:
+		return
+	case map[string]any:
+		l, _ := live.(map[string]any)
+
+		for key, val := range d {
+			compare(joinPath(path, key), val, l[key], diffs)
+		}
+	case []any:
+		l, _ := live.([]any)
+
+		if !equalSlices(d, l) {
+			*diffs = append(*diffs, formatDiff(path, live, desired))
+		}
+	default:
+		if live == nil && isZero(desired) {
+			return
+		}
+
+		if !equalValues(desired, live) {
+			*diffs = append(*diffs, formatDiff(path, live, desired))
+		}
+	}
+}
+
+// equalSlices compares slices ignoring the order of elements,
+// Keycloak does not preserve the order of values like redirect URIs.
+func equalSlices(desired, live []any) bool {
+	if len(desired) != len(live) {
+		return false
+	}
+
+	used := make([]bool, len(live))
+
+	for _, d := range desired {
+		found := false
+
+		for i, l := range live {
+			if !used[i] && equalValues(d, l) {
+				used[i] = true
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalValues compares values, a masked live value is equal to any desired value.
+func equalValues(desired, live any) bool {
+	if live == SecretMask {
+		return true
+	}
+
+	return reflect.DeepEqual(desired, live)
+}
+
+func isZero(v any) bool {
+	switch val := v.(type) {
+	case string:
+		return val == ""
+	case bool:
+		return !val
+	case float64:
+		return val == 0
+	default:
+		return false
+	}
+}
+
+func joinPath(path, key string) string {
+	if strings.ContainsAny(key, ". ") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func formatDiff(path string, live, desired any) string {
+	return fmt.Sprintf("%s: %s -> %s", path, formatValue(live), formatValue(desired))
+}
+
+func formatValue(v any) string {
+	if v == nil {
+		return "<unset>"
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(raw)
+}
@@ -0,0 +1,199 @@
+package drift
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		desired any
+		live    any
+		want    []string
+	}{
+		{
+			name: "no drift",
+			desired: keycloakapi.ClientRepresentation{
+				ClientId:     ptr.To("app"),
+				Enabled:      ptr.To(true),
+				RedirectUris: &[]string{"https://a/*", "https://b/*"},
+			},
+			live: keycloakapi.ClientRepresentation{
+				Id:           ptr.To("uuid"),
+				ClientId:     ptr.To("app"),
+				Enabled:      ptr.To(true),
+				RedirectUris: &[]string{"https://b/*", "https://a/*"},
+			},
+			want: nil,
+		},
+		{
+			name: "changed fields",
+			desired: keycloakapi.ClientRepresentation{
+				ClientId:     ptr.To("app"),
+				Enabled:      ptr.To(true),
+				RootUrl:      ptr.To("https://new"),
+				RedirectUris: &[]string{"https://a/*"},
+			},
+			live: keycloakapi.ClientRepresentation{
+				ClientId:     ptr.To("app"),
+				Enabled:      ptr.To(false),
+				RootUrl:      ptr.To("https://old"),
+				RedirectUris: &[]string{"https://a/*", "https://c/*"},
+			},
+			want: []string{
+				`enabled: false -> true`,
+				`redirectUris: ["https://a/*","https://c/*"] -> ["https://a/*"]`,
+				`rootUrl: "https://old" -> "https://new"`,
+			},
+		},
+		{
+			name: "attributes compared by desired keys only",
+			desired: keycloakapi.ClientRepresentation{
+				Attributes: &map[string]string{"access.token.lifespan": "300", "post.logout.redirect.uris": "+"},
+			},
+			live: keycloakapi.ClientRepresentation{
+				Attributes: &map[string]string{"access.token.lifespan": "600", "other": "value"},
+			},
+			want: []string{
+				`attributes["access.token.lifespan"]: "600" -> "300"`,
+				`attributes["post.logout.redirect.uris"]: <unset> -> "+"`,
+			},
+		},
+		{
+			name: "zero desired value equals missing live value",
+			desired: keycloakapi.ClientRepresentation{
+				Description: ptr.To(""),
+				BearerOnly:  ptr.To(false),
+			},
+			live: keycloakapi.ClientRepresentation{},
+			want: nil,
+		},
+		{
+			name: "masked secrets are equal",
+			desired: keycloakapi.IdentityProviderRepresentation{
+				Config: &map[string]string{"clientId": "app", "clientSecret": "secret"},
+			},
+			live: keycloakapi.IdentityProviderRepresentation{
+				Config: &map[string]string{"clientId": "app", "clientSecret": SecretMask},
+			},
+			want: nil,
+		},
+		{
+			name: "masked multivalued secrets are equal",
+			desired: keycloakapi.ComponentRepresentation{
+				Config: &keycloakapi.MultivaluedHashMapStringString{"bindCredential": {"secret"}},
+			},
+			live: keycloakapi.ComponentRepresentation{
+				Config: &keycloakapi.MultivaluedHashMapStringString{"bindCredential": {SecretMask}},
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(tt.desired, tt.live)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		desired any
+		live    any
+		want    bool
+	}{
+		{
+			name: "empty values are equal to unset",
+			desired: keycloakapi.GroupRepresentation{
+				Name:        ptr.To("group"),
+				Description: ptr.To(""),
+				Attributes:  &map[string][]string{},
+			},
+			live: keycloakapi.GroupRepresentation{
+				Name: ptr.To("group"),
+			},
+			want: true,
+		},
+		{
+			name: "extra live attribute",
+			desired: keycloakapi.GroupRepresentation{
+				Name:       ptr.To("group"),
+				Attributes: &map[string][]string{"a": {"1"}},
+			},
+			live: keycloakapi.GroupRepresentation{
+				Name:       ptr.To("group"),
+				Attributes: &map[string][]string{"a": {"1"}, "b": {"2"}},
+			},
+			want: false,
+		},
+		{
+			name:    "false is not unset",
+			desired: keycloakapi.RealmRepresentation{Enabled: ptr.To(false)},
+			live:    keycloakapi.RealmRepresentation{},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Equal(tt.desired, tt.live)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	first, err := Hash(keycloakapi.IdentityProviderRepresentation{
+		Alias:  ptr.To("idp"),
+		Config: &map[string]string{"clientId": "app", "clientSecret": "secret"},
+	})
+	require.NoError(t, err)
+
+	same, err := Hash(keycloakapi.IdentityProviderRepresentation{
+		Alias:       ptr.To("idp"),
+		DisplayName: ptr.To(""),
+		Config:      &map[string]string{"clientSecret": "secret", "clientId": "app"},
+	})
+	require.NoError(t, err)
+
+	changed, err := Hash(keycloakapi.IdentityProviderRepresentation{
+		Alias:  ptr.To("idp"),
+		Config: &map[string]string{"clientId": "app", "clientSecret": "new-secret"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, first, same)
+	assert.NotEqual(t, first, changed)
+}
+
+func TestFormatMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "a: 1 -> 2; b: 1 -> 2", FormatMessage([]string{"a: 1 -> 2", "b: 1 -> 2"}))
+
+	long := FormatMessage([]string{strings.Repeat("x", maxMessageLength+10)})
+	assert.Len(t, long, maxMessageLength+3)
+	assert.True(t, strings.HasSuffix(long, "..."))
+}
@@ -1,11 +1,26 @@
 package objectmeta
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+)
 
 const PreserveResourcesOnDeletionAnnotation = "edp.epam.com/preserve-resources-on-deletion"
 
-// PreserveResourcesOnDeletion returns true if the object has annotation
-// that indicates that resources must not be deleted.
-func PreserveResourcesOnDeletion(object metav1.Object) bool {
-	return object.GetAnnotations()[PreserveResourcesOnDeletionAnnotation] == "true"
+// PreserveResourcesOnDeletion returns true if Keycloak resources must not be deleted together with the object.
+// Resources are preserved if the object has the preserve annotation
+// or its deletion policy, or the operator-wide default one, is Retain.
+func PreserveResourcesOnDeletion(object metav1.Object, defaultPolicy common.DeletionPolicy) bool {
+	if object.GetAnnotations()[PreserveResourcesOnDeletionAnnotation] == "true" {
+		return true
+	}
+
+	policy := defaultPolicy
+
+	if o, ok := object.(common.HasDeletionPolicy); ok && o.GetDeletionPolicy() != "" {
+		policy = o.GetDeletionPolicy()
+	}
+
+	return policy == common.DeletionPolicyRetain
 }
@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 )
 
 func TestPreserveResourcesOnDeletion(t *testing.T) {
@@ -55,8 +58,69 @@ func TestPreserveResourcesOnDeletion(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := PreserveResourcesOnDeletion(tt.object)
+			got := PreserveResourcesOnDeletion(tt.object, "")
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestPreserveResourcesOnDeletion_DeletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		object        v1.Object
+		defaultPolicy common.DeletionPolicy
+		want          bool
+	}{
+		{
+			name:          "should use default policy if object has no deletion policy",
+			object:        &keycloakApi.KeycloakRealm{},
+			defaultPolicy: common.DeletionPolicyRetain,
+			want:          true,
+		},
+		{
+			name: "should prefer object deletion policy over default one",
+			object: &keycloakApi.KeycloakRealm{
+				Spec: keycloakApi.KeycloakRealmSpec{DeletionPolicy: common.DeletionPolicyDelete},
+			},
+			defaultPolicy: common.DeletionPolicyRetain,
+			want:          false,
+		},
+		{
+			name: "should retain resources if object deletion policy is Retain",
+			object: &keycloakApi.KeycloakClient{
+				Spec: keycloakApi.KeycloakClientSpec{DeletionPolicy: common.DeletionPolicyRetain},
+			},
+			defaultPolicy: common.DeletionPolicyDelete,
+			want:          true,
+		},
+		{
+			name: "should preserve resources if annotation is set regardless of deletion policy",
+			object: &keycloakApi.KeycloakClient{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: map[string]string{
+						PreserveResourcesOnDeletionAnnotation: "true",
+					},
+				},
+				Spec: keycloakApi.KeycloakClientSpec{DeletionPolicy: common.DeletionPolicyDelete},
+			},
+			defaultPolicy: common.DeletionPolicyDelete,
+			want:          true,
+		},
+		{
+			name:          "should use default policy for objects without deletion policy field",
+			object:        &v1.ObjectMeta{},
+			defaultPolicy: common.DeletionPolicyRetain,
+			want:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, PreserveResourcesOnDeletion(tt.object, tt.defaultPolicy))
+		})
+	}
+}
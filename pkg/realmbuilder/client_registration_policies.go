@@ -0,0 +1,211 @@
+package realmbuilder
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+const (
+	// ClientRegistrationPolicyProviderType is a component provider type of client registration policies.
+	ClientRegistrationPolicyProviderType = "org.keycloak.services.clientregistration.policy.ClientRegistrationPolicy"
+
+	// ClientRegistrationPolicyAnonymous is a component subtype of anonymous client registration policies.
+	ClientRegistrationPolicyAnonymous = "anonymous"
+
+	// ClientRegistrationPolicyAuthenticated is a component subtype of authenticated client registration policies.
+	ClientRegistrationPolicyAuthenticated = "authenticated"
+)
+
+// SyncClientRegistrationPolicies creates, updates and removes client registration policies of the realm.
+// managed is a list of policies created by the operator before in the form of <subType>/<name>,
+// only these policies are removed if they are not in the spec.
+// Policies that existed in Keycloak before, for example, the default policies of the realm, are updated
+// but not added to the managed list, so they are kept when removed from the spec.
+// It returns the new list of managed policies.
+func SyncClientRegistrationPolicies(
+	ctx context.Context,
+	realmName string,
+	policies *common.ClientRegistrationPolicies,
+	managed []string,
+	components keycloakapi.RealmComponentsClient,
+) ([]string, error) {
+	if policies == nil && len(managed) == 0 {
+		return nil, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+
+	existing, _, err := components.GetComponents(ctx, realmName, &keycloakapi.GetComponentsParams{
+		Type: ptr.To(ClientRegistrationPolicyProviderType),
+	})
+	if err != nil {
+		return managed, fmt.Errorf("unable to get client registration policies: %w", err)
+	}
+
+	existingByKey := make(map[string]keycloakapi.ComponentRepresentation, len(existing))
+
+	for _, c := range existing {
+		if c.Name != nil && c.Id != nil {
+			existingByKey[policyKey(ptr.Deref(c.SubType, ""), *c.Name)] = c
+		}
+	}
+
+	desired := make(map[string]keycloakapi.ComponentRepresentation)
+
+	if policies != nil {
+		for subType, list := range map[string][]common.ClientRegistrationPolicy{
+			ClientRegistrationPolicyAnonymous:     policies.Anonymous,
+			ClientRegistrationPolicyAuthenticated: policies.Authenticated,
+		} {
+			for i := range list {
+				desired[policyKey(subType, list[i].Name)] = clientRegistrationPolicyComponent(subType, &list[i])
+			}
+		}
+	}
+
+	newManaged := make([]string, 0, len(desired))
+
+	for _, key := range slices.Sorted(maps.Keys(desired)) {
+		if err := putClientRegistrationPolicy(ctx, realmName, desired[key], existingByKey, components); err != nil {
+			return managed, err
+		}
+
+		if _, existed := existingByKey[key]; !existed || slices.Contains(managed, key) {
+			newManaged = append(newManaged, key)
+		}
+	}
+
+	for _, key := range managed {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+
+		current, ok := existingByKey[key]
+		if !ok {
+			continue
+		}
+
+		if _, err := components.DeleteComponent(ctx, realmName, *current.Id); err != nil && !keycloakapi.IsNotFound(err) {
+			return newManaged, fmt.Errorf("unable to delete client registration policy %s: %w", key, err)
+		}
+
+		log.Info("Client registration policy deleted", "policy", key)
+	}
+
+	if len(newManaged) == 0 {
+		return nil, nil
+	}
+
+	return newManaged, nil
+}
+
+func putClientRegistrationPolicy(
+	ctx context.Context,
+	realmName string,
+	policy keycloakapi.ComponentRepresentation,
+	existingByKey map[string]keycloakapi.ComponentRepresentation,
+	components keycloakapi.RealmComponentsClient,
+) error {
+	key := policyKey(*policy.SubType, *policy.Name)
+	log := ctrl.LoggerFrom(ctx).WithValues("policy", key)
+
+	current, ok := existingByKey[key]
+
+	// Keycloak doesn't allow changing the policy type, so the policy is recreated.
+	if ok && ptr.Deref(current.ProviderId, "") != *policy.ProviderId {
+		if _, err := components.DeleteComponent(ctx, realmName, *current.Id); err != nil && !keycloakapi.IsNotFound(err) {
+			return fmt.Errorf("unable to delete client registration policy %s: %w", key, err)
+		}
+
+		ok = false
+	}
+
+	if !ok {
+		if _, err := components.CreateComponent(ctx, realmName, policy); err != nil {
+			return fmt.Errorf("unable to create client registration policy %s: %w", key, err)
+		}
+
+		log.Info("Client registration policy created")
+
+		return nil
+	}
+
+	policy.Id = current.Id
+	policy.ParentId = current.ParentId
+
+	if _, err := components.UpdateComponent(ctx, realmName, *current.Id, policy); err != nil {
+		return fmt.Errorf("unable to update client registration policy %s: %w", key, err)
+	}
+
+	log.Info("Client registration policy updated")
+
+	return nil
+}
+
+// clientRegistrationPolicyComponent converts the typed policy spec to the Keycloak component.
+func clientRegistrationPolicyComponent(
+	subType string,
+	policy *common.ClientRegistrationPolicy,
+) keycloakapi.ComponentRepresentation {
+	config := keycloakapi.MultivaluedHashMapStringString{}
+	providerID := ""
+
+	switch {
+	case policy.TrustedHosts != nil:
+		p := policy.TrustedHosts
+		providerID = "trusted-hosts"
+		config["trusted-hosts"] = nonNilSlice(p.Hosts)
+		config["host-sending-registration-request-must-match"] = []string{
+			strconv.FormatBool(ptr.Deref(p.HostSendingRequestMustMatch, true)),
+		}
+		config["client-uris-must-match"] = []string{strconv.FormatBool(ptr.Deref(p.ClientURIsMustMatch, true))}
+	case policy.MaxClients != nil:
+		limit := policy.MaxClients.Limit
+		if limit == 0 {
+			limit = 200
+		}
+
+		providerID = "max-clients"
+		config["max-clients"] = []string{strconv.Itoa(int(limit))}
+	case policy.AllowedProtocolMappers != nil:
+		providerID = "allowed-protocol-mappers"
+		config["allowed-protocol-mapper-types"] = nonNilSlice(policy.AllowedProtocolMappers.MapperTypes)
+	case policy.AllowedClientScopes != nil:
+		p := policy.AllowedClientScopes
+		providerID = "allowed-client-templates"
+		config["allowed-client-scopes"] = nonNilSlice(p.Scopes)
+		config["allow-default-scopes"] = []string{strconv.FormatBool(ptr.Deref(p.AllowDefaultScopes, true))}
+	case policy.Custom != nil:
+		providerID = policy.Custom.ProviderID
+		maps.Copy(config, policy.Custom.Config)
+	}
+
+	return keycloakapi.ComponentRepresentation{
+		Name:         ptr.To(policy.Name),
+		ProviderId:   ptr.To(providerID),
+		ProviderType: ptr.To(ClientRegistrationPolicyProviderType),
+		SubType:      ptr.To(subType),
+		Config:       &config,
+	}
+}
+
+func policyKey(subType, name string) string {
+	return subType + "/" + name
+}
+
+func nonNilSlice(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
@@ -0,0 +1,220 @@
+package realmbuilder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func existingPolicy(id, subType, name, providerID string) keycloakapi.ComponentRepresentation {
+	return keycloakapi.ComponentRepresentation{
+		Id:           ptr.To(id),
+		Name:         ptr.To(name),
+		ProviderId:   ptr.To(providerID),
+		ProviderType: ptr.To(ClientRegistrationPolicyProviderType),
+		SubType:      ptr.To(subType),
+		ParentId:     ptr.To("realm-id"),
+	}
+}
+
+func TestSyncClientRegistrationPolicies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		policies    *common.ClientRegistrationPolicies
+		managed     []string
+		setup       func(m *v2mocks.MockRealmComponentsClient)
+		wantManaged []string
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:        "policies are not configured",
+			setup:       func(_ *v2mocks.MockRealmComponentsClient) {},
+			wantManaged: nil,
+			wantErr:     require.NoError,
+		},
+		{
+			name: "policies are created and updated",
+			policies: &common.ClientRegistrationPolicies{
+				Anonymous: []common.ClientRegistrationPolicy{
+					{
+						Name: "Trusted Hosts",
+						TrustedHosts: &common.TrustedHostsPolicy{
+							Hosts:               []string{"example.com"},
+							ClientURIsMustMatch: ptr.To(false),
+						},
+					},
+					{
+						Name:       "Max Clients Limit",
+						MaxClients: &common.MaxClientsPolicy{Limit: 10},
+					},
+				},
+				Authenticated: []common.ClientRegistrationPolicy{
+					{
+						Name:                "Allowed Client Scopes",
+						AllowedClientScopes: &common.AllowedClientScopesPolicy{Scopes: []string{"profile"}},
+					},
+				},
+			},
+			setup: func(m *v2mocks.MockRealmComponentsClient) {
+				m.EXPECT().GetComponents(mock.Anything, "realm", mock.MatchedBy(func(p *keycloakapi.GetComponentsParams) bool {
+					return *p.Type == ClientRegistrationPolicyProviderType
+				})).Return([]keycloakapi.ComponentRepresentation{
+					existingPolicy("th-id", ClientRegistrationPolicyAnonymous, "Trusted Hosts", "trusted-hosts"),
+					existingPolicy("cr-id", ClientRegistrationPolicyAnonymous, "Consent Required", "consent-required"),
+				}, nil, nil)
+				m.EXPECT().UpdateComponent(mock.Anything, "realm", "th-id", mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+					cfg := *c.Config
+
+					return *c.Id == "th-id" &&
+						*c.ParentId == "realm-id" &&
+						assert.ObjectsAreEqual([]string{"example.com"}, cfg["trusted-hosts"]) &&
+						assert.ObjectsAreEqual([]string{"true"}, cfg["host-sending-registration-request-must-match"]) &&
+						assert.ObjectsAreEqual([]string{"false"}, cfg["client-uris-must-match"])
+				})).Return(nil, nil)
+				m.EXPECT().CreateComponent(mock.Anything, "realm", mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+					return *c.Name == "Max Clients Limit" &&
+						*c.ProviderId == "max-clients" &&
+						*c.SubType == ClientRegistrationPolicyAnonymous &&
+						assert.ObjectsAreEqual([]string{"10"}, (*c.Config)["max-clients"])
+				})).Return(nil, nil)
+				m.EXPECT().CreateComponent(mock.Anything, "realm", mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+					return *c.Name == "Allowed Client Scopes" &&
+						*c.ProviderId == "allowed-client-templates" &&
+						*c.SubType == ClientRegistrationPolicyAuthenticated &&
+						assert.ObjectsAreEqual([]string{"profile"}, (*c.Config)["allowed-client-scopes"]) &&
+						assert.ObjectsAreEqual([]string{"true"}, (*c.Config)["allow-default-scopes"])
+				})).Return(nil, nil)
+			},
+			wantManaged: []string{
+				"anonymous/Max Clients Limit",
+				"authenticated/Allowed Client Scopes",
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "managed policy removed from spec is deleted",
+			policies: &common.ClientRegistrationPolicies{
+				Anonymous: []common.ClientRegistrationPolicy{
+					{
+						Name:   "Consent Required",
+						Custom: &common.CustomClientRegistrationPolicy{ProviderID: "consent-required"},
+					},
+				},
+			},
+			managed: []string{"anonymous/Consent Required", "anonymous/Max Clients Limit", "anonymous/Already Deleted"},
+			setup: func(m *v2mocks.MockRealmComponentsClient) {
+				m.EXPECT().GetComponents(mock.Anything, "realm", mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{
+						existingPolicy("cr-id", ClientRegistrationPolicyAnonymous, "Consent Required", "consent-required"),
+						existingPolicy("mc-id", ClientRegistrationPolicyAnonymous, "Max Clients Limit", "max-clients"),
+						existingPolicy("th-id", ClientRegistrationPolicyAnonymous, "Trusted Hosts", "trusted-hosts"),
+					}, nil, nil)
+				m.EXPECT().UpdateComponent(mock.Anything, "realm", "cr-id", mock.Anything).Return(nil, nil)
+				m.EXPECT().DeleteComponent(mock.Anything, "realm", "mc-id").Return(nil, nil)
+			},
+			wantManaged: []string{"anonymous/Consent Required"},
+			wantErr:     require.NoError,
+		},
+		{
+			name: "existing default policy is updated but not managed",
+			policies: &common.ClientRegistrationPolicies{
+				Anonymous: []common.ClientRegistrationPolicy{
+					{Name: "Max Clients Limit", MaxClients: &common.MaxClientsPolicy{Limit: 50}},
+				},
+			},
+			setup: func(m *v2mocks.MockRealmComponentsClient) {
+				m.EXPECT().GetComponents(mock.Anything, "realm", mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{
+						existingPolicy("mc-id", ClientRegistrationPolicyAnonymous, "Max Clients Limit", "max-clients"),
+					}, nil, nil)
+				m.EXPECT().UpdateComponent(mock.Anything, "realm", "mc-id", mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+					return assert.ObjectsAreEqual([]string{"50"}, (*c.Config)["max-clients"])
+				})).Return(nil, nil)
+			},
+			wantManaged: nil,
+			wantErr:     require.NoError,
+		},
+		{
+			name:    "all managed policies are deleted when spec is removed",
+			managed: []string{"authenticated/Allowed Protocol Mapper Types"},
+			setup: func(m *v2mocks.MockRealmComponentsClient) {
+				m.EXPECT().GetComponents(mock.Anything, "realm", mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{
+						existingPolicy("pm-id", ClientRegistrationPolicyAuthenticated, "Allowed Protocol Mapper Types", "allowed-protocol-mappers"),
+					}, nil, nil)
+				m.EXPECT().DeleteComponent(mock.Anything, "realm", "pm-id").
+					Return(nil, &keycloakapi.ApiError{Code: 404})
+			},
+			wantManaged: nil,
+			wantErr:     require.NoError,
+		},
+		{
+			name: "policy with changed type is recreated",
+			policies: &common.ClientRegistrationPolicies{
+				Authenticated: []common.ClientRegistrationPolicy{
+					{
+						Name:                   "Restrictions",
+						AllowedProtocolMappers: &common.AllowedProtocolMappersPolicy{MapperTypes: []string{"oidc-full-name-mapper"}},
+					},
+				},
+			},
+			managed: []string{"authenticated/Restrictions"},
+			setup: func(m *v2mocks.MockRealmComponentsClient) {
+				m.EXPECT().GetComponents(mock.Anything, "realm", mock.Anything).
+					Return([]keycloakapi.ComponentRepresentation{
+						existingPolicy("r-id", ClientRegistrationPolicyAuthenticated, "Restrictions", "max-clients"),
+					}, nil, nil)
+				m.EXPECT().DeleteComponent(mock.Anything, "realm", "r-id").Return(nil, nil)
+				m.EXPECT().CreateComponent(mock.Anything, "realm", mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+					return *c.ProviderId == "allowed-protocol-mappers" &&
+						assert.ObjectsAreEqual([]string{"oidc-full-name-mapper"}, (*c.Config)["allowed-protocol-mapper-types"])
+				})).Return(nil, nil)
+			},
+			wantManaged: []string{"authenticated/Restrictions"},
+			wantErr:     require.NoError,
+		},
+		{
+			name: "failed to create policy",
+			policies: &common.ClientRegistrationPolicies{
+				Anonymous: []common.ClientRegistrationPolicy{
+					{Name: "Max Clients Limit", MaxClients: &common.MaxClientsPolicy{}},
+				},
+			},
+			managed: []string{"anonymous/Trusted Hosts"},
+			setup: func(m *v2mocks.MockRealmComponentsClient) {
+				m.EXPECT().GetComponents(mock.Anything, "realm", mock.Anything).Return(nil, nil, nil)
+				m.EXPECT().CreateComponent(mock.Anything, "realm", mock.MatchedBy(func(c keycloakapi.ComponentRepresentation) bool {
+					return assert.ObjectsAreEqual([]string{"200"}, (*c.Config)["max-clients"])
+				})).Return(nil, errors.New("create error"))
+			},
+			wantManaged: []string{"anonymous/Trusted Hosts"},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorContains(t, err, "create error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := v2mocks.NewMockRealmComponentsClient(t)
+			tt.setup(m)
+
+			got, err := SyncClientRegistrationPolicies(context.Background(), "realm", tt.policies, tt.managed, m)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantManaged, got)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package realmbuilder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/api/v1alpha1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+)
+
+// ErrAuthFlowNotFound is returned when a bound authentication flow does not exist in the realm.
+var ErrAuthFlowNotFound = errors.New("authentication flow not found")
+
+// FlowBindingsFromV1 returns the authentication flow bindings of a v1.KeycloakRealm.
+// The deprecated spec.browserFlow field is used if the browser flow is not set in spec.authenticationFlows.
+func FlowBindingsFromV1(realm *keycloakApi.KeycloakRealm) common.AuthenticationFlowBindings {
+	var bindings common.AuthenticationFlowBindings
+
+	if realm.Spec.AuthenticationFlows != nil {
+		bindings = *realm.Spec.AuthenticationFlows
+	}
+
+	if bindings.BrowserFlow == "" && realm.Spec.BrowserFlow != nil {
+		bindings.BrowserFlow = *realm.Spec.BrowserFlow
+	}
+
+	return bindings
+}
+
+// FlowBindingsFromV1Alpha1 returns the authentication flow bindings of a v1alpha1.ClusterKeycloakRealm.
+func FlowBindingsFromV1Alpha1(realm *v1alpha1.ClusterKeycloakRealm) common.AuthenticationFlowBindings {
+	flow := realm.Spec.AuthenticationFlow
+	if flow == nil {
+		return common.AuthenticationFlowBindings{}
+	}
+
+	return common.AuthenticationFlowBindings{
+		BrowserFlow:              flow.BrowserFlow,
+		RegistrationFlow:         flow.RegistrationFlow,
+		DirectGrantFlow:          flow.DirectGrantFlow,
+		ResetCredentialsFlow:     flow.ResetCredentialsFlow,
+		ClientAuthenticationFlow: flow.ClientAuthenticationFlow,
+		DockerAuthenticationFlow: flow.DockerAuthenticationFlow,
+		FirstBrokerLoginFlow:     flow.FirstBrokerLoginFlow,
+	}
+}
+
+// ApplyRealmFlowBindings validates that all bound flows exist in the realm and binds them.
+// The realm is updated only if at least one binding differs from the current one.
+func ApplyRealmFlowBindings(
+	ctx context.Context,
+	realmName string,
+	bindings common.AuthenticationFlowBindings,
+	realmClient keycloakapi.RealmClient,
+) error {
+	if len(bindings.Aliases()) == 0 {
+		return nil
+	}
+
+	flows, _, err := realmClient.GetAuthenticationFlows(ctx, realmName)
+	if err != nil {
+		return fmt.Errorf("unable to get authentication flows: %w", err)
+	}
+
+	existing := make(map[string]struct{}, len(flows))
+
+	for _, f := range flows {
+		if f.Alias != nil {
+			existing[*f.Alias] = struct{}{}
+		}
+	}
+
+	var missing []string
+
+	for _, alias := range bindings.Aliases() {
+		if _, ok := existing[alias]; !ok {
+			missing = append(missing, alias)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w in realm %s: %s", ErrAuthFlowNotFound, realmName, strings.Join(missing, ", "))
+	}
+
+	current, _, err := realmClient.GetRealm(ctx, realmName)
+	if err != nil {
+		return fmt.Errorf("unable to get realm: %w", err)
+	}
+
+	changed := false
+
+	for _, b := range []struct {
+		alias string
+		field **string
+	}{
+		{bindings.BrowserFlow, &current.BrowserFlow},
+		{bindings.RegistrationFlow, &current.RegistrationFlow},
+		{bindings.DirectGrantFlow, &current.DirectGrantFlow},
+		{bindings.ResetCredentialsFlow, &current.ResetCredentialsFlow},
+		{bindings.ClientAuthenticationFlow, &current.ClientAuthenticationFlow},
+		{bindings.DockerAuthenticationFlow, &current.DockerAuthenticationFlow},
+		{bindings.FirstBrokerLoginFlow, &current.FirstBrokerLoginFlow},
+	} {
+		if b.alias == "" || (*b.field != nil && **b.field == b.alias) {
+			continue
+		}
+
+		alias := b.alias
+		*b.field = &alias
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if _, err := realmClient.UpdateRealm(ctx, realmName, *current); err != nil {
+		return fmt.Errorf("unable to update realm flow bindings: %w", err)
+	}
+
+	return nil
+}
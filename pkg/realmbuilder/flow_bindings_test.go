@@ -0,0 +1,110 @@
+package realmbuilder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/epam/edp-keycloak-operator/api/common"
+	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
+	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	v2mocks "github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi/mocks"
+)
+
+func TestFlowBindingsFromV1(t *testing.T) {
+	t.Parallel()
+
+	realm := &keycloakApi.KeycloakRealm{
+		Spec: keycloakApi.KeycloakRealmSpec{
+			BrowserFlow: ptr.To("legacy-browser"),
+			AuthenticationFlows: &common.AuthenticationFlowBindings{
+				DockerAuthenticationFlow: "docker auth",
+			},
+		},
+	}
+
+	got := FlowBindingsFromV1(realm)
+	assert.Equal(t, "legacy-browser", got.BrowserFlow)
+	assert.Equal(t, "docker auth", got.DockerAuthenticationFlow)
+
+	realm.Spec.AuthenticationFlows.BrowserFlow = "new-browser"
+
+	got = FlowBindingsFromV1(realm)
+	assert.Equal(t, "new-browser", got.BrowserFlow, "authenticationFlows should take precedence over deprecated browserFlow")
+}
+
+func TestApplyRealmFlowBindings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		bindings common.AuthenticationFlowBindings
+		setup    func(m *v2mocks.MockRealmClient)
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "no bindings",
+			bindings: common.AuthenticationFlowBindings{},
+			setup:    func(_ *v2mocks.MockRealmClient) {},
+			wantErr:  require.NoError,
+		},
+		{
+			name: "all flows are bound",
+			bindings: common.AuthenticationFlowBindings{
+				BrowserFlow:              "b",
+				RegistrationFlow:         "r",
+				DirectGrantFlow:          "dg",
+				ResetCredentialsFlow:     "rc",
+				ClientAuthenticationFlow: "ca",
+				DockerAuthenticationFlow: "da",
+				FirstBrokerLoginFlow:     "fbl",
+			},
+			setup: func(m *v2mocks.MockRealmClient) {
+				flows := make([]keycloakapi.AuthenticationFlowRepresentation, 0, 7)
+				for _, alias := range []string{"b", "r", "dg", "rc", "ca", "da", "fbl"} {
+					flows = append(flows, keycloakapi.AuthenticationFlowRepresentation{Alias: ptr.To(alias)})
+				}
+
+				m.EXPECT().GetAuthenticationFlows(mock.Anything, "realm").Return(flows, nil, nil)
+				m.EXPECT().GetRealm(mock.Anything, "realm").Return(&keycloakapi.RealmRepresentation{}, nil, nil)
+				m.EXPECT().UpdateRealm(mock.Anything, "realm", keycloakapi.RealmRepresentation{
+					BrowserFlow:              ptr.To("b"),
+					RegistrationFlow:         ptr.To("r"),
+					DirectGrantFlow:          ptr.To("dg"),
+					ResetCredentialsFlow:     ptr.To("rc"),
+					ClientAuthenticationFlow: ptr.To("ca"),
+					DockerAuthenticationFlow: ptr.To("da"),
+					FirstBrokerLoginFlow:     ptr.To("fbl"),
+				}).Return(nil, nil)
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name:     "flow not found",
+			bindings: common.AuthenticationFlowBindings{RegistrationFlow: "custom", BrowserFlow: "browser"},
+			setup: func(m *v2mocks.MockRealmClient) {
+				m.EXPECT().GetAuthenticationFlows(mock.Anything, "realm").
+					Return([]keycloakapi.AuthenticationFlowRepresentation{{Alias: ptr.To("browser")}}, nil, nil)
+			},
+			wantErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorIs(t, err, ErrAuthFlowNotFound)
+				require.Contains(t, err.Error(), "custom")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := v2mocks.NewMockRealmClient(t)
+			tt.setup(m)
+
+			tt.wantErr(t, ApplyRealmFlowBindings(context.Background(), "realm", tt.bindings, m))
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	keycloakApi "github.com/epam/edp-keycloak-operator/api/v1"
 	"github.com/epam/edp-keycloak-operator/api/v1alpha1"
 	"github.com/epam/edp-keycloak-operator/pkg/client/keycloakapi"
+	"github.com/epam/edp-keycloak-operator/pkg/drift"
 )
 
 // commonRealmSpec holds the normalized, API-version-agnostic fields shared by
@@ -43,26 +44,32 @@ type commonRealmSpec struct {
 // set (non-nil pointer fields), and writes the result back. This ensures that omitting
 // a boolean field in the CR means "preserve current Keycloak value" rather than
 // silently resetting it to false.
-// It is a no-op if cfg is nil.
+// It is a no-op if cfg is nil or the current config already matches it.
+// It returns true if the config was written to Keycloak.
 func ApplyRealmEventConfig(
 	ctx context.Context,
 	realmName string,
 	cfg *common.RealmEventConfig,
 	eventsClient keycloakapi.EventsClient,
-) error {
+) (bool, error) {
 	if cfg == nil {
-		return nil
+		return false, nil
 	}
 
 	current, _, err := eventsClient.GetEventsConfig(ctx, realmName)
 	if err != nil {
-		return fmt.Errorf("unable to get current realm event config: %w", err)
+		return false, fmt.Errorf("unable to get current realm event config: %w", err)
 	}
 
 	if current == nil {
 		current = &keycloakapi.RealmEventsConfigRepresentation{}
 	}
 
+	before, err := drift.Normalize(current)
+	if err != nil {
+		return false, fmt.Errorf("unable to normalize current realm event config: %w", err)
+	}
+
 	if cfg.AdminEventsDetailsEnabled != nil {
 		current.AdminEventsDetailsEnabled = cfg.AdminEventsDetailsEnabled
 	}
@@ -87,33 +94,57 @@ func ApplyRealmEventConfig(
 		current.EventsListeners = &cfg.EventsListeners
 	}
 
+	unchanged, err := drift.Equal(current, before)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare realm event config: %w", err)
+	}
+
+	if unchanged {
+		return false, nil
+	}
+
 	if _, err := eventsClient.SetEventsConfig(ctx, realmName, *current); err != nil {
-		return fmt.Errorf("unable to set realm event config: %w", err)
+		return false, fmt.Errorf("unable to set realm event config: %w", err)
 	}
 
-	return nil
+	return true, nil
 }
 
 // ApplyRealmSettings fetches the current realm from Keycloak, merges the overlay into it,
-// and writes it back.
+// and writes it back. The write is skipped if the overlay doesn't change the realm.
+// It returns true if the realm was written to Keycloak.
 func ApplyRealmSettings(
 	ctx context.Context,
 	realmName string,
 	overlay keycloakapi.RealmRepresentation,
 	realmClient keycloakapi.RealmClient,
-) error {
+) (bool, error) {
 	current, _, err := realmClient.GetRealm(ctx, realmName)
 	if err != nil {
-		return fmt.Errorf("unable to get realm: %w", err)
+		return false, fmt.Errorf("unable to get realm: %w", err)
+	}
+
+	before, err := drift.Normalize(current)
+	if err != nil {
+		return false, fmt.Errorf("unable to normalize current realm: %w", err)
 	}
 
 	MergeRealmRepresentation(current, &overlay)
 
+	unchanged, err := drift.Equal(current, before)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare realm settings: %w", err)
+	}
+
+	if unchanged {
+		return false, nil
+	}
+
 	if _, err := realmClient.UpdateRealm(ctx, realmName, *current); err != nil {
-		return fmt.Errorf("unable to update realm settings: %w", err)
+		return false, fmt.Errorf("unable to update realm settings: %w", err)
 	}
 
-	return nil
+	return true, nil
 }
 
 // BuildRealmRepresentationFromV1 builds a keycloakapi.RealmRepresentation with only the
@@ -735,10 +735,11 @@ func TestApplyRealmEventConfig(t *testing.T) {
 	}
 
 	tests := []struct {
-		name      string
-		cfg       *common.RealmEventConfig
-		setupMock func(*v2mocks.MockEventsClient)
-		wantErr   require.ErrorAssertionFunc
+		name        string
+		cfg         *common.RealmEventConfig
+		setupMock   func(*v2mocks.MockEventsClient)
+		wantUpdated bool
+		wantErr     require.ErrorAssertionFunc
 	}{
 		{
 			name:      "nil config — no-op",
@@ -769,7 +770,8 @@ func TestApplyRealmEventConfig(t *testing.T) {
 							rep.EventsListeners != nil && len(*rep.EventsListeners) == 1
 					})).Return(nil, nil)
 			},
-			wantErr: require.NoError,
+			wantUpdated: true,
+			wantErr:     require.NoError,
 		},
 		{
 			name: "explicit false propagated for all boolean fields",
@@ -792,7 +794,8 @@ func TestApplyRealmEventConfig(t *testing.T) {
 							rep.EventsEnabled != nil && !*rep.EventsEnabled
 					})).Return(nil, nil)
 			},
-			wantErr: require.NoError,
+			wantUpdated: true,
+			wantErr:     require.NoError,
 		},
 		{
 			name: "nil booleans preserve current Keycloak values",
@@ -813,7 +816,8 @@ func TestApplyRealmEventConfig(t *testing.T) {
 							rep.EventsExpiration != nil && *rep.EventsExpiration == 3600
 					})).Return(nil, nil)
 			},
-			wantErr: require.NoError,
+			wantUpdated: true,
+			wantErr:     require.NoError,
 		},
 		{
 			name: "nil expiration preserves current Keycloak value",
@@ -832,7 +836,8 @@ func TestApplyRealmEventConfig(t *testing.T) {
 						return rep.EventsExpiration != nil && *rep.EventsExpiration == 9000
 					})).Return(nil, nil)
 			},
-			wantErr: require.NoError,
+			wantUpdated: true,
+			wantErr:     require.NoError,
 		},
 		{
 			name: "config without optional slices — slices not overwritten",
@@ -848,6 +853,25 @@ func TestApplyRealmEventConfig(t *testing.T) {
 						return rep.EnabledEventTypes == nil && rep.EventsListeners == nil
 					})).Return(nil, nil)
 			},
+			wantUpdated: true,
+			wantErr:     require.NoError,
+		},
+		{
+			name: "config matches current Keycloak values — update skipped",
+			cfg: &common.RealmEventConfig{
+				AdminEventsEnabled: ptr.To(true),
+				EventsEnabled:      ptr.To(true),
+				EventsListeners:    []string{"jboss-logging"},
+			},
+			setupMock: func(m *v2mocks.MockEventsClient) {
+				m.EXPECT().GetEventsConfig(mock.Anything, "test-realm").
+					Return(&keycloakapi.RealmEventsConfigRepresentation{
+						AdminEventsDetailsEnabled: ptr.To(false),
+						AdminEventsEnabled:        ptr.To(true),
+						EventsEnabled:             ptr.To(true),
+						EventsListeners:           &[]string{"jboss-logging"},
+					}, nil, nil)
+			},
 			wantErr: require.NoError,
 		},
 		{
@@ -885,8 +909,9 @@ func TestApplyRealmEventConfig(t *testing.T) {
 			m := v2mocks.NewMockEventsClient(t)
 			tt.setupMock(m)
 
-			err := ApplyRealmEventConfig(context.Background(), "test-realm", tt.cfg, m)
+			updated, err := ApplyRealmEventConfig(context.Background(), "test-realm", tt.cfg, m)
 			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantUpdated, updated)
 		})
 	}
 }
@@ -895,10 +920,11 @@ func TestApplyRealmSettings(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name      string
-		overlay   keycloakapi.RealmRepresentation
-		setupMock func(*v2mocks.MockRealmClient)
-		wantErr   require.ErrorAssertionFunc
+		name        string
+		overlay     keycloakapi.RealmRepresentation
+		setupMock   func(*v2mocks.MockRealmClient)
+		wantUpdated bool
+		wantErr     require.ErrorAssertionFunc
 	}{
 		{
 			name:    "successful — GetRealm, merge, UpdateRealm",
@@ -910,12 +936,16 @@ func TestApplyRealmSettings(t *testing.T) {
 					return rep.DisplayName != nil && *rep.DisplayName == "My Realm"
 				})).Return(nil, nil)
 			},
-			wantErr: require.NoError,
+			wantUpdated: true,
+			wantErr:     require.NoError,
 		},
 		{
 			name: "unset fields in overlay preserve externally-set Keycloak values",
 			overlay: BuildRealmRepresentationFromV1(&keycloakApi.KeycloakRealm{
-				Spec: keycloakApi.KeycloakRealmSpec{RealmName: "test-realm"},
+				Spec: keycloakApi.KeycloakRealmSpec{
+					RealmName: "test-realm",
+					Themes:    &keycloakApi.RealmThemes{LoginTheme: ptr.To("custom")},
+				},
 			}),
 			setupMock: func(m *v2mocks.MockRealmClient) {
 				m.EXPECT().GetRealm(mock.Anything, "test-realm").
@@ -925,11 +955,25 @@ func TestApplyRealmSettings(t *testing.T) {
 						OrganizationsEnabled: ptr.To(true),
 					}, nil, nil)
 				m.EXPECT().UpdateRealm(mock.Anything, "test-realm", mock.MatchedBy(func(rep keycloakapi.RealmRepresentation) bool {
-					return rep.DisplayName != nil && *rep.DisplayName == "Externally Set" &&
+					return rep.LoginTheme != nil && *rep.LoginTheme == "custom" &&
+						rep.DisplayName != nil && *rep.DisplayName == "Externally Set" &&
 						rep.DisplayNameHtml != nil && *rep.DisplayNameHtml == "<b>Externally Set</b>" &&
 						rep.OrganizationsEnabled != nil && *rep.OrganizationsEnabled
 				})).Return(nil, nil)
 			},
+			wantUpdated: true,
+			wantErr:     require.NoError,
+		},
+		{
+			name:    "overlay matches current realm — update skipped",
+			overlay: keycloakapi.RealmRepresentation{DisplayName: ptr.To("My Realm")},
+			setupMock: func(m *v2mocks.MockRealmClient) {
+				m.EXPECT().GetRealm(mock.Anything, "test-realm").
+					Return(&keycloakapi.RealmRepresentation{
+						DisplayName:     ptr.To("My Realm"),
+						DisplayNameHtml: ptr.To("<b>My Realm</b>"),
+					}, nil, nil)
+			},
 			wantErr: require.NoError,
 		},
 		{
@@ -946,7 +990,7 @@ func TestApplyRealmSettings(t *testing.T) {
 		},
 		{
 			name:    "UpdateRealm fails — error returned",
-			overlay: keycloakapi.RealmRepresentation{},
+			overlay: keycloakapi.RealmRepresentation{DisplayName: ptr.To("My Realm")},
 			setupMock: func(m *v2mocks.MockRealmClient) {
 				m.EXPECT().GetRealm(mock.Anything, "test-realm").
 					Return(&keycloakapi.RealmRepresentation{}, nil, nil)
@@ -967,8 +1011,9 @@ func TestApplyRealmSettings(t *testing.T) {
 			m := v2mocks.NewMockRealmClient(t)
 			tt.setupMock(m)
 
-			err := ApplyRealmSettings(context.Background(), "test-realm", tt.overlay, m)
+			updated, err := ApplyRealmSettings(context.Background(), "test-realm", tt.overlay, m)
 			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantUpdated, updated)
 		})
 	}
 }
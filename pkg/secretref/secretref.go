@@ -109,6 +109,21 @@ func HasSecretRef(val string) bool {
 	return strings.HasPrefix(val, secretRefPrefix)
 }
 
+// ParseSecretRef returns secret name and key from secret reference in format '$secretName:secretKey'.
+// It returns false if the value is not a valid secret reference or it is a Keycloak reference.
+func ParseSecretRef(val string) (name, key string, ok bool) {
+	if !HasSecretRef(val) || strings.HasPrefix(val, keycloakSecretRefPrefix) {
+		return "", "", false
+	}
+
+	ref := strings.Split(val[1:], ":")
+	if len(ref) != 2 {
+		return "", "", false
+	}
+
+	return ref[0], ref[1], true
+}
+
 // GenerateSecretRef generates secret reference.
 func GenerateSecretRef(secretName, secretFiled string) string {
 	return fmt.Sprintf("%s%s:%s", secretRefPrefix, secretName, secretFiled)
@@ -322,3 +322,46 @@ func TestGenerateSecretRef(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSecretRef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		val      string
+		wantName string
+		wantKey  string
+		wantOk   bool
+	}{
+		{
+			name:     "secret ref",
+			val:      "$secret:field",
+			wantName: "secret",
+			wantKey:  "field",
+			wantOk:   true,
+		},
+		{
+			name: "keycloak ref",
+			val:  "${vault.secret}",
+		},
+		{
+			name: "invalid format",
+			val:  "$secret",
+		},
+		{
+			name: "plain value",
+			val:  "secret:field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			name, key, ok := ParseSecretRef(tt.val)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantKey, key)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}